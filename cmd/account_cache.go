@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+// accountSettings are the ad account settings that affect how values are displayed.
+type accountSettings struct {
+	Currency     string `json:"currency"`
+	TimezoneName string `json:"timezone_name"`
+}
+
+// accountSettingsCache caches the settings of each ad account looked up during this run.
+var accountSettingsCache = map[string]accountSettings{}
+
+// lookupAccountSettings returns the currency and timezone of an ad account,
+// fetching them at most once per run. Fields are empty when the lookup fails.
+func lookupAccountSettings(account string) accountSettings {
+	account = api.NormalizeAccountID(account)
+	if s, ok := accountSettingsCache[account]; ok {
+		return s
+	}
+	params := url.Values{}
+	params.Set("fields", "currency,timezone_name")
+	var s accountSettings
+	if body, err := client.Get("/"+account, params); err == nil {
+		_ = json.Unmarshal(body, &s)
+	}
+	accountSettingsCache[account] = s
+	return s
+}
+
+// accountOverrides returns the config account section of account (see
+// `config account`), or the zero value when there is none.
+func accountOverrides(account string) config.AccountConfig {
+	loadConfig()
+	if cfg == nil {
+		return config.AccountConfig{}
+	}
+	return cfg.Account(api.NormalizeAccountID(account))
+}
+
+// displayCurrency returns the currency override of account, else currency.
+// The override is only a label: amounts keep the minor units of the account's
+// own currency.
+func displayCurrency(account, currency string) string {
+	if c := accountOverrides(account).Currency; c != "" {
+		return c
+	}
+	return currency
+}
+
+// accountCurrency returns the ISO 4217 currency of an ad account, whose minor
+// units its amounts are in, or "" when it can't be fetched.
+func accountCurrency(account string) string {
+	return lookupAccountSettings(account).Currency
+}
+
+// useAccountCurrency makes output.FormatBudget render amounts in the currency
+// of account. If the currency can't be fetched, amounts are shown without one.
+func useAccountCurrency(account string) {
+	if account == "" || account == "act_" {
+		return
+	}
+	currency := accountCurrency(account)
+	output.SetCurrency(currency, displayCurrency(account, currency))
+}
+
+// accountLocation returns the timezone of account, or the local one when it
+// can't be fetched.
+func accountLocation(account string) *time.Location {
+	if tz := lookupAccountSettings(account).TimezoneName; tz != "" {
+		if l, err := output.ParseTimezone(tz); err == nil {
+			return l
+		}
+	}
+	return time.Local
+}
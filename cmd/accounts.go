@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"os"
+	"slices"
 
 	"github.com/spf13/cobra"
 	"github.com/the20100/meta-ads-cli/internal/api"
@@ -15,57 +17,373 @@ var accountsCmd = &cobra.Command{
 	Short: "Manage Meta Ad Accounts",
 }
 
+var (
+	accountsWithSpend  bool
+	accountsDatePreset string
+)
+
 var accountsListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "List all ad accounts accessible to you",
-	RunE:  runAccountsList,
+	Long: `List the ad accounts accessible to you.
+
+--with-spend adds the spend and impressions of each account over --date-preset,
+read from the account-level insights of up to 50 accounts per batch request;
+so does selecting the spend or impressions column with --columns.
+An account whose insights can't be read is reported on stderr and listed
+without them.
+
+Examples:
+  meta-ads accounts list
+  meta-ads accounts list --with-spend --date-preset last_30d
+  meta-ads accounts list --columns id,name,spend --date-preset yesterday`,
+	RunE: runAccountsList,
+}
+
+var accountsFundingCmd = &cobra.Command{
+	Use:   "funding [act_id]",
+	Short: "Show funding source, balance, and billing status for an ad account",
+	Long: `Show the payment method, current balance, spend cap, and next bill date
+of an ad account. Billing failures quietly stop delivery, so this is the first
+thing to check when everything stops spending at once.
+
+Uses --account / META_ADS_ACCOUNT when no account ID is given.`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAccountsFunding,
 }
 
 func init() {
-	accountsCmd.AddCommand(accountsListCmd)
+	accountsListCmd.Flags().BoolVar(&accountsWithSpend, "with-spend", false, "Add the spend and impressions of each account over --date-preset")
+	accountsListCmd.Flags().StringVar(&accountsDatePreset, "date-preset", "last_7d", "Period of --with-spend (e.g. today, last_7d, last_30d, this_month, maximum); implies --with-spend")
+	addColumnsFlag(accountsListCmd, accountColumns)
+	addCountFlag(accountsListCmd)
+	addPagingFlags(accountsListCmd)
+	addLimitFlag(accountsListCmd, "accounts")
+	accountsCmd.AddCommand(accountsListCmd, accountsFundingCmd)
 	rootCmd.AddCommand(accountsCmd)
 }
 
 func runAccountsList(cmd *cobra.Command, args []string) error {
+	fields, err := accountColumns.fields("id,name,currency,account_status,timezone_name,amount_spent,balance")
+	if err != nil {
+		return err
+	}
 	params := url.Values{}
-	params.Set("fields", "id,name,currency,account_status,timezone_name,amount_spent,balance")
+	params.Set("fields", fields)
 
-	items, err := client.GetAll("/me/adaccounts", params)
-	if err != nil {
+	withSpend := accountsWithSpend || cmd.Flags().Changed("date-preset")
+	if columnsFlag != "" {
+		cols, _ := accountColumns.selected()
+		for _, c := range cols {
+			withSpend = withSpend || c.name == "spend" || c.name == "impressions"
+		}
+	}
+	if withSpend && !output.IsTable(cmd) && (output.FormatOf(cmd) == output.FormatNDJSON || cmd.Flags().Changed("after") || cmd.Flags().Changed("max-pages")) {
+		// listAll would print the accounts before their totals are read.
+		return usageError("--with-spend can't be combined with --output ndjson, --after or --max-pages")
+	}
+	accounts, streamed, err := listAll[api.Account](cmd, "/me/adaccounts", params, "account", nil)
+	if err != nil || streamed {
 		return err
 	}
 
-	// Decode into Account structs
-	accounts := make([]api.Account, 0, len(items))
-	for _, raw := range items {
-		var a api.Account
-		if err := json.Unmarshal(raw, &a); err != nil {
-			return fmt.Errorf("parsing account: %w", err)
+	var totals []accountTotals
+	if withSpend {
+		totals, err = accountsTotals(accounts)
+		if err != nil {
+			return err
 		}
-		accounts = append(accounts, a)
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(accounts, prettyFlag)
+	if !output.IsTable(cmd) {
+		if withSpend {
+			out := make([]accountWithTotals, len(accounts))
+			for i := range accounts {
+				out[i] = accountWithTotals{accounts[i], totals[i]}
+			}
+			return output.Print(cmd, out)
+		}
+		return output.Print(cmd, accounts)
 	}
 
-	headers := []string{"ID", "NAME", "CURRENCY", "STATUS", "TIMEZONE", "AMOUNT SPENT", "BALANCE"}
-	rows := make([][]string, len(accounts))
+	rows := make([]accountWithTotals, len(accounts))
+	hasLabels := false
 	for i, a := range accounts {
-		rows[i] = []string{
-			a.ID,
-			output.Truncate(a.Name, 40),
-			a.Currency,
-			accountStatusLabel(a.Status),
-			a.TimezoneName,
-			output.FormatBudget(a.AmountSpent),
-			output.FormatBudget(a.Balance),
+		rows[i].Account = a
+		if withSpend {
+			rows[i].accountTotals = totals[i]
+		}
+		hasLabels = hasLabels || accountOverrides(a.ID).Label != ""
+	}
+
+	// The default columns add LABEL only when labels are configured (config
+	// account set <id> label ...), and the totals with --with-spend.
+	set := accountColumns
+	set.columns = slices.Clone(set.columns)
+	for i, c := range set.columns {
+		if c.name == "spend" {
+			set.columns[i].header = "SPEND (" + accountsDatePreset + ")"
 		}
 	}
-	output.PrintTable(headers, rows)
+	if hasLabels {
+		set.defaults = slices.Insert(slices.Clone(set.defaults), 1, "label")
+	}
+	if withSpend {
+		set.defaults = append(slices.Clone(set.defaults), "spend", "impressions")
+	}
+	return set.print(rows)
+}
+
+// accountColumns are the columns selectable with accounts list --columns.
+// spend and impressions read the account insights, as --with-spend does.
+var accountColumns = columnSet[accountWithTotals]{
+	columns: []tableColumn[accountWithTotals]{
+		{name: "id", header: "ID", value: func(a accountWithTotals) string { return a.ID }},
+		{name: "label", header: "LABEL", field: "id", value: func(a accountWithTotals) string { return accountOverrides(a.ID).Label }},
+		{name: "name", header: "NAME", value: func(a accountWithTotals) string { return output.Truncate(a.Name, 40) }},
+		{name: "currency", header: "CURRENCY", value: func(a accountWithTotals) string { return displayCurrency(a.ID, a.Currency) }},
+		{name: "status", header: "STATUS", field: "account_status", value: func(a accountWithTotals) string { return output.Status(accountStatusLabel(a.Status)) }},
+		{name: "timezone", header: "TIMEZONE", field: "timezone_name", value: func(a accountWithTotals) string { return a.TimezoneName }},
+		{name: "amount_spent", header: "AMOUNT SPENT", field: "amount_spent,currency", value: func(a accountWithTotals) string {
+			return output.FormatMoneyLabel(a.AmountSpent, a.Currency, displayCurrency(a.ID, a.Currency))
+		}},
+		{name: "balance", header: "BALANCE", field: "balance,currency", value: func(a accountWithTotals) string {
+			return output.FormatMoneyLabel(a.Balance, a.Currency, displayCurrency(a.ID, a.Currency))
+		}},
+		{name: "spend", header: "SPEND", field: "currency", value: func(a accountWithTotals) string {
+			if a.SpendError != "" {
+				return "-"
+			}
+			return output.FormatNumber(a.Spend) + " " + displayCurrency(a.ID, a.Currency)
+		}},
+		{name: "impressions", header: "IMPRESSIONS", field: "id", value: func(a accountWithTotals) string {
+			if a.SpendError != "" {
+				return "-"
+			}
+			return a.Impressions
+		}},
+	},
+	defaults: []string{"id", "name", "currency", "status", "timezone", "amount_spent", "balance"},
+}
+
+// accountTotals is the spend and impressions of an account over
+// --date-preset, from its account-level insights. Spend is in the account
+// currency, in units rather than minor units.
+type accountTotals struct {
+	Spend       string `json:"spend,omitempty"`
+	Impressions string `json:"impressions,omitempty"`
+	// SpendError is set when the insights of the account couldn't be read.
+	SpendError string `json:"spend_error,omitempty"`
+}
+
+// accountWithTotals is an account listed with --with-spend.
+type accountWithTotals struct {
+	api.Account
+	accountTotals
+}
+
+// accountsTotals returns the totals of each account, in the same order,
+// reading the insights of BatchMax accounts per batch request. Accounts
+// whose insights fail get an error in their totals and a warning on stderr.
+func accountsTotals(accounts []api.Account) ([]accountTotals, error) {
+	params := url.Values{}
+	params.Set("fields", "spend,impressions")
+	params.Set("date_preset", accountsDatePreset)
+	totals := make([]accountTotals, len(accounts))
+	for start := 0; start < len(accounts); start += api.BatchMax {
+		end := min(start+api.BatchMax, len(accounts))
+		urls := make([]string, 0, end-start)
+		for _, a := range accounts[start:end] {
+			urls = append(urls, a.ID+"/insights?"+params.Encode())
+		}
+		progressLine.Update("Fetching spend: %d of %d accounts…", start, len(accounts))
+		responses, err := client.BatchGet(urls)
+		if err != nil {
+			progressLine.Clear()
+			return nil, err
+		}
+		for i, resp := range responses {
+			t := &totals[start+i]
+			var page struct {
+				Data []struct {
+					Spend       string `json:"spend"`
+					Impressions string `json:"impressions"`
+				} `json:"data"`
+			}
+			switch err := resp.Err(); {
+			case resp.Code == 0:
+				t.SpendError = "not processed by Meta"
+			case err != nil:
+				t.SpendError = err.Error()
+			case json.Unmarshal([]byte(resp.Body), &page) != nil:
+				t.SpendError = "unexpected response: " + resp.Body
+			case len(page.Data) > 0:
+				t.Spend, t.Impressions = page.Data[0].Spend, page.Data[0].Impressions
+			default:
+				// No delivery over the period.
+				t.Spend, t.Impressions = "0", "0"
+			}
+		}
+	}
+	progressLine.Clear()
+	for i, t := range totals {
+		if t.SpendError != "" {
+			fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("warning: spend of %s: %s", accounts[i].ID, t.SpendError)))
+		}
+	}
+	return totals, nil
+}
+
+func runAccountsFunding(cmd *cobra.Command, args []string) error {
+	var account string
+	if len(args) == 1 {
+		account = accountID(args[0])
+	} else {
+		var err error
+		account, err = resolveAccount()
+		if err != nil {
+			return err
+		}
+	}
+
+	params := url.Values{}
+	params.Set("fields", "id,name,currency,account_status,disable_reason,balance,amount_spent,spend_cap,is_prepay_account,funding_source_details")
+
+	body, err := client.Get("/"+account, params)
+	if err != nil {
+		return err
+	}
+
+	var b api.AccountBilling
+	if err := json.Unmarshal(body, &b); err != nil {
+		return fmt.Errorf("parsing account: %w", err)
+	}
+
+	// next_bill_date is not exposed for every account type (e.g. invoiced
+	// accounts), so fetch it separately and ignore failures.
+	nbParams := url.Values{}
+	nbParams.Set("fields", "next_bill_date")
+	if nb, err := client.Get("/"+account, nbParams); err == nil {
+		var next struct {
+			NextBillDate string `json:"next_bill_date"`
+		}
+		if json.Unmarshal(nb, &next) == nil {
+			b.NextBillDate = next.NextBillDate
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, b)
+	}
+
+	fundingSource := "(none)"
+	fundingType := ""
+	if b.FundingSourceDetails != nil {
+		fundingSource = b.FundingSourceDetails.DisplayString
+		fundingType = fundingSourceTypeLabel(b.FundingSourceDetails.Type)
+	}
+	prepay := "no"
+	if b.IsPrepayAccount {
+		prepay = "yes"
+	}
+	disableReason := ""
+	if b.DisableReason != 0 {
+		disableReason = disableReasonLabel(b.DisableReason)
+	}
+	currency := displayCurrency(account, b.Currency)
+	spendCap := output.FormatMoneyLabel(b.SpendCap.String(), b.Currency, currency)
+	if spendCap == "-" {
+		spendCap = "(no cap)"
+	}
+
+	rows := [][]string{
+		{"ID", b.ID},
+		{"Name", b.Name},
+		{"Label", accountOverrides(account).Label},
+		{"Status", output.Status(accountStatusLabel(b.Status))},
+		{"Disable Reason", disableReason},
+		{"Currency", currency},
+		{"Funding Source", fundingSource},
+		{"Funding Type", fundingType},
+		{"Prepay Account", prepay},
+		{"Balance Due", output.FormatMoneyLabel(b.Balance.String(), b.Currency, currency)},
+		{"Amount Spent", output.FormatMoneyLabel(b.AmountSpent.String(), b.Currency, currency)},
+		{"Spend Cap", spendCap},
+		{"Next Bill Date", output.FormatTime(b.NextBillDate)},
+	}
+	output.PrintKeyValue(rows)
+
+	if b.Status != 1 {
+		fmt.Println()
+		fmt.Printf("⚠️  Account is %s — ads will not deliver until this is resolved.\n", accountStatusLabel(b.Status))
+	}
 	return nil
 }
 
+// fundingSourceTypeLabel maps funding_source_details.type to its API name.
+func fundingSourceTypeLabel(t int) string {
+	switch t {
+	case 0:
+		return "UNSET"
+	case 1:
+		return "CREDIT_CARD"
+	case 2:
+		return "FACEBOOK_WALLET"
+	case 3:
+		return "FACEBOOK_PAID_CREDIT"
+	case 4:
+		return "FACEBOOK_EXTENDED_CREDIT"
+	case 5:
+		return "ORDER"
+	case 6:
+		return "INVOICE"
+	case 7:
+		return "FACEBOOK_TOKEN"
+	case 8:
+		return "EXTERNAL_FUNDING"
+	case 12:
+		return "PAYPAL_TOKEN"
+	case 13:
+		return "PAYPAL_BILLING_AGREEMENT"
+	case 15:
+		return "EXTERNAL_DEPOSIT"
+	case 17:
+		return "DIRECT_DEBIT"
+	case 19:
+		return "ALTPAY"
+	case 20:
+		return "STORED_BALANCE"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", t)
+	}
+}
+
+// disableReasonLabel maps an ad account disable_reason code to its API name.
+func disableReasonLabel(reason int) string {
+	switch reason {
+	case 1:
+		return "ADS_INTEGRITY_POLICY"
+	case 2:
+		return "ADS_IP_REVIEW"
+	case 3:
+		return "RISK_PAYMENT"
+	case 4:
+		return "GRAY_ACCOUNT_SHUT_DOWN"
+	case 5:
+		return "ADS_AFC_REVIEW"
+	case 6:
+		return "BUSINESS_INTEGRITY_RAR"
+	case 7:
+		return "PERMANENT_CLOSE"
+	case 8:
+		return "UNUSED_RESELLER_ACCOUNT"
+	case 9:
+		return "UNUSED_ACCOUNT"
+	default:
+		return fmt.Sprintf("UNKNOWN(%d)", reason)
+	}
+}
+
 func accountStatusLabel(status int) string {
 	switch status {
 	case 1:
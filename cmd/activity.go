@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	activitySince  string
+	activityUntil  string
+	activityObject string
+	activityActor  string
+)
+
+var activityCmd = &cobra.Command{
+	Use:   "activity",
+	Short: "Inspect the change history of an ad account",
+}
+
+var activityListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List account activity (who changed what, and when)",
+	Long: `List the change history of an ad account from the /activities edge.
+
+Entries are shown oldest first so budget and status changes read as a timeline.
+
+Examples:
+  meta-ads activity list --account act_123 --since 2026-01-01
+  meta-ads activity list --since 2026-01-01 --until 2026-01-31 --object 23851234567890
+  meta-ads activity list --since 2026-01-01 --actor "Jane"`,
+	RunE: runActivityList,
+}
+
+func init() {
+	activityListCmd.Flags().StringVar(&activitySince, "since", "", "Start date YYYY-MM-DD (required)")
+	activityListCmd.Flags().StringVar(&activityUntil, "until", "", "End date YYYY-MM-DD (defaults to now)")
+	activityListCmd.Flags().StringVar(&activityObject, "object", "", "Only show activity for this campaign, ad set, or ad ID")
+	activityListCmd.Flags().StringVar(&activityActor, "actor", "", "Only show activity by this actor (name substring or user ID)")
+	_ = activityListCmd.MarkFlagRequired("since")
+
+	addCountFlag(activityListCmd)
+	addPagingFlags(activityListCmd)
+	addLimitFlag(activityListCmd, "events")
+	activityCmd.AddCommand(activityListCmd)
+	rootCmd.AddCommand(activityCmd)
+}
+
+func runActivityList(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+
+	fields := "event_time,event_type,translated_event_type,actor_id,actor_name,object_id,object_name,object_type,application_name,extra_data"
+	params := url.Values{}
+	params.Set("fields", fields)
+	params.Set("since", activitySince)
+	if activityUntil != "" {
+		params.Set("until", activityUntil)
+	}
+	if activityObject != "" {
+		params.Set("oid", activityObject)
+	}
+
+	var keep func(*api.Activity) bool
+	if actorFilter := strings.ToLower(activityActor); activityObject != "" || actorFilter != "" {
+		keep = func(a *api.Activity) bool {
+			if activityObject != "" && a.ObjectID != activityObject {
+				return false
+			}
+			return actorFilter == "" || a.ActorID == activityActor || strings.Contains(strings.ToLower(a.ActorName), actorFilter)
+		}
+	}
+	activities, streamed, err := listAll(cmd, "/"+account+"/activities", params, "activity", keep)
+	if err != nil || streamed {
+		return err
+	}
+
+	// The API returns newest first; render as a chronological timeline.
+	sort.SliceStable(activities, func(i, j int) bool {
+		return activities[i].EventTime < activities[j].EventTime
+	})
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, activities)
+	}
+
+	if len(activities) == 0 {
+		fmt.Println("No activity found for the specified period.")
+		return nil
+	}
+
+	headers := []string{"TIME", "ACTOR", "EVENT", "OBJECT TYPE", "OBJECT", "CHANGE"}
+	rows := make([][]string, len(activities))
+	for i, a := range activities {
+		event := a.TranslatedEventType
+		if event == "" {
+			event = a.EventType
+		}
+		object := a.ObjectID
+		if a.ObjectName != "" {
+			object = fmt.Sprintf("%s (%s)", output.Truncate(a.ObjectName, 30), a.ObjectID)
+		}
+		rows[i] = []string{
+			output.FormatTime(a.EventTime),
+			output.Truncate(a.ActorName, 24),
+			output.Truncate(event, 32),
+			a.ObjectType,
+			object,
+			output.Truncate(activityChange(a.ExtraData), 50),
+		}
+	}
+	output.PrintTable(headers, rows)
+	return nil
+}
+
+// activityChange summarises the extra_data payload of an activity as "old → new".
+// extra_data is a JSON-encoded string whose shape varies by event type.
+func activityChange(extra string) string {
+	if extra == "" || extra == "null" {
+		return ""
+	}
+	var data map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(extra), &data); err != nil {
+		return extra
+	}
+	oldVal, hasOld := data["old_value"]
+	newVal, hasNew := data["new_value"]
+	if !hasOld && !hasNew {
+		return extra
+	}
+	return activityValue(oldVal) + " → " + activityValue(newVal)
+}
+
+// activityValue renders an old_value/new_value entry, unwrapping {"value": ...} objects.
+func activityValue(raw json.RawMessage) string {
+	if len(raw) == 0 {
+		return "-"
+	}
+	var wrapped struct {
+		Value    json.RawMessage `json:"value"`
+		Currency string          `json:"currency"`
+	}
+	if err := json.Unmarshal(raw, &wrapped); err == nil && len(wrapped.Value) > 0 {
+		v := activityValue(wrapped.Value)
+		if wrapped.Currency != "" {
+			v += " " + wrapped.Currency
+		}
+		return v
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	return string(raw)
+}
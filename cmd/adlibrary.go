@@ -0,0 +1,99 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	adlibraryCountries []string
+	adlibraryStatus    string
+)
+
+const archivedAdFields = "id,page_id,page_name,ad_creation_time,ad_delivery_start_time,ad_delivery_stop_time,ad_snapshot_url,publisher_platforms,languages,ad_creative_bodies,ad_creative_link_titles"
+
+var adlibraryCmd = &cobra.Command{
+	Use:   "adlibrary",
+	Short: "Search the Ad Library for ads run by any page",
+}
+
+var adlibraryPageCmd = &cobra.Command{
+	Use:   "page <page_id>",
+	Short: "List the ads a page is running, from the Ad Library",
+	Long: `List every ad a page runs according to the Ad Library, with delivery
+dates, platforms, and a link to the creative snapshot — to follow a competitor
+over time.
+
+The Ad Library needs a token of a user who confirmed their identity for it
+(facebook.com/ID).
+
+Examples:
+  meta-ads adlibrary page 123456789
+  meta-ads adlibrary page 123456789 --countries US,CA --status all`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAdlibraryPage,
+}
+
+func init() {
+	adlibraryPageCmd.Flags().StringSliceVar(&adlibraryCountries, "countries", []string{"ALL"}, "Countries the ads reached, e.g. US,CA")
+	adlibraryPageCmd.Flags().StringVar(&adlibraryStatus, "status", "active", "Delivery status: active, inactive, or all")
+	addCountFlag(adlibraryPageCmd)
+	addPagingFlags(adlibraryPageCmd)
+	adlibraryCmd.AddCommand(adlibraryPageCmd)
+	rootCmd.AddCommand(adlibraryCmd)
+}
+
+func runAdlibraryPage(cmd *cobra.Command, args []string) error {
+	status := strings.ToUpper(adlibraryStatus)
+	switch status {
+	case "ACTIVE", "INACTIVE", "ALL":
+	default:
+		return usageError("invalid --status %q — use active, inactive, or all", adlibraryStatus)
+	}
+	countries := make([]string, len(adlibraryCountries))
+	for i, c := range adlibraryCountries {
+		countries[i] = fmt.Sprintf("%q", strings.ToUpper(strings.TrimSpace(c)))
+	}
+
+	params := url.Values{}
+	params.Set("fields", archivedAdFields)
+	params.Set("search_page_ids", fmt.Sprintf("[%q]", args[0]))
+	params.Set("ad_reached_countries", "["+strings.Join(countries, ",")+"]")
+	params.Set("ad_active_status", status)
+
+	ads, streamed, err := listAll[api.ArchivedAd](cmd, "/ads_archive", params, "ad", nil)
+	if err != nil || streamed {
+		return err
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, ads)
+	}
+	if len(ads) == 0 {
+		fmt.Println("No ads found.")
+		return nil
+	}
+
+	rows := make([][]string, len(ads))
+	for i, a := range ads {
+		text := ""
+		if len(a.CreativeBodies) > 0 {
+			text = a.CreativeBodies[0]
+		}
+		rows[i] = []string{
+			a.ID,
+			output.FormatTime(a.DeliveryStartTime),
+			output.FormatTime(a.DeliveryStopTime),
+			strings.ToLower(strings.Join(a.PublisherPlatforms, ",")),
+			output.Truncate(strings.Join(strings.Fields(text), " "), 50),
+			a.SnapshotURL,
+		}
+	}
+	fmt.Printf("%s — %d ad(s)\n\n", ads[0].PageName, len(ads))
+	output.PrintTable([]string{"ID", "STARTED", "STOPPED", "PLATFORMS", "TEXT", "SNAPSHOT"}, rows)
+	return nil
+}
@@ -27,23 +27,38 @@ var adsListCmd = &cobra.Command{
 }
 
 var adsGetCmd = &cobra.Command{
-	Use:   "get <ad_id>",
+	Use:   "get [ad_id]",
 	Short: "Get details for an ad",
-	Args:  cobra.ExactArgs(1),
-	RunE:  runAdsGet,
+	Long: `Get details for an ad, with the texts, destination link, call to action,
+media and page/Instagram identity of its creative.
+
+Examples:
+  meta-ads ads get 120210000000000
+  meta-ads ads get 120210000000000 --show-creative-json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAdsGet,
 }
 
 var adsPauseCmd = &cobra.Command{
-	Use:   "pause <ad_id>",
+	Use:   "pause [ad_id]",
 	Short: "Pause an ad",
-	Args:  cobra.ExactArgs(1),
+	Args:  cobra.MaximumNArgs(1),
 	RunE:  runAdsPause,
 }
 
 func init() {
 	adsListCmd.Flags().StringVar(&adAdsetFilter, "adset", "", "Filter by ad set ID")
+	_ = adsListCmd.RegisterFlagCompletionFunc("adset", completeObjectFlag("adset"))
 	adsListCmd.Flags().StringVar(&adStatusFilter, "status", "", "Filter by status (ACTIVE, PAUSED, etc.)")
 
+	addNameFlags(adsGetCmd, "name", false)
+	addNameFlags(adsPauseCmd, "name", true)
+
+	addColumnsFlag(adsListCmd, adColumns)
+	addCountFlag(adsListCmd)
+	addPagingFlags(adsListCmd)
+	addLimitFlag(adsListCmd, "ads")
+
 	adsCmd.AddCommand(adsListCmd, adsGetCmd, adsPauseCmd)
 	rootCmd.AddCommand(adsCmd)
 }
@@ -55,6 +70,10 @@ func runAdsList(cmd *cobra.Command, args []string) error {
 	}
 
 	fields := "id,name,status,effective_status,adset_id,campaign_id,created_time,updated_time"
+	fields, err = adColumns.fields(fields)
+	if err != nil {
+		return err
+	}
 	params := url.Values{}
 	params.Set("fields", fields)
 	if adAdsetFilter != "" {
@@ -64,43 +83,39 @@ func runAdsList(cmd *cobra.Command, args []string) error {
 		params.Set("effective_status", fmt.Sprintf(`["%s"]`, adStatusFilter))
 	}
 
-	items, err := client.GetAll("/"+account+"/ads", params)
-	if err != nil {
+	ads, streamed, err := listAll[api.Ad](cmd, "/"+account+"/ads", params, "ad", nil)
+	if err != nil || streamed {
 		return err
 	}
 
-	ads := make([]api.Ad, 0, len(items))
-	for _, raw := range items {
-		var a api.Ad
-		if err := json.Unmarshal(raw, &a); err != nil {
-			return fmt.Errorf("parsing ad: %w", err)
-		}
-		ads = append(ads, a)
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, ads)
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(ads, prettyFlag)
-	}
+	return adColumns.print(ads)
+}
 
-	headers := []string{"ID", "NAME", "STATUS", "AD SET ID", "CAMPAIGN ID", "CREATED"}
-	rows := make([][]string, len(ads))
-	for i, a := range ads {
-		rows[i] = []string{
-			a.ID,
-			output.Truncate(a.Name, 40),
-			a.EffectiveStatus,
-			a.AdSetID,
-			a.CampaignID,
-			output.FormatTime(a.CreatedTime),
-		}
-	}
-	output.PrintTable(headers, rows)
-	return nil
+// adColumns are the columns selectable with ads list --columns.
+var adColumns = columnSet[api.Ad]{
+	columns: []tableColumn[api.Ad]{
+		{name: "id", header: "ID", value: func(a api.Ad) string { return a.ID }},
+		{name: "name", header: "NAME", value: func(a api.Ad) string { return output.Truncate(a.Name, 40) }},
+		{name: "status", header: "STATUS", field: "effective_status", value: func(a api.Ad) string { return output.Status(a.EffectiveStatus) }},
+		{name: "adset_id", header: "AD SET ID", value: func(a api.Ad) string { return a.AdSetID }},
+		{name: "campaign_id", header: "CAMPAIGN ID", value: func(a api.Ad) string { return a.CampaignID }},
+		{name: "created_time", header: "CREATED", value: func(a api.Ad) string { return output.FormatTime(a.CreatedTime) }},
+		{name: "updated_time", header: "UPDATED", value: func(a api.Ad) string { return output.FormatTime(a.UpdatedTime) }},
+	},
+	defaults: []string{"id", "name", "status", "adset_id", "campaign_id", "created_time"},
 }
 
 func runAdsGet(cmd *cobra.Command, args []string) error {
-	id := args[0]
-	fields := "id,name,status,effective_status,adset_id,campaign_id,creative,created_time,updated_time"
+	ids, err := objectIDs(cmd, args, "ad")
+	if err != nil {
+		return err
+	}
+	id := ids[0]
+	fields := "id,name,status,effective_status,adset_id,campaign_id," + adCreativeFields + ",created_time,updated_time"
 	params := url.Values{}
 	params.Set("fields", fields)
 
@@ -114,37 +129,35 @@ func runAdsGet(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("parsing ad: %w", err)
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(a, prettyFlag)
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, a)
 	}
 
 	rows := [][]string{
 		{"ID", a.ID},
 		{"Name", a.Name},
-		{"Status", a.Status},
-		{"Effective Status", a.EffectiveStatus},
+		{"Status", output.Status(a.Status)},
+		{"Effective Status", output.Status(a.EffectiveStatus)},
 		{"Ad Set ID", a.AdSetID},
 		{"Campaign ID", a.CampaignID},
 		{"Created", a.CreatedTime},
 		{"Updated", a.UpdatedTime},
 	}
+	rows = append(rows, creativeRows(a.Creative)...)
 	output.PrintKeyValue(rows)
+	if showCreativeJSON {
+		return printCreativeJSON(a.Creative)
+	}
 	return nil
 }
 
 func runAdsPause(cmd *cobra.Command, args []string) error {
-	id := args[0]
-	body := url.Values{}
-	body.Set("status", "PAUSED")
-
-	resp, err := client.Post("/"+id, body)
+	ids, err := objectIDs(cmd, args, "ad")
 	if err != nil {
 		return err
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(json.RawMessage(resp), prettyFlag)
-	}
-	fmt.Printf("✓ Ad %s paused\n", id)
-	return nil
+	body := url.Values{}
+	body.Set("status", "PAUSED")
+	return postObjects(cmd, ids, body, "Ad %s paused")
 }
@@ -0,0 +1,274 @@
+package cmd
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+// adCreativeFields is the creative of an ad as ads get reads it: the fields
+// creativeRows renders, and the specs they come from.
+const adCreativeFields = "creative{id,name,title,body,call_to_action_type,link_url,object_url,image_hash,image_url,video_id,thumbnail_url,actor_id,instagram_actor_id,instagram_user_id,object_story_spec,asset_feed_spec}"
+
+// showCreativeJSON is set by ads get --show-creative-json.
+var showCreativeJSON bool
+
+func init() {
+	adsGetCmd.Flags().BoolVar(&showCreativeJSON, "show-creative-json", false, "Also print the creative as returned by Meta, specs included (table output)")
+}
+
+// callToAction is the call_to_action of a story spec.
+type callToAction struct {
+	Type  string `json:"type"`
+	Value struct {
+		Link string `json:"link"`
+	} `json:"value"`
+}
+
+// assetText is a text of an asset_feed_spec (titles, bodies, descriptions).
+type assetText struct {
+	Text string `json:"text"`
+}
+
+// adCreative is the part of a creative ads get renders. Texts, link and media
+// are found at the top level, in object_story_spec (link_data, video_data or
+// photo_data), or in asset_feed_spec for dynamic creatives.
+type adCreative struct {
+	ID               string `json:"id"`
+	Name             string `json:"name"`
+	Title            string `json:"title"`
+	Body             string `json:"body"`
+	CallToActionType string `json:"call_to_action_type"`
+	LinkURL          string `json:"link_url"`
+	ObjectURL        string `json:"object_url"`
+	ImageHash        string `json:"image_hash"`
+	ImageURL         string `json:"image_url"`
+	VideoID          string `json:"video_id"`
+	ActorID          string `json:"actor_id"`
+	InstagramActorID string `json:"instagram_actor_id"`
+	InstagramUserID  string `json:"instagram_user_id"`
+	ObjectStorySpec  *struct {
+		PageID           string `json:"page_id"`
+		InstagramActorID string `json:"instagram_actor_id"`
+		InstagramUserID  string `json:"instagram_user_id"`
+		LinkData         *struct {
+			Name         string        `json:"name"`
+			Message      string        `json:"message"`
+			Description  string        `json:"description"`
+			Link         string        `json:"link"`
+			ImageHash    string        `json:"image_hash"`
+			Picture      string        `json:"picture"`
+			CallToAction *callToAction `json:"call_to_action"`
+		} `json:"link_data"`
+		VideoData *struct {
+			Title           string        `json:"title"`
+			Message         string        `json:"message"`
+			LinkDescription string        `json:"link_description"`
+			VideoID         string        `json:"video_id"`
+			ImageHash       string        `json:"image_hash"`
+			ImageURL        string        `json:"image_url"`
+			CallToAction    *callToAction `json:"call_to_action"`
+		} `json:"video_data"`
+		PhotoData *struct {
+			Caption   string `json:"caption"`
+			ImageHash string `json:"image_hash"`
+			URL       string `json:"url"`
+		} `json:"photo_data"`
+	} `json:"object_story_spec"`
+	AssetFeedSpec *struct {
+		Titles       []assetText `json:"titles"`
+		Bodies       []assetText `json:"bodies"`
+		Descriptions []assetText `json:"descriptions"`
+		LinkURLs     []struct {
+			WebsiteURL string `json:"website_url"`
+		} `json:"link_urls"`
+		CallToActionTypes []string `json:"call_to_action_types"`
+		Images            []struct {
+			Hash string `json:"hash"`
+		} `json:"images"`
+		Videos []struct {
+			VideoID string `json:"video_id"`
+		} `json:"videos"`
+	} `json:"asset_feed_spec"`
+}
+
+// creativeView is what ads get shows of a creative. Dynamic creatives may
+// have several texts, links and media of each kind.
+type creativeView struct {
+	ID, Name                            string
+	Titles, Bodies, Descriptions, Links []string
+	CTAs, Images, Videos                []string
+	PageID, InstagramID                 string
+}
+
+// viewCreative gathers the texts, destination, media and identities of cr
+// from wherever the creative sets them.
+func viewCreative(cr adCreative) creativeView {
+	v := creativeView{ID: cr.ID, Name: cr.Name, PageID: cr.ActorID}
+	add := func(list *[]string, values ...string) {
+		for _, s := range values {
+			if s = strings.TrimSpace(s); s != "" && !slices.Contains(*list, s) {
+				*list = append(*list, s)
+			}
+		}
+	}
+	add(&v.Titles, cr.Title)
+	add(&v.Bodies, cr.Body)
+	add(&v.Links, cr.LinkURL)
+	add(&v.CTAs, cr.CallToActionType)
+	add(&v.Images, cr.ImageHash)
+	add(&v.Videos, cr.VideoID)
+	v.InstagramID = cmp.Or(cr.InstagramUserID, cr.InstagramActorID)
+
+	if s := cr.ObjectStorySpec; s != nil {
+		v.PageID = cmp.Or(s.PageID, v.PageID)
+		v.InstagramID = cmp.Or(s.InstagramUserID, s.InstagramActorID, v.InstagramID)
+		if d := s.LinkData; d != nil {
+			add(&v.Titles, d.Name)
+			add(&v.Bodies, d.Message)
+			add(&v.Descriptions, d.Description)
+			add(&v.Links, d.Link)
+			add(&v.Images, d.ImageHash, d.Picture)
+			if d.CallToAction != nil {
+				add(&v.CTAs, d.CallToAction.Type)
+				add(&v.Links, d.CallToAction.Value.Link)
+			}
+		}
+		if d := s.VideoData; d != nil {
+			add(&v.Titles, d.Title)
+			add(&v.Bodies, d.Message)
+			add(&v.Descriptions, d.LinkDescription)
+			add(&v.Videos, d.VideoID)
+			add(&v.Images, d.ImageHash, d.ImageURL)
+			if d.CallToAction != nil {
+				add(&v.CTAs, d.CallToAction.Type)
+				add(&v.Links, d.CallToAction.Value.Link)
+			}
+		}
+		if d := s.PhotoData; d != nil {
+			add(&v.Bodies, d.Caption)
+			add(&v.Images, d.ImageHash, d.URL)
+		}
+	}
+	if f := cr.AssetFeedSpec; f != nil {
+		for _, t := range f.Titles {
+			add(&v.Titles, t.Text)
+		}
+		for _, t := range f.Bodies {
+			add(&v.Bodies, t.Text)
+		}
+		for _, t := range f.Descriptions {
+			add(&v.Descriptions, t.Text)
+		}
+		for _, l := range f.LinkURLs {
+			add(&v.Links, l.WebsiteURL)
+		}
+		add(&v.CTAs, f.CallToActionTypes...)
+		for _, img := range f.Images {
+			add(&v.Images, img.Hash)
+		}
+		for _, vid := range f.Videos {
+			add(&v.Videos, vid.VideoID)
+		}
+	}
+	// The story link is only the destination when nothing else gives one.
+	if len(v.Links) == 0 {
+		add(&v.Links, cr.ObjectURL)
+	}
+	if len(v.Images) == 0 && cr.ImageURL != "" {
+		add(&v.Images, cr.ImageURL)
+	}
+	return v
+}
+
+// identityNames returns the name of the Facebook page and the username of
+// the Instagram account of a creative, read in one batch request; a name
+// that can't be read is returned empty.
+func identityNames(pageID, instagramID string) (page, instagram string) {
+	var urls []string
+	if pageID != "" {
+		urls = append(urls, pageID+"?fields=name")
+	}
+	if instagramID != "" {
+		urls = append(urls, instagramID+"?fields=username")
+	}
+	if len(urls) == 0 {
+		return "", ""
+	}
+	responses, err := client.BatchGet(urls)
+	if err != nil {
+		return "", ""
+	}
+	for _, resp := range responses {
+		var obj struct {
+			Name     string `json:"name"`
+			Username string `json:"username"`
+		}
+		if resp.Code == 0 || resp.Err() != nil || json.Unmarshal([]byte(resp.Body), &obj) != nil {
+			continue
+		}
+		// Pages have a name, Instagram accounts a username.
+		if obj.Username != "" {
+			instagram = "@" + obj.Username
+		} else {
+			page = obj.Name
+		}
+	}
+	return page, instagram
+}
+
+// creativeRows returns the key-value rows of ads get for the creative raw,
+// the creative field of an ad.
+func creativeRows(raw json.RawMessage) [][]string {
+	var cr adCreative
+	if len(raw) == 0 || json.Unmarshal(raw, &cr) != nil || cr.ID == "" {
+		return nil
+	}
+	v := viewCreative(cr)
+	pageName, igName := identityNames(v.PageID, v.InstagramID)
+	// An identity whose name can't be read shows under an ID key, which
+	// keeps its digits ungrouped.
+	identity := func(key, name, id string) []string {
+		if name == "" {
+			return []string{key + " ID", id}
+		}
+		return []string{key, name + " (" + id + ")"}
+	}
+	join := func(values []string) string {
+		// Multi-line texts are kept on their row.
+		return strings.ReplaceAll(strings.Join(values, " | "), "\n", " ")
+	}
+	rows := [][]string{
+		{"Creative ID", v.ID},
+		{"Creative Name", v.Name},
+		{"Title", join(v.Titles)},
+		{"Body", join(v.Bodies)},
+		{"Description", join(v.Descriptions)},
+		{"Link", join(v.Links)},
+		{"Call to Action", join(v.CTAs)},
+		{"Image", join(v.Images)},
+		{"Video ID", join(v.Videos)},
+	}
+	if v.PageID != "" {
+		rows = append(rows, identity("Page", pageName, v.PageID))
+	}
+	if v.InstagramID != "" {
+		rows = append(rows, identity("Instagram", igName, v.InstagramID))
+	}
+	return rows
+}
+
+// printCreativeJSON prints the creative of an ad, indented, after its
+// key-value view.
+func printCreativeJSON(raw json.RawMessage) error {
+	if len(raw) == 0 {
+		return nil
+	}
+	fmt.Println()
+	fmt.Println("CREATIVE")
+	return output.PrintJSON(raw, true)
+}
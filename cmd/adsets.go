@@ -16,6 +16,7 @@ var (
 	adsetStatusFilter      string
 	adsetNameContains      string
 	adsetGetFields         string
+	adsetTargetingSentences bool
 
 	adsetUpdateDailyBudget    string
 	adsetUpdateLifetimeBudget string
@@ -33,36 +34,53 @@ var adsetsListCmd = &cobra.Command{
 }
 
 var adsetsGetCmd = &cobra.Command{
-	Use:   "get <adset_id>",
+	Use:   "get [adset_id]",
 	Short: "Get details for an ad set",
-	Args:  cobra.ExactArgs(1),
+	Args:  cobra.MaximumNArgs(1),
 	RunE:  runAdsetsGet,
 }
 
 var adsetsPauseCmd = &cobra.Command{
-	Use:   "pause <adset_id>",
+	Use:   "pause [adset_id]",
 	Short: "Pause an ad set",
-	Args:  cobra.ExactArgs(1),
+	Args:  cobra.MaximumNArgs(1),
 	RunE:  runAdsetsPause,
 }
 
 var adsetsUpdateBudgetCmd = &cobra.Command{
-	Use:   "update-budget <adset_id>",
+	Use:   "update-budget [adset_id]",
 	Short: "Update the budget for an ad set",
-	Args:  cobra.ExactArgs(1),
+	Args:  cobra.MaximumNArgs(1),
 	RunE:  runAdsetsUpdateBudget,
 }
 
 func init() {
 	adsetsListCmd.Flags().StringVar(&adsetCampaignFilter, "campaign", "", "Filter by campaign ID")
+	_ = adsetsListCmd.RegisterFlagCompletionFunc("campaign", completeObjectFlag("campaign"))
 	adsetsListCmd.Flags().StringVar(&adsetStatusFilter, "status", "", "Filter by status (ACTIVE, PAUSED, etc.)")
 	adsetsListCmd.Flags().StringVar(&adsetNameContains, "name-contains", "", "Filter ad sets whose name contains this string (case-insensitive)")
 
 	adsetsGetCmd.Flags().StringVar(&adsetGetFields, "fields", "", "Comma-separated fields to request from the API (overrides defaults)")
+	adsetsGetCmd.Flags().BoolVar(&adsetTargetingSentences, "targeting-sentences", false, "Show the targeting as readable sentences")
 
 	adsetsUpdateBudgetCmd.Flags().StringVar(&adsetUpdateDailyBudget, "daily-budget", "", "New daily budget in cents (e.g. 5000 = $50.00)")
 	adsetsUpdateBudgetCmd.Flags().StringVar(&adsetUpdateLifetimeBudget, "lifetime-budget", "", "New lifetime budget in cents")
 
+	addFromJSONFlag(adsetsUpdateBudgetCmd, jsonBody{
+		fields: []string{"daily_budget", "lifetime_budget", "daily_min_spend_target", "daily_spend_cap",
+			"lifetime_min_spend_target", "lifetime_spend_cap"},
+		flags: []string{"daily-budget", "lifetime-budget"},
+	})
+
+	addNameFlags(adsetsGetCmd, "name", false)
+	addNameFlags(adsetsPauseCmd, "name", true)
+	addNameFlags(adsetsUpdateBudgetCmd, "name", true)
+
+	addColumnsFlag(adsetsListCmd, adsetColumns)
+	addCountFlag(adsetsListCmd)
+	addPagingFlags(adsetsListCmd)
+	addLimitFlag(adsetsListCmd, "ad sets")
+
 	adsetsCmd.AddCommand(adsetsListCmd, adsetsGetCmd, adsetsPauseCmd, adsetsUpdateBudgetCmd)
 	rootCmd.AddCommand(adsetsCmd)
 }
@@ -74,6 +92,10 @@ func runAdsetsList(cmd *cobra.Command, args []string) error {
 	}
 
 	fields := "id,name,status,effective_status,campaign_id,daily_budget,lifetime_budget,budget_remaining,bid_amount,billing_event,optimization_goal,start_time,end_time,created_time"
+	fields, err = adsetColumns.fields(fields, "name") // name is needed by --name-contains
+	if err != nil {
+		return err
+	}
 	params := url.Values{}
 	params.Set("fields", fields)
 	if adsetCampaignFilter != "" {
@@ -83,48 +105,50 @@ func runAdsetsList(cmd *cobra.Command, args []string) error {
 		params.Set("effective_status", fmt.Sprintf(`["%s"]`, adsetStatusFilter))
 	}
 
-	items, err := client.GetAll("/"+account+"/adsets", params)
-	if err != nil {
+	var keep func(*api.AdSet) bool
+	if nameFilter := strings.ToLower(adsetNameContains); nameFilter != "" {
+		keep = func(a *api.AdSet) bool { return strings.Contains(strings.ToLower(a.Name), nameFilter) }
+	}
+	adsets, streamed, err := listAll(cmd, "/"+account+"/adsets", params, "adset", keep)
+	if err != nil || streamed {
 		return err
 	}
 
-	adsets := make([]api.AdSet, 0, len(items))
-	nameFilter := strings.ToLower(adsetNameContains)
-	for _, raw := range items {
-		var a api.AdSet
-		if err := json.Unmarshal(raw, &a); err != nil {
-			return fmt.Errorf("parsing adset: %w", err)
-		}
-		if nameFilter != "" && !strings.Contains(strings.ToLower(a.Name), nameFilter) {
-			continue
-		}
-		adsets = append(adsets, a)
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, adsets)
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(adsets, prettyFlag)
-	}
+	useAccountCurrency(account)
+	return adsetColumns.print(adsets)
+}
 
-	headers := []string{"ID", "NAME", "STATUS", "CAMPAIGN ID", "DAILY BUDGET", "BILLING EVENT", "OPT. GOAL"}
-	rows := make([][]string, len(adsets))
-	for i, a := range adsets {
-		rows[i] = []string{
-			a.ID,
-			output.Truncate(a.Name, 38),
-			a.EffectiveStatus,
-			a.CampaignID,
-			output.FormatBudget(a.DailyBudget.String()),
-			a.BillingEvent,
-			a.OptimizationGoal,
-		}
-	}
-	output.PrintTable(headers, rows)
-	return nil
+// adsetColumns are the columns selectable with adsets list --columns.
+var adsetColumns = columnSet[api.AdSet]{
+	columns: []tableColumn[api.AdSet]{
+		{name: "id", header: "ID", value: func(a api.AdSet) string { return a.ID }},
+		{name: "name", header: "NAME", value: func(a api.AdSet) string { return output.Truncate(a.Name, 38) }},
+		{name: "status", header: "STATUS", field: "effective_status", value: func(a api.AdSet) string { return output.Status(a.EffectiveStatus) }},
+		{name: "campaign_id", header: "CAMPAIGN ID", value: func(a api.AdSet) string { return a.CampaignID }},
+		{name: "daily_budget", header: "DAILY BUDGET", value: func(a api.AdSet) string { return output.FormatBudget(a.DailyBudget.String()) }},
+		{name: "lifetime_budget", header: "LIFETIME BUDGET", value: func(a api.AdSet) string { return output.FormatBudget(a.LifetimeBudget.String()) }},
+		{name: "budget_remaining", header: "BUDGET REMAINING", value: func(a api.AdSet) string { return output.FormatBudget(a.BudgetRemaining.String()) }},
+		{name: "bid_amount", header: "BID AMOUNT", value: func(a api.AdSet) string { return output.FormatBudget(a.BidAmount.String()) }},
+		{name: "billing_event", header: "BILLING EVENT", value: func(a api.AdSet) string { return a.BillingEvent }},
+		{name: "optimization_goal", header: "OPT. GOAL", value: func(a api.AdSet) string { return a.OptimizationGoal }},
+		{name: "start_time", header: "START", value: func(a api.AdSet) string { return output.FormatTime(a.StartTime) }},
+		{name: "end_time", header: "END", value: func(a api.AdSet) string { return output.FormatTime(a.EndTime) }},
+		{name: "created_time", header: "CREATED", value: func(a api.AdSet) string { return output.FormatTime(a.CreatedTime) }},
+	},
+	defaults: []string{"id", "name", "status", "campaign_id", "daily_budget", "billing_event", "optimization_goal"},
 }
 
 func runAdsetsGet(cmd *cobra.Command, args []string) error {
-	id := args[0]
-	fields := "id,name,status,effective_status,campaign_id,daily_budget,lifetime_budget,budget_remaining,bid_amount,bid_strategy,billing_event,optimization_goal,start_time,end_time,created_time,updated_time,destination_type,campaign{id,name,objective},targeting,promoted_object,attribution_spec,pacing_type"
+	ids, err := objectIDs(cmd, args, "adset")
+	if err != nil {
+		return err
+	}
+	id := ids[0]
+	fields := "id,name,status,effective_status,campaign_id,daily_budget,lifetime_budget,budget_remaining,bid_amount,bid_strategy,billing_event,optimization_goal,start_time,end_time,created_time,updated_time,destination_type,campaign{id,name,objective},targeting,promoted_object,attribution_spec,pacing_type,account_id"
 	if adsetGetFields != "" {
 		fields = adsetGetFields
 	}
@@ -136,9 +160,24 @@ func runAdsetsGet(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if output.IsJSON(cmd) {
+	var sentences []targetingSentence
+	if adsetTargetingSentences {
+		if sentences, err = targetingSentences(id); err != nil {
+			return fmt.Errorf("fetching targeting sentences: %w", err)
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		if adsetTargetingSentences {
+			var obj map[string]any
+			if err := json.Unmarshal(body, &obj); err != nil {
+				return fmt.Errorf("parsing adset: %w", err)
+			}
+			obj["targeting_sentences"] = sentences
+			return output.Print(cmd, obj)
+		}
 		// For JSON output, return the raw response to preserve all nested structures
-		return output.PrintJSON(json.RawMessage(body), prettyFlag)
+		return output.Print(cmd, json.RawMessage(body))
 	}
 
 	var a api.AdSet
@@ -150,12 +189,15 @@ func runAdsetsGet(cmd *cobra.Command, args []string) error {
 	if a.Campaign != nil {
 		campaignInfo = fmt.Sprintf("%s (%s) — %s", a.Campaign.Name, a.Campaign.ID, a.Campaign.Objective)
 	}
+	if a.AccountID != "" {
+		useAccountCurrency("act_" + a.AccountID)
+	}
 
 	rows := [][]string{
 		{"ID", a.ID},
 		{"Name", a.Name},
-		{"Status", a.Status},
-		{"Effective Status", a.EffectiveStatus},
+		{"Status", output.Status(a.Status)},
+		{"Effective Status", output.Status(a.EffectiveStatus)},
 		{"Campaign", campaignInfo},
 		{"Daily Budget", output.FormatBudget(a.DailyBudget.String())},
 		{"Lifetime Budget", output.FormatBudget(a.LifetimeBudget.String())},
@@ -173,13 +215,31 @@ func runAdsetsGet(cmd *cobra.Command, args []string) error {
 	output.PrintKeyValue(rows)
 
 	// Display targeting summary
-	if len(a.Targeting) > 0 {
+	if adsetTargetingSentences {
+		fmt.Println()
+		fmt.Println("TARGETING")
+		fmt.Println(strings.Repeat("─", 60))
+		for _, s := range sentences {
+			fmt.Printf("  %s %s\n", s.Content, strings.Join(s.Children, "; "))
+		}
+	} else if len(a.Targeting) > 0 {
 		fmt.Println()
 		fmt.Println("TARGETING")
 		fmt.Println(strings.Repeat("─", 60))
 		printTargetingSummary(a.Targeting)
 	}
 
+	// Display placements
+	var targeting map[string]json.RawMessage
+	if json.Unmarshal(a.Targeting, &targeting) == nil && targeting != nil {
+		fmt.Println()
+		fmt.Println("PLACEMENTS")
+		fmt.Println(strings.Repeat("─", 60))
+		for _, line := range placementLines(targeting) {
+			fmt.Printf("  %s\n", line)
+		}
+	}
+
 	// Display promoted object
 	if len(a.PromotedObject) > 0 {
 		fmt.Println()
@@ -199,6 +259,35 @@ func runAdsetsGet(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// targetingSentence is one line of Meta's readable targeting description,
+// e.g. "Location:" with children ["United States"].
+type targetingSentence struct {
+	Content  string   `json:"content"`
+	Children []string `json:"children"`
+}
+
+// targetingSentences fetches the targeting of an ad set as readable sentences.
+func targetingSentences(adsetID string) ([]targetingSentence, error) {
+	body, err := client.Get("/"+adsetID+"/targetingsentencelines", nil)
+	if err != nil {
+		return nil, err
+	}
+	// The lines come at the top level, or in a one-item data list.
+	var resp struct {
+		Lines []targetingSentence `json:"targetingsentencelines"`
+		Data  []struct {
+			Lines []targetingSentence `json:"targetingsentencelines"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Lines) == 0 && len(resp.Data) > 0 {
+		return resp.Data[0].Lines, nil
+	}
+	return resp.Lines, nil
+}
+
 // printTargetingSummary prints key targeting fields in a readable format.
 func printTargetingSummary(raw json.RawMessage) {
 	var targeting map[string]json.RawMessage
@@ -231,17 +320,6 @@ func printTargetingSummary(raw json.RawMessage) {
 		}
 	}
 
-	// Publisher platforms
-	if v, ok := targeting["publisher_platforms"]; ok {
-		fmt.Printf("  Platforms:       %s\n", string(v))
-	}
-	if v, ok := targeting["facebook_positions"]; ok {
-		fmt.Printf("  FB Positions:    %s\n", string(v))
-	}
-	if v, ok := targeting["instagram_positions"]; ok {
-		fmt.Printf("  IG Positions:    %s\n", string(v))
-	}
-
 	// Custom audiences
 	if v, ok := targeting["custom_audiences"]; ok {
 		var audiences []struct {
@@ -295,27 +373,29 @@ func printIndentedJSON(raw json.RawMessage) {
 }
 
 func runAdsetsPause(cmd *cobra.Command, args []string) error {
-	id := args[0]
-	body := url.Values{}
-	body.Set("status", "PAUSED")
-
-	resp, err := client.Post("/"+id, body)
+	ids, err := objectIDs(cmd, args, "adset")
 	if err != nil {
 		return err
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(json.RawMessage(resp), prettyFlag)
-	}
-	fmt.Printf("✓ Ad set %s paused\n", id)
-	return nil
+	body := url.Values{}
+	body.Set("status", "PAUSED")
+	return postObjects(cmd, ids, body, "Ad set %s paused")
 }
 
 func runAdsetsUpdateBudget(cmd *cobra.Command, args []string) error {
-	id := args[0]
-	body := url.Values{}
-
-	changed := false
+	ids, err := objectIDs(cmd, args, "adset")
+	if err != nil {
+		return err
+	}
+	body, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	changed := body != nil
+	if body == nil {
+		body = url.Values{}
+	}
 	if adsetUpdateDailyBudget != "" {
 		body.Set("daily_budget", adsetUpdateDailyBudget)
 		changed = true
@@ -326,17 +406,13 @@ func runAdsetsUpdateBudget(cmd *cobra.Command, args []string) error {
 	}
 
 	if !changed {
-		return fmt.Errorf("no budget specified — use --daily-budget or --lifetime-budget")
-	}
-
-	resp, err := client.Post("/"+id, body)
-	if err != nil {
-		return err
+		return fmt.Errorf("no budget specified — use --daily-budget, --lifetime-budget, or --from-json")
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(json.RawMessage(resp), prettyFlag)
+	for _, id := range ids {
+		if err := confirmBudget("ad set", id, body.Get("daily_budget"), body.Get("lifetime_budget")); err != nil {
+			return err
+		}
 	}
-	fmt.Printf("✓ Ad set %s budget updated\n", id)
-	return nil
+	return postObjects(cmd, ids, body, "Ad set %s budget updated")
 }
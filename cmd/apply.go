@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+	"github.com/the20100/meta-ads-cli/internal/spec"
+)
+
+var (
+	applyFile         string
+	applySetThumbnail string
+)
+
+var applyCmd = &cobra.Command{
+	Use:   "apply -f <file>",
+	Short: "Create or update campaigns from a YAML spec",
+	Long: `Create or update a campaign → ad set → ad → creative tree described in YAML.
+
+Objects are applied in dependency order. Objects without an id are created and
+their new ID is written back into the file (comments are kept), so applying the
+same file again updates them instead of creating duplicates. New objects are
+created PAUSED unless a status is given.
+
+Example spec:
+
+  account: act_123456789        # optional, defaults to --account
+  campaigns:
+    - name: Spring Sale
+      objective: OUTCOME_SALES
+      daily_budget: 5000        # cents
+      adsets:
+        - name: US 25-45
+          billing_event: IMPRESSIONS
+          optimization_goal: OFFSITE_CONVERSIONS
+          promoted_object: {pixel_id: "123", custom_event_type: PURCHASE}
+          targeting:
+            geo_locations: {countries: [US]}
+            age_min: 25
+            age_max: 45
+          ads:
+            - name: Video A
+              creative:
+                name: Video A
+                object_story_spec: {page_id: "456", video_data: {video_id: "789", call_to_action: {type: SHOP_NOW}}}
+                thumbnail: 3          # generated thumbnail 3, or an image URL
+
+Video creatives without image_url or image_hash get the thumbnail given by
+--set-thumbnail (see assets videos thumbnails). Any other API field can be
+passed under params:. Use "-f -" to read stdin
+(created IDs are then only printed). With --dry-run every request is printed
+instead of sent and the file is left unchanged.`,
+	Args: cobra.NoArgs,
+	RunE: runApply,
+}
+
+func init() {
+	applyCmd.Flags().StringVarP(&applyFile, "file", "f", "", "Spec file (YAML), or - for stdin (required)")
+	applyCmd.Flags().StringVar(&applySetThumbnail, "set-thumbnail", "", "Thumbnail of video creatives without one: an image URL or a thumbnail index")
+	_ = applyCmd.MarkFlagRequired("file")
+	rootCmd.AddCommand(applyCmd)
+}
+
+// applyResult is one object created or updated by apply.
+type applyResult struct {
+	Kind   string `json:"kind"`
+	Name   string `json:"name"`
+	Action string `json:"action"`
+	ID     string `json:"id"`
+}
+
+func runApply(cmd *cobra.Command, args []string) error {
+	s, err := spec.Load(applyFile)
+	if err != nil {
+		return err
+	}
+	account := ""
+	if s.Account != "" {
+		account = accountID(s.Account)
+	} else if account, err = resolveAccount(); err != nil {
+		return err
+	}
+
+	var results []applyResult
+	record := func(kind, name, action, id string) error {
+		results = append(results, applyResult{Kind: kind, Name: name, Action: action, ID: id})
+		if action != "created" || dryRunFlag {
+			return nil
+		}
+		// Save after each creation so a later failure doesn't lose the IDs.
+		if err := s.Save(); err != nil {
+			return fmt.Errorf("recording %s ID in %s: %w", kind, s.Path(), err)
+		}
+		return nil
+	}
+
+	applyErr := applySpec(s, account, record)
+
+	if !output.IsTable(cmd) {
+		if err := output.Print(cmd, results); err != nil {
+			return err
+		}
+		return applyErr
+	}
+	if len(results) > 0 {
+		rows := make([][]string, len(results))
+		for i, r := range results {
+			rows[i] = []string{r.Kind, output.Truncate(r.Name, 40), r.Action, r.ID}
+		}
+		output.PrintTable([]string{"KIND", "NAME", "ACTION", "ID"}, rows)
+	}
+	if applyErr != nil {
+		return applyErr
+	}
+	if dryRunFlag {
+		fmt.Println("✓ Dry run — nothing was changed and no IDs were recorded")
+	} else if s.Writable() {
+		fmt.Printf("✓ Applied %s\n", s.Path())
+	} else {
+		fmt.Println("✓ Applied (stdin — add the IDs above to your spec to update these objects next time)")
+	}
+	return nil
+}
+
+// applySpec creates or updates every object of s in dependency order,
+// calling record after each one. It stops at the first error.
+func applySpec(s *spec.Spec, account string, record func(kind, name, action, id string) error) error {
+	for _, c := range s.Campaigns {
+		action, err := applyObject(c.ID, "/"+account+"/campaigns", campaignApplyFields(c), c.SetID)
+		if err != nil {
+			return fmt.Errorf("campaign %q: %w", c.Name, err)
+		}
+		if err := record("campaign", c.Name, action, c.ID); err != nil {
+			return err
+		}
+
+		for _, as := range c.AdSets {
+			action, err := applyObject(as.ID, "/"+account+"/adsets", adsetApplyFields(as, c.ID), as.SetID)
+			if err != nil {
+				return fmt.Errorf("ad set %q: %w", as.Name, err)
+			}
+			if err := record("adset", as.Name, action, as.ID); err != nil {
+				return err
+			}
+
+			for _, ad := range as.Ads {
+				if cr := ad.Creative; cr != nil && cr.ID == "" {
+					fields, err := creativeFields(cr, applySetThumbnail)
+					if err != nil {
+						return fmt.Errorf("creative of ad %q: %w", ad.Name, err)
+					}
+					if _, err := applyObject("", "/"+account+"/adcreatives", fields, cr.SetID); err != nil {
+						return fmt.Errorf("creative of ad %q: %w", ad.Name, err)
+					}
+					if err := record("creative", cr.Name, "created", cr.ID); err != nil {
+						return err
+					}
+					if ad.ID != "" {
+						// Point the existing ad at its new creative.
+						if _, err := applyObject(ad.ID, "", map[string]any{"creative": map[string]any{"creative_id": cr.ID}}, nil); err != nil {
+							return fmt.Errorf("ad %q: %w", ad.Name, err)
+						}
+					}
+				}
+				action, err := applyObject(ad.ID, "/"+account+"/ads", adApplyFields(ad, as.ID), ad.SetID)
+				if err != nil {
+					return fmt.Errorf("ad %q: %w", ad.Name, err)
+				}
+				if err := record("ad", ad.Name, action, ad.ID); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// campaignApplyFields returns the fields apply sends for c: all of them when
+// creating (PAUSED unless a status is set), the updatable ones otherwise.
+func campaignApplyFields(c *spec.Campaign) map[string]any {
+	fields := c.Fields(c.ID == "")
+	if c.ID == "" && c.Status == "" {
+		fields["status"] = "PAUSED"
+	}
+	return fields
+}
+
+// adsetApplyFields returns the fields apply sends for as, created in campaignID.
+func adsetApplyFields(as *spec.AdSet, campaignID string) map[string]any {
+	fields := as.Fields()
+	if as.ID == "" {
+		fields["campaign_id"] = campaignID
+		if as.Status == "" {
+			fields["status"] = "PAUSED"
+		}
+	}
+	return fields
+}
+
+// adApplyFields returns the fields apply sends for ad, created in adsetID.
+// The creative is only sent when creating the ad.
+func adApplyFields(ad *spec.Ad, adsetID string) map[string]any {
+	fields := ad.Fields()
+	if ad.ID == "" {
+		fields["adset_id"] = adsetID
+		if ad.Creative != nil {
+			fields["creative"] = map[string]any{"creative_id": ad.Creative.ID}
+		}
+		if ad.Status == "" {
+			fields["status"] = "PAUSED"
+		}
+	}
+	return fields
+}
+
+// applyObject updates the object id with fields, or creates it on the create
+// edge when id is empty and hands the new ID to setID.
+// It returns "created" or "updated".
+func applyObject(id, createPath string, fields map[string]any, setID func(string)) (string, error) {
+	body, err := spec.Form(fields)
+	if err != nil {
+		return "", err
+	}
+	if id != "" {
+		if _, err := client.Post("/"+id, body); err != nil {
+			return "", err
+		}
+		return "updated", nil
+	}
+
+	resp, err := client.Post(createPath, body)
+	if err != nil {
+		return "", err
+	}
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return "", fmt.Errorf("parsing response: %w", err)
+	}
+	if result.ID == "" {
+		return "", fmt.Errorf("no ID in response: %s", resp)
+	}
+	setID(result.ID)
+	return "created", nil
+}
@@ -0,0 +1,238 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var assetsUnused bool
+
+const (
+	adImageFields = "hash,name,width,height,url,status,created_time"
+	adVideoFields = "id,title,length,picture,created_time"
+)
+
+// assetImage is a library image with the number of ad creatives using it.
+type assetImage struct {
+	api.AdImage
+	Usage int `json:"usage"`
+}
+
+// assetVideo is a library video with the number of ad creatives using it.
+type assetVideo struct {
+	api.AdVideo
+	Usage int `json:"usage"`
+}
+
+var assetsCmd = &cobra.Command{
+	Use:   "assets",
+	Short: "Browse the image and video library of an ad account",
+}
+
+var assetsImagesCmd = &cobra.Command{
+	Use:   "images",
+	Short: "Manage the images of an ad account (adimages)",
+}
+
+var assetsVideosCmd = &cobra.Command{
+	Use:   "videos",
+	Short: "Manage the videos of an ad account (advideos)",
+}
+
+var assetsImagesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List library images with their hash, size, usage and creation date",
+	Long: `List the images uploaded to an ad account. USAGE counts the ad creatives of
+the account that reference the image hash (directly, in a carousel or in a
+dynamic creative); --unused keeps the images no creative uses.
+
+Examples:
+  meta-ads assets images list -a act_123456789
+  meta-ads assets images list --unused --json`,
+	Args: cobra.NoArgs,
+	RunE: runAssetsImagesList,
+}
+
+var assetsVideosListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List library videos with their length, usage and creation date",
+	Long: `List the videos uploaded to an ad account. USAGE counts the ad creatives of
+the account that reference the video; --unused keeps the videos no creative
+uses.
+
+Examples:
+  meta-ads assets videos list -a act_123456789
+  meta-ads assets videos list --unused`,
+	Args: cobra.NoArgs,
+	RunE: runAssetsVideosList,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{assetsImagesListCmd, assetsVideosListCmd} {
+		c.Flags().BoolVar(&assetsUnused, "unused", false, "Only list assets no ad creative uses")
+		addCountFlag(c)
+		addPagingFlags(c)
+	}
+	addLimitFlag(assetsImagesListCmd, "images")
+	addLimitFlag(assetsVideosListCmd, "videos")
+	assetsImagesCmd.AddCommand(assetsImagesListCmd)
+	assetsVideosCmd.AddCommand(assetsVideosListCmd)
+	assetsCmd.AddCommand(assetsImagesCmd, assetsVideosCmd)
+	rootCmd.AddCommand(assetsCmd)
+}
+
+// assetUsage counts the ad creatives of account referencing each image hash
+// and each video ID.
+func assetUsage(account string) (images, videos map[string]int, err error) {
+	params := url.Values{}
+	params.Set("fields", "image_hash,video_id,object_story_spec,asset_feed_spec")
+	images, videos = map[string]int{}, map[string]int{}
+	err = client.GetEach("/"+account+"/adcreatives", params, func(raw json.RawMessage) error {
+		var creative any
+		if err := json.Unmarshal(raw, &creative); err != nil {
+			return fmt.Errorf("parsing creative: %w", err)
+		}
+		hashes, ids := map[string]bool{}, map[string]bool{}
+		collectAssetRefs(creative, "", hashes, ids)
+		for h := range hashes {
+			images[h]++
+		}
+		for id := range ids {
+			videos[id]++
+		}
+		return nil
+	})
+	return images, videos, err
+}
+
+// collectAssetRefs walks a creative and records the image hashes and video
+// IDs it references: image_hash and video_id keys at any depth, and the hash
+// of asset_feed_spec images. parent is the key v is found under.
+func collectAssetRefs(v any, parent string, hashes, videos map[string]bool) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			s, isString := child.(string)
+			switch {
+			case isString && s != "" && (k == "image_hash" || (k == "hash" && parent == "images")):
+				hashes[s] = true
+			case isString && s != "" && k == "video_id":
+				videos[s] = true
+			default:
+				collectAssetRefs(child, k, hashes, videos)
+			}
+		}
+	case []any:
+		for _, child := range v {
+			collectAssetRefs(child, parent, hashes, videos)
+		}
+	}
+}
+
+func runAssetsImagesList(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("fields", adImageFields)
+	// --count needs no usage, and can't count what --unused filters locally.
+	var keep func(*assetImage) bool
+	if count, _ := cmd.Flags().GetBool("count"); count && assetsUnused {
+		return usageError("--count can't be combined with --unused")
+	} else if !count {
+		usage, _, err := assetUsage(account)
+		if err != nil {
+			return err
+		}
+		keep = func(a *assetImage) bool {
+			a.Usage = usage[a.Hash]
+			return !assetsUnused || a.Usage == 0
+		}
+	}
+	images, streamed, err := listAll(cmd, "/"+account+"/adimages", params, "image", keep)
+	if err != nil || streamed {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, images)
+	}
+	if len(images) == 0 {
+		fmt.Println("No images found.")
+		return nil
+	}
+	rows := make([][]string, len(images))
+	for i, img := range images {
+		size := "-"
+		if img.Width > 0 && img.Height > 0 {
+			size = fmt.Sprintf("%dx%d", img.Width, img.Height)
+		}
+		rows[i] = []string{
+			img.Hash,
+			output.Truncate(orDash(img.Name), 40),
+			size,
+			strconv.Itoa(img.Usage),
+			output.FormatTime(img.CreatedTime),
+		}
+	}
+	output.PrintTable([]string{"HASH", "NAME", "SIZE", "USAGE", "CREATED"}, rows)
+	return nil
+}
+
+func runAssetsVideosList(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("fields", adVideoFields)
+	// --count needs no usage, and can't count what --unused filters locally.
+	var keep func(*assetVideo) bool
+	if count, _ := cmd.Flags().GetBool("count"); count && assetsUnused {
+		return usageError("--count can't be combined with --unused")
+	} else if !count {
+		_, usage, err := assetUsage(account)
+		if err != nil {
+			return err
+		}
+		keep = func(a *assetVideo) bool {
+			a.Usage = usage[a.ID]
+			return !assetsUnused || a.Usage == 0
+		}
+	}
+	videos, streamed, err := listAll(cmd, "/"+account+"/advideos", params, "video", keep)
+	if err != nil || streamed {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, videos)
+	}
+	if len(videos) == 0 {
+		fmt.Println("No videos found.")
+		return nil
+	}
+	rows := make([][]string, len(videos))
+	for i, v := range videos {
+		length := "-"
+		if v.Length > 0 {
+			length = fmt.Sprintf("%d:%02d", int(v.Length)/60, int(v.Length)%60)
+		}
+		rows[i] = []string{
+			v.ID,
+			output.Truncate(orDash(v.Title), 40),
+			length,
+			strconv.Itoa(v.Usage),
+			output.FormatTime(v.CreatedTime),
+		}
+	}
+	output.PrintTable([]string{"ID", "TITLE", "LENGTH", "USAGE", "CREATED"}, rows)
+	return nil
+}
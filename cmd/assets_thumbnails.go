@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+	"github.com/the20100/meta-ads-cli/internal/spec"
+)
+
+// videoThumbnail is one of the thumbnails Meta generated for a video.
+type videoThumbnail struct {
+	Index       int    `json:"index"`
+	ID          string `json:"id"`
+	URI         string `json:"uri"`
+	Width       int    `json:"width,omitempty"`
+	Height      int    `json:"height,omitempty"`
+	IsPreferred bool   `json:"is_preferred"`
+}
+
+var assetsVideosThumbnailsCmd = &cobra.Command{
+	Use:   "thumbnails <video_id>",
+	Short: "List the thumbnails Meta generated for a video",
+	Long: `List the thumbnails Meta generated for a video, numbered from 1. Pick one for
+a video creative with "thumbnail: <index>" in an apply spec, or for every
+video creative of the spec with apply --set-thumbnail <index>; an image URL
+works too. Without a thumbnail, video creatives need image_url or image_hash
+in video_data.
+
+Examples:
+  meta-ads assets videos thumbnails 789
+  meta-ads apply -f launch.yaml --set-thumbnail 3`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAssetsVideosThumbnails,
+}
+
+func init() {
+	assetsVideosCmd.AddCommand(assetsVideosThumbnailsCmd)
+}
+
+// videoThumbnails returns the generated thumbnails of a video, indexed from 1.
+func videoThumbnails(videoID string) ([]videoThumbnail, error) {
+	params := url.Values{}
+	params.Set("fields", "id,uri,width,height,is_preferred")
+	items, err := client.GetAll("/"+videoID+"/thumbnails", params)
+	if err != nil {
+		return nil, err
+	}
+	thumbs := make([]videoThumbnail, 0, len(items))
+	for i, raw := range items {
+		var t videoThumbnail
+		if err := json.Unmarshal(raw, &t); err != nil {
+			return nil, fmt.Errorf("parsing thumbnail: %w", err)
+		}
+		t.Index = i + 1
+		thumbs = append(thumbs, t)
+	}
+	return thumbs, nil
+}
+
+// resolveThumbnail returns the image URL of thumbnail for videoID: thumbnail
+// itself when it is a URL, else the URL of the generated thumbnail with that
+// index.
+func resolveThumbnail(videoID, thumbnail string) (string, error) {
+	if strings.HasPrefix(thumbnail, "http://") || strings.HasPrefix(thumbnail, "https://") {
+		return thumbnail, nil
+	}
+	n, err := strconv.Atoi(thumbnail)
+	if err != nil || n < 1 {
+		return "", usageError("invalid thumbnail %q: expected an image URL or a thumbnail index from 1", thumbnail)
+	}
+	thumbs, err := videoThumbnails(videoID)
+	if err != nil {
+		return "", err
+	}
+	if n > len(thumbs) {
+		return "", fmt.Errorf("video %s has %d thumbnail(s), so there's no thumbnail %d — see: meta-ads assets videos thumbnails %s", videoID, len(thumbs), n, videoID)
+	}
+	return thumbs[n-1].URI, nil
+}
+
+// creativeFields returns the fields creating cr, with the thumbnail of a
+// video creative set from cr.Thumbnail, or else from fallback when video_data
+// has no image yet.
+func creativeFields(cr *spec.Creative, fallback string) (map[string]any, error) {
+	fields := cr.Fields()
+	videoData, ok := cr.ObjectStorySpec["video_data"].(map[string]any)
+	if !ok {
+		if cr.Thumbnail != "" {
+			return nil, fmt.Errorf("thumbnail is only for video creatives (object_story_spec.video_data)")
+		}
+		return fields, nil
+	}
+	thumbnail := cr.Thumbnail
+	if thumbnail == "" && videoData["image_url"] == nil && videoData["image_hash"] == nil {
+		thumbnail = fallback
+	}
+	if thumbnail == "" {
+		return fields, nil
+	}
+	videoID := ""
+	if v := videoData["video_id"]; v != nil {
+		videoID = fmt.Sprint(v)
+	}
+	if videoID == "" {
+		return nil, fmt.Errorf("video_data has no video_id to pick a thumbnail of")
+	}
+	imageURL, err := resolveThumbnail(videoID, thumbnail)
+	if err != nil {
+		return nil, err
+	}
+	// Copy so the spec itself keeps the thumbnail as written.
+	videoData = maps.Clone(videoData)
+	delete(videoData, "image_hash")
+	videoData["image_url"] = imageURL
+	storySpec := maps.Clone(cr.ObjectStorySpec)
+	storySpec["video_data"] = videoData
+	fields["object_story_spec"] = storySpec
+	return fields, nil
+}
+
+func runAssetsVideosThumbnails(cmd *cobra.Command, args []string) error {
+	thumbs, err := videoThumbnails(args[0])
+	if err != nil {
+		return err
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, thumbs)
+	}
+	if len(thumbs) == 0 {
+		fmt.Println("No thumbnails found — Meta may still be processing the video.")
+		return nil
+	}
+	rows := make([][]string, len(thumbs))
+	for i, t := range thumbs {
+		size := "-"
+		if t.Width > 0 && t.Height > 0 {
+			size = fmt.Sprintf("%dx%d", t.Width, t.Height)
+		}
+		preferred := ""
+		if t.IsPreferred {
+			preferred = "✓"
+		}
+		rows[i] = []string{strconv.Itoa(t.Index), size, preferred, t.URI}
+	}
+	output.PrintTable([]string{"INDEX", "SIZE", "PREFERRED", "URL"}, rows)
+	return nil
+}
@@ -0,0 +1,195 @@
+package cmd
+
+import (
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+// imageUpload is the outcome of uploading one image file.
+type imageUpload struct {
+	File string `json:"file"`
+	Hash string `json:"hash,omitempty"`
+	// Result is "uploaded", "reused" (the account already had the same bytes)
+	// or "error".
+	Result string `json:"result"`
+	URL    string `json:"url,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+var assetsImagesUploadCmd = &cobra.Command{
+	Use:   "upload <file>...",
+	Short: "Upload images, reusing the ones the account already has",
+	Long: `Upload image files to the ad account's library and print their hashes for
+use in creatives (image_hash).
+
+Meta identifies an image by the MD5 hash of its bytes, so each file is hashed
+locally first: files the account already has are reported as "reused" and not
+sent again, which keeps creative pipelines idempotent. The command exits with
+status 1 if any upload fails.
+
+Examples:
+  meta-ads assets images upload hero.jpg -a act_123456789
+  meta-ads assets images upload creatives/*.png --json`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runAssetsImagesUpload,
+}
+
+func init() {
+	assetsImagesCmd.AddCommand(assetsImagesUploadCmd)
+}
+
+// imageFileHash returns the MD5 hash Meta gives an image with data.
+func imageFileHash(data []byte) string {
+	sum := md5.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// existingImageHashes returns which of hashes are already in the library of
+// account.
+func existingImageHashes(account string, hashes []string) (map[string]bool, error) {
+	existing := map[string]bool{}
+	const chunk = 50
+	for i := 0; i < len(hashes); i += chunk {
+		end := min(i+chunk, len(hashes))
+		list, _ := json.Marshal(hashes[i:end])
+		params := url.Values{}
+		params.Set("fields", "hash")
+		params.Set("hashes", string(list))
+		items, err := client.GetAll("/"+account+"/adimages", params)
+		if err != nil {
+			return nil, err
+		}
+		for _, raw := range items {
+			var img struct {
+				Hash string `json:"hash"`
+			}
+			if json.Unmarshal(raw, &img) == nil && img.Hash != "" {
+				existing[img.Hash] = true
+			}
+		}
+	}
+	return existing, nil
+}
+
+// uploadImage uploads data to the library of account and returns its hash and URL.
+func uploadImage(account, name string, data []byte) (hash, imageURL string, err error) {
+	body := url.Values{}
+	body.Set("bytes", base64.StdEncoding.EncodeToString(data))
+	body.Set("name", name)
+	resp, err := client.Post("/"+account+"/adimages", body)
+	if err != nil {
+		return "", "", err
+	}
+	var result struct {
+		Images map[string]struct {
+			Hash string `json:"hash"`
+			URL  string `json:"url"`
+		} `json:"images"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return "", "", fmt.Errorf("parsing response: %w", err)
+	}
+	for _, img := range result.Images {
+		return img.Hash, img.URL, nil
+	}
+	return "", "", fmt.Errorf("the response has no image hash")
+}
+
+func runAssetsImagesUpload(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+
+	results := make([]imageUpload, len(args))
+	contents := make([][]byte, len(args))
+	var hashes []string
+	for i, path := range args {
+		results[i].File = path
+		data, err := os.ReadFile(path)
+		if err != nil {
+			results[i].Result = "error"
+			results[i].Error = err.Error()
+			continue
+		}
+		contents[i] = data
+		results[i].Hash = imageFileHash(data)
+		hashes = append(hashes, results[i].Hash)
+	}
+	existing, err := existingImageHashes(account, hashes)
+	if err != nil {
+		return err
+	}
+
+	// Identical files are uploaded once; see below.
+	var total, sent int64
+	uploads, pending := 0, map[string]bool{}
+	for i, r := range results {
+		if r.Result != "error" && !existing[r.Hash] && !pending[r.Hash] {
+			total += int64(len(contents[i]))
+			uploads++
+			pending[r.Hash] = true
+		}
+	}
+
+	failed, uploaded := 0, 0
+	for i := range results {
+		r := &results[i]
+		switch {
+		case r.Result == "error":
+		case existing[r.Hash]:
+			r.Result = "reused"
+		default:
+			progressLine.Update("Uploading images %s %d of %d (%s of %s)", output.ProgressBar(sent, total, 20),
+				uploaded+1, uploads, formatBytes(sent), formatBytes(total))
+			sent += int64(len(contents[i]))
+			uploaded++
+			hash, imageURL, err := uploadImage(account, filepath.Base(r.File), contents[i])
+			if err != nil {
+				r.Result = "error"
+				r.Error = err.Error()
+				break
+			}
+			if hash != r.Hash {
+				progressLine.Clear()
+				fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("warning: Meta hashed %s as %s, not %s", r.File, hash, r.Hash)))
+			}
+			r.Hash, r.URL, r.Result = hash, imageURL, "uploaded"
+			// Identical files later in the list reuse this upload.
+			existing[hash] = true
+		}
+		if r.Result == "error" {
+			failed++
+		}
+	}
+	progressLine.Clear()
+
+	if !output.IsTable(cmd) {
+		if err := output.Print(cmd, results); err != nil {
+			return err
+		}
+	} else {
+		rows := make([][]string, len(results))
+		for i, r := range results {
+			result := r.Result
+			if r.Error != "" {
+				result = "error: " + r.Error
+			}
+			rows[i] = []string{output.Truncate(r.File, 40), orDash(r.Hash), result}
+		}
+		output.PrintTable([]string{"FILE", "HASH", "RESULT"}, rows)
+	}
+	if failed > 0 {
+		return partialFailure("%d of %d image(s) failed to upload", failed, len(results))
+	}
+	return nil
+}
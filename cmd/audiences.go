@@ -34,6 +34,11 @@ var audiencesGetCmd = &cobra.Command{
 func init() {
 	audiencesGetCmd.Flags().StringVar(&audienceGetFields, "fields", "", "Comma-separated fields to request from the API (overrides defaults)")
 
+	addColumnsFlag(audiencesListCmd, audienceColumns)
+	addCountFlag(audiencesListCmd)
+	addPagingFlags(audiencesListCmd)
+	addLimitFlag(audiencesListCmd, "audiences")
+
 	audiencesCmd.AddCommand(audiencesListCmd, audiencesGetCmd)
 	rootCmd.AddCommand(audiencesCmd)
 }
@@ -45,45 +50,43 @@ func runAudiencesList(cmd *cobra.Command, args []string) error {
 	}
 
 	fields := "id,name,subtype,approximate_count_lower_bound,approximate_count_upper_bound,delivery_status,description,time_content_updated"
+	fields, err = audienceColumns.fields(fields)
+	if err != nil {
+		return err
+	}
 	params := url.Values{}
 	params.Set("fields", fields)
 
-	items, err := client.GetAll("/"+account+"/customaudiences", params)
-	if err != nil {
+	audiences, streamed, err := listAll[api.Audience](cmd, "/"+account+"/customaudiences", params, "audience", nil)
+	if err != nil || streamed {
 		return err
 	}
 
-	audiences := make([]api.Audience, 0, len(items))
-	for _, raw := range items {
-		var a api.Audience
-		if err := json.Unmarshal(raw, &a); err != nil {
-			return fmt.Errorf("parsing audience: %w", err)
-		}
-		audiences = append(audiences, a)
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, audiences)
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(audiences, prettyFlag)
-	}
+	return audienceColumns.print(audiences)
+}
 
-	headers := []string{"ID", "NAME", "SUBTYPE", "SIZE (LOW)", "SIZE (HIGH)", "STATUS"}
-	rows := make([][]string, len(audiences))
-	for i, a := range audiences {
-		deliveryStatus := ""
-		if a.DeliveryStatus != nil {
-			deliveryStatus = a.DeliveryStatus.Description
-		}
-		rows[i] = []string{
-			a.ID,
-			output.Truncate(a.Name, 40),
-			a.Subtype,
-			formatCount(a.ApproximateCountLowerBound),
-			formatCount(a.ApproximateCountUpperBound),
-			output.Truncate(deliveryStatus, 30),
-		}
-	}
-	output.PrintTable(headers, rows)
-	return nil
+// audienceColumns are the columns selectable with audiences list --columns.
+var audienceColumns = columnSet[api.Audience]{
+	columns: []tableColumn[api.Audience]{
+		{name: "id", header: "ID", value: func(a api.Audience) string { return a.ID }},
+		{name: "name", header: "NAME", value: func(a api.Audience) string { return output.Truncate(a.Name, 40) }},
+		{name: "subtype", header: "SUBTYPE", value: func(a api.Audience) string { return a.Subtype }},
+		{name: "size_low", header: "SIZE (LOW)", field: "approximate_count_lower_bound", value: func(a api.Audience) string { return formatCount(a.ApproximateCountLowerBound) }},
+		{name: "size_high", header: "SIZE (HIGH)", field: "approximate_count_upper_bound", value: func(a api.Audience) string { return formatCount(a.ApproximateCountUpperBound) }},
+		{name: "status", header: "STATUS", field: "delivery_status", value: func(a api.Audience) string {
+			if a.DeliveryStatus == nil {
+				return ""
+			}
+			return output.Truncate(a.DeliveryStatus.Description, 30)
+		}},
+		{name: "description", header: "DESCRIPTION", value: func(a api.Audience) string { return output.Truncate(a.Description, 40) }},
+		{name: "updated", header: "UPDATED", field: "time_content_updated", value: func(a api.Audience) string { return output.FormatTime(a.TimeContentUpdated.String()) }},
+	},
+	defaults: []string{"id", "name", "subtype", "size_low", "size_high", "status"},
 }
 
 func runAudiencesGet(cmd *cobra.Command, args []string) error {
@@ -100,8 +103,8 @@ func runAudiencesGet(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(json.RawMessage(body), prettyFlag)
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, json.RawMessage(body))
 	}
 
 	var a api.Audience
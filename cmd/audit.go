@@ -0,0 +1,346 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	auditSince  string
+	auditLimit  int
+	auditObject string
+)
+
+var auditCmd = &cobra.Command{
+	Use:   "audit",
+	Short: "Review the local log of changes made through meta-ads",
+	Long: `Every POST and DELETE sent to the Meta API (creates, updates, pauses,
+deletes, batch rows) is appended to audit.jsonl next to the config file, one
+JSON object per line: time, user, account, command, method, path, object ID,
+changed fields (secrets redacted) and result. Dry runs are not logged.
+
+Examples:
+  meta-ads audit list --since 2026-03-01
+  meta-ads audit list --account shop --limit 20
+  meta-ads audit search "daily_budget"
+  meta-ads audit search --object 120210000000000`,
+}
+
+var auditListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List logged changes, newest first",
+	Args:  cobra.NoArgs,
+	RunE:  runAuditList,
+}
+
+var auditSearchCmd = &cobra.Command{
+	Use:   "search [text]",
+	Short: "Find logged changes containing text (case-insensitive) or touching an object",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runAuditList,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{auditListCmd, auditSearchCmd} {
+		c.Flags().StringVar(&auditSince, "since", "", "Only changes from this date (YYYY-MM-DD or RFC 3339)")
+		c.Flags().IntVar(&auditLimit, "limit", 50, "Max number of changes to show (0 = all)")
+	}
+	auditSearchCmd.Flags().StringVar(&auditObject, "object", "", "Only changes of this object ID")
+	auditCmd.AddCommand(auditListCmd, auditSearchCmd)
+	rootCmd.AddCommand(auditCmd)
+}
+
+// auditEntry is one line of the audit log.
+type auditEntry struct {
+	ID       string            `json:"id"`
+	Time     string            `json:"time"`
+	User     string            `json:"user,omitempty"`
+	Account  string            `json:"account,omitempty"`
+	Command  string            `json:"command"`
+	Method   string            `json:"method"`
+	Path     string            `json:"path"`
+	ObjectID string            `json:"object_id,omitempty"`
+	Fields   map[string]string `json:"fields,omitempty"`
+	Result   string            `json:"result"`
+	Error    string            `json:"error,omitempty"`
+	// Previous holds the values the change overwrote, for undo.
+	Previous map[string]string `json:"previous,omitempty"`
+	// UndoOf is the ID of the entry this change reverted.
+	UndoOf string `json:"undo_of,omitempty"`
+}
+
+// undoableFields are the fields whose previous value is captured before an
+// update, so undo can restore them.
+var undoableFields = []string{"status", "name", "daily_budget", "lifetime_budget", "bid_amount", "bid_strategy", "bid_constraints", "targeting", "spend_cap", "end_time"}
+
+// auditUndoOf is the ID of the entry being reverted by undo, recorded in the
+// entries of its changes.
+var auditUndoOf string
+
+// startAuditLog makes the client append every mutation of cmd to the audit log.
+func startAuditLog(cmd *cobra.Command) {
+	path := config.AuditLogPath()
+	if path == "" {
+		return
+	}
+	command := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	account, _ := resolveAccount()
+	user := ""
+	warned := false
+	// previous holds the values captured before the pending update of each path.
+	previous := map[string]map[string]string{}
+	client.BeforeMutation(func(m api.Mutation) {
+		if v := previousValues(m); v != nil {
+			previous[m.Path] = v
+		}
+	})
+	client.OnMutation(func(m api.Mutation) {
+		if user == "" {
+			user = auditUser()
+		}
+		e := newAuditEntry(m, command, account, user)
+		e.Previous = previous[m.Path]
+		delete(previous, m.Path)
+		if err := appendAuditEntry(path, e); err != nil && !warned {
+			warned = true
+			fmt.Fprintln(os.Stderr, output.Warn("warning: could not write the audit log: "+err.Error()))
+		}
+	})
+}
+
+// newAuditEntry describes mutation m. The account is the one in the path
+// when there is one, else the account the command ran against.
+func newAuditEntry(m api.Mutation, command, account, user string) auditEntry {
+	now := time.Now()
+	e := auditEntry{
+		ID:      strconv.FormatInt(now.UnixNano(), 36),
+		Time:    now.UTC().Format(time.RFC3339),
+		User:    user,
+		Account: account,
+		Command: command,
+		Method:  m.Method,
+		Path:    m.Path,
+		Result:  "ok",
+		UndoOf:  auditUndoOf,
+	}
+	segments := strings.Split(strings.Trim(m.Path, "/"), "/")
+	if strings.HasPrefix(segments[0], "act_") {
+		e.Account = segments[0]
+	}
+	e.ObjectID = segments[0]
+	if len(segments) > 1 && m.Method == "POST" {
+		// Creations (POST /parent/edge) return the new object's ID.
+		var created struct {
+			ID string `json:"id"`
+		}
+		if json.Unmarshal(m.Response, &created) == nil && created.ID != "" {
+			e.ObjectID = created.ID
+		}
+	}
+	if len(m.Params) > 0 {
+		e.Fields = make(map[string]string, len(m.Params))
+		for k := range m.Params {
+			v := m.Params.Get(k)
+			if api.IsSecretParam(k) {
+				v = "[redacted]"
+			}
+			if k == "bytes" {
+				// Uploaded file contents would bloat the log.
+				v = fmt.Sprintf("[%d base64 characters]", len(v))
+			}
+			e.Fields[k] = v
+		}
+	}
+	if m.Err != nil {
+		e.Result = "error"
+		e.Error = m.Err.Error()
+	}
+	return e
+}
+
+// previousValues fetches the current values of the undoable fields an
+// update of a single object (POST /<id>) is about to change, or nil.
+func previousValues(m api.Mutation) map[string]string {
+	id := strings.Trim(m.Path, "/")
+	if m.Method != "POST" || id == "" || strings.Contains(id, "/") {
+		return nil
+	}
+	var fields []string
+	for _, f := range undoableFields {
+		if _, ok := m.Params[f]; ok {
+			fields = append(fields, f)
+		}
+	}
+	if len(fields) == 0 {
+		return nil
+	}
+	var current map[string]json.RawMessage
+	if err := getObject(id, strings.Join(fields, ","), &current); err != nil {
+		return nil
+	}
+	values := make(map[string]string, len(fields))
+	for _, f := range fields {
+		raw := current[f]
+		var v string
+		if json.Unmarshal(raw, &v) != nil && len(raw) > 0 && string(raw) != "null" {
+			v = string(raw)
+		}
+		values[f] = v
+	}
+	return values
+}
+
+// auditUser returns "name (id)" of the token owner, or the stored user name.
+func auditUser() string {
+	var me struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	}
+	params := url.Values{}
+	params.Set("fields", "id,name")
+	if body, err := client.Get("/me", params); err == nil && json.Unmarshal(body, &me) == nil && me.ID != "" {
+		return fmt.Sprintf("%s (%s)", me.Name, me.ID)
+	}
+	loadConfig()
+	if cfg != nil && cfg.UserName != "" {
+		return cfg.UserName
+	}
+	return "unknown"
+}
+
+// appendAuditEntry appends e as one JSON line to the log at path.
+func appendAuditEntry(path string, e auditEntry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+func runAuditList(cmd *cobra.Command, args []string) error {
+	var since time.Time
+	if auditSince != "" {
+		ts, err := parseTimeFlag(auditSince, "since")
+		if err != nil {
+			return err
+		}
+		since = time.Unix(ts, 0)
+	}
+	account := ""
+	if accountFlag != "" {
+		account = accountID(accountFlag)
+	}
+	query := ""
+	if len(args) > 0 {
+		query = strings.ToLower(args[0])
+	}
+
+	entries, err := readAuditLog(config.AuditLogPath(), func(line string, e auditEntry) bool {
+		if t, err := time.Parse(time.RFC3339, e.Time); err == nil && t.Before(since) {
+			return false
+		}
+		if account != "" && e.Account != account {
+			return false
+		}
+		if auditObject != "" && e.ObjectID != auditObject && !strings.Contains(e.Path, "/"+auditObject) {
+			return false
+		}
+		return query == "" || strings.Contains(strings.ToLower(line), query)
+	})
+	if err != nil {
+		return err
+	}
+
+	// Newest first.
+	for i, j := 0, len(entries)-1; i < j; i, j = i+1, j-1 {
+		entries[i], entries[j] = entries[j], entries[i]
+	}
+	if auditLimit > 0 && len(entries) > auditLimit {
+		entries = entries[:auditLimit]
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, entries)
+	}
+	if len(entries) == 0 {
+		fmt.Println("No changes logged.")
+		return nil
+	}
+	rows := make([][]string, len(entries))
+	for i, e := range entries {
+		result := output.Green(e.Result)
+		if e.Error != "" {
+			result = output.Red(e.Result) + ": " + output.Truncate(e.Error, 40)
+		}
+		rows[i] = []string{e.ID, output.FormatTime(e.Time), orDash(e.User), orDash(e.Account), e.Command, orDash(e.ObjectID), output.Truncate(auditFields(e.Fields), 50), result}
+	}
+	output.PrintTable([]string{"ID", "TIME", "USER", "ACCOUNT", "COMMAND", "OBJECT", "CHANGES", "RESULT"}, rows)
+	return nil
+}
+
+// readAuditLog returns the entries of the log at path that keep accepts,
+// oldest first. A missing log has no entries.
+func readAuditLog(path string, keep func(line string, e auditEntry) bool) ([]auditEntry, error) {
+	entries := []auditEntry{}
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return entries, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 4*1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		var e auditEntry
+		if json.Unmarshal([]byte(line), &e) != nil {
+			continue
+		}
+		if keep(line, e) {
+			entries = append(entries, e)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return entries, nil
+}
+
+// auditFields renders changed fields as "k=v k=v", sorted by key.
+func auditFields(fields map[string]string) string {
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + fields[k]
+	}
+	return strings.Join(parts, " ")
+}
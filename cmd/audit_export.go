@@ -433,7 +433,7 @@ func parsePeriod(p string) (string, string, error) {
 	case "1y", "12m", "12month", "12months":
 		return now.AddDate(-1, 0, 0).Format("2006-01-02"), end, nil
 	default:
-		return "", "", fmt.Errorf("invalid period %q — use 7d, 30d, 3m, 6m, 1y, etc.", p)
+		return "", "", usageError("invalid period %q — use 7d, 30d, 3m, 6m, 1y, etc.", p)
 	}
 }
 
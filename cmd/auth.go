@@ -15,6 +15,7 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/metaauth"
 )
 
 const (
@@ -28,6 +29,7 @@ const (
 
 var authSetTokenNoExtend bool
 var authExtendTokenSave  bool
+var authShared           bool
 
 // ── command definitions ───────────────────────────────────────────────────────
 
@@ -120,6 +122,9 @@ var authStatusCmd = &cobra.Command{
 		if c.DefaultAccount != "" {
 			fmt.Printf("  Default account: %s\n", c.DefaultAccount)
 		}
+		if c.IsEncrypted() {
+			fmt.Println("  Encrypted:       yes")
+		}
 		fmt.Printf("  Config:          %s\n", config.Path())
 		return nil
 	},
@@ -128,6 +133,8 @@ var authStatusCmd = &cobra.Command{
 func init() {
 	authSetTokenCmd.Flags().BoolVar(&authSetTokenNoExtend, "no-extend", false, "Skip upgrading to long-lived token even if app credentials are available")
 	authExtendTokenCmd.Flags().BoolVar(&authExtendTokenSave, "save", false, "Save the long-lived token to config (replaces current token)")
+	authLoginCmd.Flags().BoolVar(&authShared, "shared", false, "Also write the token to the shared meta-auth config used by other Meta CLIs")
+	authSetTokenCmd.Flags().BoolVar(&authShared, "shared", false, "Also write the token to the shared meta-auth config used by other Meta CLIs")
 
 	authCmd.AddCommand(authLoginCmd, authSetTokenCmd, authExtendTokenCmd, authLogoutCmd, authStatusCmd)
 	rootCmd.AddCommand(authCmd)
@@ -233,7 +240,12 @@ func runAuthLogin(cmd *cobra.Command, args []string) error {
 	}
 
 	// 9. Save config
-	existingCfg, _ := config.Load()
+	// A config that can't be read (e.g. a wrong passphrase) isn't replaced:
+	// its account sections, preferences and encryption would be lost.
+	existingCfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config, not overwriting it: %w", err)
+	}
 	newCfg := &config.Config{
 		AccessToken: longToken,
 		TokenType:   config.TokenTypeOAuth,
@@ -242,9 +254,7 @@ func runAuthLogin(cmd *cobra.Command, args []string) error {
 		AppID:       appID,
 		AppSecret:   appSecret,
 	}
-	if existingCfg != nil {
-		newCfg.DefaultAccount = existingCfg.DefaultAccount
-	}
+	newCfg.KeepSettings(existingCfg)
 	if err := config.Save(newCfg); err != nil {
 		return fmt.Errorf("failed to save config: %w", err)
 	}
@@ -252,6 +262,9 @@ func runAuthLogin(cmd *cobra.Command, args []string) error {
 	fmt.Printf("\n✓ Logged in as %s (ID: %s)\n", userName, userID)
 	fmt.Printf("  Token type: %s\n", config.TokenTypeOAuth)
 	fmt.Printf("  Token saved to: %s\n", config.Path())
+	if authShared {
+		return saveSharedToken(longToken, userName, config.TokenTypeOAuth)
+	}
 	return nil
 }
 
@@ -287,7 +300,10 @@ func runAuthSetToken(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("token validation failed: %w", err)
 	}
 
-	existingCfg, _ := config.Load()
+	existingCfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config, not overwriting it: %w", err)
+	}
 	newCfg := &config.Config{
 		AccessToken: finalToken,
 		TokenType:   tokenType,
@@ -296,8 +312,8 @@ func runAuthSetToken(cmd *cobra.Command, args []string) error {
 		AppID:       appID,
 		AppSecret:   appSecret,
 	}
+	newCfg.KeepSettings(existingCfg)
 	if existingCfg != nil {
-		newCfg.DefaultAccount = existingCfg.DefaultAccount
 		if newCfg.AppID == "" {
 			newCfg.AppID = existingCfg.AppID
 		}
@@ -313,6 +329,9 @@ func runAuthSetToken(cmd *cobra.Command, args []string) error {
 	fmt.Printf("\n✓ Token saved — logged in as %s (ID: %s)\n", userName, userID)
 	fmt.Printf("  Token type: %s\n", tokenType)
 	fmt.Printf("  Config:     %s\n", config.Path())
+	if authShared {
+		return saveSharedToken(finalToken, userName, tokenType)
+	}
 	return nil
 }
 
@@ -340,7 +359,10 @@ func runAuthExtendToken(cmd *cobra.Command, args []string) error {
 			return fmt.Errorf("token validation failed: %w", err)
 		}
 
-		existingCfg, _ := config.Load()
+		existingCfg, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config, not overwriting it: %w", err)
+	}
 		newCfg := &config.Config{
 			AccessToken: longToken,
 			TokenType:   config.TokenTypeLongLived,
@@ -349,9 +371,7 @@ func runAuthExtendToken(cmd *cobra.Command, args []string) error {
 			AppID:       appID,
 			AppSecret:   appSecret,
 		}
-		if existingCfg != nil {
-			newCfg.DefaultAccount = existingCfg.DefaultAccount
-		}
+		newCfg.KeepSettings(existingCfg)
 		if err := config.Save(newCfg); err != nil {
 			return fmt.Errorf("failed to save config: %w", err)
 		}
@@ -369,6 +389,21 @@ func runAuthExtendToken(cmd *cobra.Command, args []string) error {
 
 // ── helpers ───────────────────────────────────────────────────────────────────
 
+// saveSharedToken writes token to the shared meta-auth config (auth --shared).
+// Long-lived tokens are recorded as expiring in 60 days.
+func saveSharedToken(token, userName string, tokenType config.TokenType) error {
+	var expiresAt int64
+	if tokenType == config.TokenTypeOAuth || tokenType == config.TokenTypeLongLived {
+		expiresAt = time.Now().Add(60 * 24 * time.Hour).Unix()
+	}
+	if err := metaauth.Save(token, userName, expiresAt); err != nil {
+		return fmt.Errorf("failed to write shared config: %w", err)
+	}
+	path, _ := metaauth.Path()
+	fmt.Printf("  Shared config:  %s\n", path)
+	return nil
+}
+
 // resolveAppCredentials returns appID and appSecret from env vars, falling back to stored config.
 func resolveAppCredentials() (appID, appSecret string) {
 	appID = os.Getenv("META_APP_ID")
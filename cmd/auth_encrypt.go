@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"golang.org/x/term"
+)
+
+var authEncryptConfigCmd = &cobra.Command{
+	Use:   "encrypt-config",
+	Short: "Encrypt the stored token and app secret with a passphrase",
+	Long: `Encrypts the access token and app secret in the config file with a passphrase
+(AES-256-GCM, key derived with scrypt). Other settings stay readable.
+
+Every command that needs the token then reads the passphrase from
+META_ADS_PASSPHRASE, or prompts for it when run in a terminal.
+
+Examples:
+  meta-ads auth encrypt-config
+  META_ADS_PASSPHRASE=... meta-ads campaigns list
+  meta-ads auth decrypt-config`,
+	Args: cobra.NoArgs,
+	RunE: runAuthEncryptConfig,
+}
+
+var authDecryptConfigCmd = &cobra.Command{
+	Use:   "decrypt-config",
+	Short: "Store the token and app secret unencrypted again",
+	Args:  cobra.NoArgs,
+	RunE:  runAuthDecryptConfig,
+}
+
+func init() {
+	config.PassphrasePrompt = promptConfigPassphrase
+
+	authCmd.AddCommand(authEncryptConfigCmd, authDecryptConfigCmd)
+}
+
+// promptConfigPassphrase asks for the config passphrase on the terminal.
+// It returns "" when stdin is not a terminal.
+func promptConfigPassphrase() (string, error) {
+	return readPassphrase("Config passphrase: ")
+}
+
+// readPassphrase reads a line from the terminal without echoing it.
+func readPassphrase(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return "", nil
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	b, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", fmt.Errorf("reading passphrase: %w", err)
+	}
+	return string(b), nil
+}
+
+func runAuthEncryptConfig(cmd *cobra.Command, args []string) error {
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if c.AccessToken == "" {
+		return fmt.Errorf("no token stored — run: meta-ads auth login")
+	}
+	if c.IsEncrypted() {
+		return fmt.Errorf("config is already encrypted — run auth decrypt-config first to change the passphrase")
+	}
+
+	passphrase := os.Getenv(config.EnvPassphrase)
+	if passphrase == "" {
+		if passphrase, err = readPassphrase("New passphrase: "); err != nil {
+			return err
+		}
+		if passphrase == "" {
+			return fmt.Errorf("no passphrase — set %s or run in a terminal", config.EnvPassphrase)
+		}
+		confirm, err := readPassphrase("Repeat passphrase: ")
+		if err != nil {
+			return err
+		}
+		if confirm != passphrase {
+			return fmt.Errorf("passphrases don't match")
+		}
+	}
+
+	if err := c.Encrypt(passphrase); err != nil {
+		return err
+	}
+	if err := config.Save(c); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	fmt.Printf("✓ Token and app secret encrypted in %s\n", config.Path())
+	fmt.Printf("  Set %s to avoid the passphrase prompt in scripts.\n", config.EnvPassphrase)
+	return nil
+}
+
+func runAuthDecryptConfig(cmd *cobra.Command, args []string) error {
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if !c.IsEncrypted() {
+		fmt.Println("Config is not encrypted.")
+		return nil
+	}
+	c.Decrypt()
+	if err := config.Save(c); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	fmt.Printf("✓ Config decrypted: %s\n", config.Path())
+	return nil
+}
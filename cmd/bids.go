@@ -0,0 +1,349 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	bidStrategy string
+	bidCap      int64
+	bidMinROAS  float64
+)
+
+// bidStrategies maps the accepted --strategy spellings to Meta's bid strategies.
+var bidStrategies = map[string]string{
+	"LOWEST_COST_WITHOUT_CAP":   "LOWEST_COST_WITHOUT_CAP",
+	"LOWEST_COST":               "LOWEST_COST_WITHOUT_CAP",
+	"LOWEST_COST_WITH_BID_CAP":  "LOWEST_COST_WITH_BID_CAP",
+	"BID_CAP":                   "LOWEST_COST_WITH_BID_CAP",
+	"COST_CAP":                  "COST_CAP",
+	"LOWEST_COST_WITH_MIN_ROAS": "LOWEST_COST_WITH_MIN_ROAS",
+	"MIN_ROAS":                  "LOWEST_COST_WITH_MIN_ROAS",
+}
+
+const bidHelp = `Strategies and the flags they take:
+  LOWEST_COST_WITHOUT_CAP    (lowest-cost)  no cap
+  LOWEST_COST_WITH_BID_CAP   (bid-cap)      --cap: max bid per auction, in cents
+  COST_CAP                   (cost-cap)     --cap: target cost per result, in cents
+  LOWEST_COST_WITH_MIN_ROAS  (min-roas)     --min-roas: ROAS floor, e.g. 2.5;
+                                            ad sets must optimize for VALUE
+
+Without --strategy, --cap or --min-roas changes the cap or floor of the
+current strategy.`
+
+var adsetsSetBidCmd = &cobra.Command{
+	Use:   "set-bid [adset_id]",
+	Short: "Change the bid strategy, cost or bid cap, or ROAS floor of an ad set",
+	Long: `Change how an ad set bids.
+
+` + bidHelp + `
+
+Ad sets of a campaign with a campaign budget share its bid strategy: change
+the strategy with campaigns set-bid, and the cap or floor here.
+
+Examples:
+  meta-ads adsets set-bid 120210000000001 --strategy COST_CAP --cap 1500
+  meta-ads adsets set-bid 120210000000001 --cap 1800
+  meta-ads adsets set-bid 120210000000001 --strategy min-roas --min-roas 2.5`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAdsetsSetBid,
+}
+
+var campaignsSetBidCmd = &cobra.Command{
+	Use:   "set-bid [campaign_id]",
+	Short: "Change the bid strategy of a campaign budget and the caps of its ad sets",
+	Long: `Change how a campaign with a campaign budget (Advantage+ campaign budget)
+bids. The strategy is set on the campaign; --cap or --min-roas is set on each
+of its ad sets, where Meta keeps them. Campaigns without a campaign budget bid
+per ad set: use adsets set-bid.
+
+` + bidHelp + `
+
+Examples:
+  meta-ads campaigns set-bid 120210000000000 --strategy cost-cap --cap 1500
+  meta-ads campaigns set-bid 120210000000000 --strategy lowest-cost`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runCampaignsSetBid,
+}
+
+func init() {
+	for _, c := range []*cobra.Command{adsetsSetBidCmd, campaignsSetBidCmd} {
+		c.Flags().StringVar(&bidStrategy, "strategy", "", "Bid strategy: lowest-cost, bid-cap, cost-cap, min-roas (or Meta's names)")
+		c.Flags().Int64Var(&bidCap, "cap", 0, "Bid cap or cost cap in cents (e.g. 1500 = $15.00)")
+		c.Flags().Float64Var(&bidMinROAS, "min-roas", 0, "Minimum ROAS, e.g. 2.5")
+		c.MarkFlagsMutuallyExclusive("cap", "min-roas")
+		addFromJSONFlag(c, jsonBody{
+			fields: []string{"bid_strategy", "bid_amount", "bid_constraints"},
+			flags:  []string{"strategy", "cap", "min-roas"},
+		})
+		_ = c.RegisterFlagCompletionFunc("strategy", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+			return []string{"lowest-cost", "bid-cap", "cost-cap", "min-roas"}, cobra.ShellCompDirectiveNoFileComp
+		})
+	}
+	adsetsSetBidCmd.ValidArgsFunction = completeObjects("adset")
+	campaignsSetBidCmd.ValidArgsFunction = completeObjects("campaign")
+	addNameFlags(adsetsSetBidCmd, "name", true)
+	addNameFlags(campaignsSetBidCmd, "name", true)
+
+	adsetsCmd.AddCommand(adsetsSetBidCmd)
+	campaignsCmd.AddCommand(campaignsSetBidCmd)
+}
+
+// parseBidStrategy returns Meta's name of the --strategy value ("" when unset).
+func parseBidStrategy(v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+	s, ok := bidStrategies[strings.ToUpper(strings.ReplaceAll(v, "-", "_"))]
+	if !ok {
+		return "", usageError("invalid --strategy %q: use lowest-cost, bid-cap, cost-cap or min-roas", v)
+	}
+	return s, nil
+}
+
+// bidFromJSON sets --strategy, --cap and --min-roas from a --from-json body
+// with the ad set's bid fields: bid_strategy, bid_amount, and bid_constraints
+// with its roas_average_floor.
+func bidFromJSON(cmd *cobra.Command) error {
+	body, err := fromJSONBody(cmd)
+	if body == nil || err != nil {
+		return err
+	}
+	bidStrategy = body.Get("bid_strategy")
+	if v := body.Get("bid_amount"); v != "" {
+		if bidCap, err = strconv.ParseInt(v, 10, 64); err != nil {
+			return usageError("--from-json: bid_amount must be an amount in cents")
+		}
+	}
+	if v := body.Get("bid_constraints"); v != "" {
+		var constraints struct {
+			ROASFloor *int64 `json:"roas_average_floor"`
+		}
+		if json.Unmarshal([]byte(v), &constraints) != nil || constraints.ROASFloor == nil {
+			return usageError(`--from-json: bid_constraints must be {"roas_average_floor": <hundredths of a percent>}`)
+		}
+		bidMinROAS = float64(*constraints.ROASFloor) / 10000
+	}
+	return nil
+}
+
+// bidParams returns the ad set fields setting the --cap or --min-roas of
+// strategy, after checking that Meta accepts the combination. goals are the
+// optimization goals of the ad sets concerned.
+func bidParams(strategy string, goals ...string) (url.Values, error) {
+	body := url.Values{}
+	switch strategy {
+	case "LOWEST_COST_WITHOUT_CAP":
+		if bidCap > 0 || bidMinROAS > 0 {
+			return nil, fmt.Errorf("%s takes no --cap or --min-roas", strategy)
+		}
+	case "LOWEST_COST_WITH_BID_CAP", "COST_CAP":
+		if bidMinROAS > 0 {
+			return nil, fmt.Errorf("%s takes --cap, not --min-roas", strategy)
+		}
+		if bidCap <= 0 {
+			return nil, fmt.Errorf("%s needs --cap <cents>", strategy)
+		}
+		body.Set("bid_amount", strconv.FormatInt(bidCap, 10))
+	case "LOWEST_COST_WITH_MIN_ROAS":
+		if bidCap > 0 {
+			return nil, fmt.Errorf("%s takes --min-roas, not --cap", strategy)
+		}
+		if bidMinROAS <= 0 {
+			return nil, fmt.Errorf("%s needs --min-roas, e.g. 2.5", strategy)
+		}
+		for _, g := range goals {
+			if g != "" && g != "VALUE" {
+				return nil, fmt.Errorf("%s needs ad sets optimizing for VALUE, not %s", strategy, g)
+			}
+		}
+		// Meta expresses the floor in hundredths of a percent: 2.5 → 25000.
+		body.Set("bid_constraints", fmt.Sprintf(`{"roas_average_floor":%d}`, int64(math.Round(bidMinROAS*10000))))
+	case "":
+		return nil, fmt.Errorf("the current bid strategy is unknown — pass --strategy")
+	default:
+		return nil, fmt.Errorf("bid strategy %s can't be changed with set-bid — pass --strategy", strategy)
+	}
+	return body, nil
+}
+
+// bidSummary describes the bid set by body for table output.
+func bidSummary(strategy string, body url.Values) string {
+	s := strategy
+	if v := body.Get("bid_amount"); v != "" {
+		s += " cap " + output.FormatBudget(v)
+	}
+	if bidMinROAS > 0 {
+		s += fmt.Sprintf(" min ROAS %.2f", bidMinROAS)
+	}
+	return s
+}
+
+func runAdsetsSetBid(cmd *cobra.Command, args []string) error {
+	if err := bidFromJSON(cmd); err != nil {
+		return err
+	}
+	strategy, err := parseBidStrategy(bidStrategy)
+	if err != nil {
+		return err
+	}
+	if strategy == "" && bidCap == 0 && bidMinROAS == 0 {
+		return fmt.Errorf("nothing to change — use --strategy, --cap or --min-roas")
+	}
+	ids, err := objectIDs(cmd, args, "adset")
+	if err != nil {
+		return err
+	}
+
+	var responses []json.RawMessage
+	for _, id := range ids {
+		var cur struct {
+			AccountID        string `json:"account_id"`
+			BidStrategy      string `json:"bid_strategy"`
+			OptimizationGoal string `json:"optimization_goal"`
+			Campaign         struct {
+				ID             string         `json:"id"`
+				BidStrategy    string         `json:"bid_strategy"`
+				DailyBudget    api.FlexString `json:"daily_budget"`
+				LifetimeBudget api.FlexString `json:"lifetime_budget"`
+			} `json:"campaign"`
+		}
+		if err := getObject(id, "account_id,bid_strategy,optimization_goal,campaign{id,bid_strategy,daily_budget,lifetime_budget}", &cur); err != nil {
+			return err
+		}
+		useAccountCurrency("act_" + cur.AccountID)
+		campaignBudget := cur.Campaign.DailyBudget.String() != "" || cur.Campaign.LifetimeBudget.String() != ""
+
+		target := strategy
+		if target == "" {
+			target = cur.BidStrategy
+			if campaignBudget && cur.Campaign.BidStrategy != "" {
+				target = cur.Campaign.BidStrategy
+			}
+		}
+		if campaignBudget && strategy != "" && cur.Campaign.BidStrategy != "" && strategy != cur.Campaign.BidStrategy {
+			return fmt.Errorf("ad set %s uses the bid strategy of campaign %s (%s) — change it with: meta-ads campaigns set-bid %s --strategy %s",
+				id, cur.Campaign.ID, cur.Campaign.BidStrategy, cur.Campaign.ID, strategy)
+		}
+		body, err := bidParams(target, cur.OptimizationGoal)
+		if err != nil {
+			return fmt.Errorf("ad set %s: %w", id, err)
+		}
+		if strategy != "" && !campaignBudget {
+			body.Set("bid_strategy", strategy)
+		}
+		if len(body) == 0 {
+			return fmt.Errorf("ad set %s already uses %s — nothing to change", id, target)
+		}
+
+		resp, err := client.Post("/"+id, body)
+		if err != nil {
+			return err
+		}
+		if output.IsTable(cmd) {
+			fmt.Printf("✓ Ad set %s bids %s\n", id, bidSummary(target, body))
+		}
+		responses = append(responses, resp)
+	}
+
+	if !output.IsTable(cmd) {
+		return printResponses(cmd, responses)
+	}
+	return nil
+}
+
+func runCampaignsSetBid(cmd *cobra.Command, args []string) error {
+	if err := bidFromJSON(cmd); err != nil {
+		return err
+	}
+	strategy, err := parseBidStrategy(bidStrategy)
+	if err != nil {
+		return err
+	}
+	if strategy == "" && bidCap == 0 && bidMinROAS == 0 {
+		return fmt.Errorf("nothing to change — use --strategy, --cap or --min-roas")
+	}
+	ids, err := objectIDs(cmd, args, "campaign")
+	if err != nil {
+		return err
+	}
+
+	var responses []json.RawMessage
+	for _, id := range ids {
+		var cur struct {
+			AccountID      string         `json:"account_id"`
+			BidStrategy    string         `json:"bid_strategy"`
+			DailyBudget    api.FlexString `json:"daily_budget"`
+			LifetimeBudget api.FlexString `json:"lifetime_budget"`
+		}
+		if err := getObject(id, "account_id,bid_strategy,daily_budget,lifetime_budget", &cur); err != nil {
+			return err
+		}
+		useAccountCurrency("act_" + cur.AccountID)
+		if cur.DailyBudget.String() == "" && cur.LifetimeBudget.String() == "" {
+			return fmt.Errorf("campaign %s has no campaign budget, so its ad sets bid separately — use: meta-ads adsets set-bid", id)
+		}
+		var adsets []struct {
+			ID               string `json:"id"`
+			OptimizationGoal string `json:"optimization_goal"`
+		}
+		if err := getAllInto("/"+id+"/adsets", "id,optimization_goal", &adsets); err != nil {
+			return err
+		}
+		goals := make([]string, len(adsets))
+		for i, a := range adsets {
+			goals[i] = a.OptimizationGoal
+		}
+
+		target := strategy
+		if target == "" {
+			target = cur.BidStrategy
+		}
+		caps, err := bidParams(target, goals...)
+		if err != nil {
+			return fmt.Errorf("campaign %s: %w", id, err)
+		}
+
+		if strategy != "" && strategy != cur.BidStrategy {
+			body := url.Values{}
+			body.Set("bid_strategy", strategy)
+			resp, err := client.Post("/"+id, body)
+			if err != nil {
+				return err
+			}
+			responses = append(responses, resp)
+		}
+		for _, a := range adsets {
+			if len(caps) == 0 {
+				break
+			}
+			resp, err := client.Post("/"+a.ID, maps.Clone(caps))
+			if err != nil {
+				return fmt.Errorf("ad set %s: %w", a.ID, err)
+			}
+			responses = append(responses, resp)
+		}
+		if output.IsTable(cmd) {
+			fmt.Printf("✓ Campaign %s bids %s", id, bidSummary(target, caps))
+			if len(caps) > 0 {
+				fmt.Printf(" (%d ad sets)", len(adsets))
+			}
+			fmt.Println()
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return printResponses(cmd, responses)
+	}
+	return nil
+}
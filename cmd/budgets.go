@@ -0,0 +1,410 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/monitor"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	budgetShiftFrom     string
+	budgetShiftTo       string
+	budgetShiftAmount   int64
+	budgetByPerformance bool
+	budgetCampaign      string
+	budgetMetric        string
+	budgetDatePreset    string
+	budgetShare         float64
+	budgetConversion    string
+	budgetApply         bool
+)
+
+var budgetsCmd = &cobra.Command{
+	Use:   "budgets",
+	Short: "Move budget between ad sets and check spend pacing",
+}
+
+var budgetsShiftCmd = &cobra.Command{
+	Use:   "shift",
+	Short: "Move budget from one ad set to another, or across a campaign by performance",
+	Long: `Move part of one ad set's budget to another, so the total spend stays the
+same. Both budgets are updated in a single batch request; if Meta applies one
+and rejects the other, the applied one is set back to its previous value.
+
+--by-performance proposes a reallocation across the active ad sets of
+--campaign instead: every ad set gives up --share percent of its budget, and
+the pooled amount is split in proportion to each ad set's ROAS (or 1/CPA with
+--metric cpa) over --date-preset. Ad sets without conversions get none of it.
+The proposal is only printed unless --apply is given.
+
+Amounts are in the account currency's minor units (cents), like the budget
+flags of adsets update-budget. Ad sets must both use daily or both use
+lifetime budgets.
+
+Examples:
+  meta-ads budgets shift --from 120210000000001 --to 120210000000002 --amount 2000
+  meta-ads budgets shift --by-performance --campaign 120210000000000
+  meta-ads budgets shift --by-performance --campaign 120210000000000 --metric cpa --share 30 --apply`,
+	Args: cobra.NoArgs,
+	RunE: runBudgetsShift,
+}
+
+func init() {
+	f := budgetsShiftCmd.Flags()
+	f.StringVar(&budgetShiftFrom, "from", "", "Ad set to take budget from")
+	f.StringVar(&budgetShiftTo, "to", "", "Ad set to give the budget to")
+	f.Int64Var(&budgetShiftAmount, "amount", 0, "Budget to move, in cents (e.g. 2000 = $20.00)")
+	f.BoolVar(&budgetByPerformance, "by-performance", false, "Propose a reallocation across --campaign's ad sets based on ROAS or CPA")
+	f.StringVar(&budgetCampaign, "campaign", "", "Campaign whose ad sets --by-performance reallocates")
+	f.StringVar(&budgetMetric, "metric", "roas", "Performance metric of --by-performance: roas or cpa")
+	f.StringVar(&budgetDatePreset, "date-preset", "last_7d", "Period performance is measured over (e.g. last_3d, last_7d, last_30d)")
+	f.Float64Var(&budgetShare, "share", 20, "Percent of each ad set's budget --by-performance redistributes")
+	f.StringVar(&budgetConversion, "conversion", monitor.DefaultConversion, "Action type counted as a conversion for --metric cpa")
+	f.BoolVar(&budgetApply, "apply", false, "Make the changes --by-performance proposes")
+	budgetsShiftCmd.MarkFlagsMutuallyExclusive("by-performance", "from")
+	budgetsShiftCmd.MarkFlagsMutuallyExclusive("by-performance", "to")
+	budgetsShiftCmd.MarkFlagsMutuallyExclusive("by-performance", "amount")
+	_ = budgetsShiftCmd.RegisterFlagCompletionFunc("from", completeObjectFlag("adset"))
+	_ = budgetsShiftCmd.RegisterFlagCompletionFunc("to", completeObjectFlag("adset"))
+	_ = budgetsShiftCmd.RegisterFlagCompletionFunc("campaign", completeObjectFlag("campaign"))
+
+	budgetsCmd.AddCommand(budgetsShiftCmd)
+	rootCmd.AddCommand(budgetsCmd)
+}
+
+// budgetAdSet is an ad set whose budget is moved.
+type budgetAdSet struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	EffectiveStatus string         `json:"effective_status"`
+	AccountID       string         `json:"account_id"`
+	DailyBudget     api.FlexString `json:"daily_budget"`
+	LifetimeBudget  api.FlexString `json:"lifetime_budget"`
+}
+
+const budgetAdSetFields = "id,name,effective_status,account_id,daily_budget,lifetime_budget"
+
+// budget returns the field holding the ad set's budget and its amount in
+// cents, or "" when it has none (its campaign's budget is used).
+func (a budgetAdSet) budget() (string, int64) {
+	if v, _ := strconv.ParseInt(a.DailyBudget.String(), 10, 64); v > 0 {
+		return "daily_budget", v
+	}
+	if v, _ := strconv.ParseInt(a.LifetimeBudget.String(), 10, 64); v > 0 {
+		return "lifetime_budget", v
+	}
+	return "", 0
+}
+
+// budgetChange is the new budget of one ad set.
+type budgetChange struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Field  string `json:"field"`
+	Before int64  `json:"before"`
+	After  int64  `json:"after"`
+	// Performance is the metric the --by-performance share is based on.
+	Performance string `json:"performance,omitempty"`
+	Result      string `json:"result,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+func runBudgetsShift(cmd *cobra.Command, args []string) error {
+	var (
+		changes []budgetChange
+		action  string
+		err     error
+	)
+	if budgetByPerformance {
+		if budgetCampaign == "" {
+			return usageError("--by-performance needs --campaign <campaign_id>")
+		}
+		changes, err = proposeBudgetShift()
+		if err != nil {
+			return err
+		}
+		action = fmt.Sprintf("Reallocate the budgets of %d ad sets", len(changes))
+	} else {
+		if budgetShiftFrom == "" || budgetShiftTo == "" || budgetShiftAmount <= 0 {
+			return usageError("pass --from, --to and a positive --amount, or --by-performance --campaign <campaign_id>")
+		}
+		if budgetShiftFrom == budgetShiftTo {
+			return usageError("--from and --to are the same ad set")
+		}
+		changes, err = directBudgetShift()
+		if err != nil {
+			return err
+		}
+		action = fmt.Sprintf("Move %s from %s %q to %s %q", output.FormatBudget(strconv.FormatInt(budgetShiftAmount, 10)),
+			changes[0].ID, changes[0].Name, changes[1].ID, changes[1].Name)
+	}
+
+	apply := !budgetByPerformance || budgetApply
+	if apply {
+		if err := confirm(action); err != nil {
+			return err
+		}
+		applyBudgetChanges(changes)
+	}
+
+	if !output.IsTable(cmd) {
+		if err := output.Print(cmd, changes); err != nil {
+			return err
+		}
+	} else {
+		printBudgetChanges(changes)
+	}
+
+	failed := 0
+	for _, c := range changes {
+		if c.Error != "" {
+			failed++
+		}
+	}
+	switch {
+	case failed > 0:
+		return partialFailure("%d of %d budget updates failed — see the summary above", failed, len(changes))
+	case !apply:
+		if output.IsTable(cmd) {
+			fmt.Println("Proposal only — run again with --apply to make these changes.")
+		}
+	case output.IsTable(cmd):
+		fmt.Printf("✓ Updated the budgets of %d ad sets\n", len(changes))
+	}
+	return nil
+}
+
+// directBudgetShift returns the changes of moving --amount from --from to --to.
+func directBudgetShift() ([]budgetChange, error) {
+	var from, to budgetAdSet
+	if err := getObject(budgetShiftFrom, budgetAdSetFields, &from); err != nil {
+		return nil, err
+	}
+	if err := getObject(budgetShiftTo, budgetAdSetFields, &to); err != nil {
+		return nil, err
+	}
+	useAccountCurrency("act_" + from.AccountID)
+
+	fromField, fromBudget := from.budget()
+	toField, toBudget := to.budget()
+	switch {
+	case fromField == "":
+		return nil, fmt.Errorf("ad set %s has no budget of its own (its campaign's budget is used)", from.ID)
+	case toField == "":
+		return nil, fmt.Errorf("ad set %s has no budget of its own (its campaign's budget is used)", to.ID)
+	case fromField != toField:
+		return nil, fmt.Errorf("ad set %s has a %s and %s a %s — both must use the same kind of budget", from.ID, strings.ReplaceAll(fromField, "_", " "), to.ID, strings.ReplaceAll(toField, "_", " "))
+	case budgetShiftAmount >= fromBudget:
+		return nil, usageError("--amount %s would leave ad set %s without budget (it has %s)",
+			output.FormatBudget(strconv.FormatInt(budgetShiftAmount, 10)), from.ID, output.FormatBudget(strconv.FormatInt(fromBudget, 10)))
+	}
+	return []budgetChange{
+		{ID: from.ID, Name: from.Name, Field: fromField, Before: fromBudget, After: fromBudget - budgetShiftAmount},
+		{ID: to.ID, Name: to.Name, Field: toField, Before: toBudget, After: toBudget + budgetShiftAmount},
+	}, nil
+}
+
+// proposeBudgetShift returns the --by-performance reallocation of the active
+// ad sets of --campaign. The total budget is unchanged.
+func proposeBudgetShift() ([]budgetChange, error) {
+	if budgetMetric != "roas" && budgetMetric != "cpa" {
+		return nil, usageError("invalid --metric %q: use roas or cpa", budgetMetric)
+	}
+	if budgetShare <= 0 || budgetShare > 100 {
+		return nil, usageError("--share must be between 0 and 100")
+	}
+
+	var adsets []budgetAdSet
+	if err := getAllInto("/"+budgetCampaign+"/adsets", budgetAdSetFields, &adsets); err != nil {
+		return nil, err
+	}
+	var changes []budgetChange
+	for _, a := range adsets {
+		if a.EffectiveStatus != "ACTIVE" {
+			continue
+		}
+		field, budget := a.budget()
+		if field == "" {
+			return nil, fmt.Errorf("campaign %s uses a campaign budget — its ad sets have no budget to reallocate", budgetCampaign)
+		}
+		if len(changes) > 0 && field != changes[0].Field {
+			fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("warning: skipping ad set %s: its %s differs from the others' %s",
+				a.ID, strings.ReplaceAll(field, "_", " "), strings.ReplaceAll(changes[0].Field, "_", " "))))
+			continue
+		}
+		useAccountCurrency("act_" + a.AccountID)
+		changes = append(changes, budgetChange{ID: a.ID, Name: a.Name, Field: field, Before: budget})
+	}
+	if len(changes) < 2 {
+		return nil, fmt.Errorf("campaign %s has %d active ad sets with a budget — at least 2 are needed", budgetCampaign, len(changes))
+	}
+	if len(changes) > api.BatchMax {
+		return nil, fmt.Errorf("campaign %s has %d active ad sets; at most %d can be reallocated at once", budgetCampaign, len(changes), api.BatchMax)
+	}
+
+	params := url.Values{}
+	params.Set("level", "adset")
+	params.Set("date_preset", budgetDatePreset)
+	params.Set("fields", "adset_id,"+monitor.InsightFields)
+	rows, err := client.GetAll("/"+budgetCampaign+"/insights", params)
+	if err != nil {
+		return nil, err
+	}
+	metrics := map[string]map[string]float64{}
+	for _, row := range rows {
+		var ids struct {
+			AdSetID string `json:"adset_id"`
+		}
+		_ = json.Unmarshal(row, &ids)
+		m, err := monitor.Compute(row, budgetConversion)
+		if err != nil {
+			return nil, fmt.Errorf("parsing insights: %w", err)
+		}
+		metrics[ids.AdSetID] = m
+	}
+
+	// Scores are higher for better ad sets: ROAS, or conversions per unit spent.
+	scores := make([]float64, len(changes))
+	total := 0.0
+	for i := range changes {
+		m := metrics[changes[i].ID]
+		v, ok := m[budgetMetric]
+		switch {
+		case !ok:
+			changes[i].Performance = "no " + strings.ToUpper(budgetMetric)
+		case budgetMetric == "roas":
+			changes[i].Performance = fmt.Sprintf("ROAS %.2f", v)
+			scores[i] = v
+		default:
+			changes[i].Performance = fmt.Sprintf("CPA %.2f", v)
+			if v > 0 {
+				scores[i] = 1 / v
+			}
+		}
+		total += scores[i]
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("no ad set of campaign %s has a %s over %s — nothing to base a reallocation on", budgetCampaign, strings.ToUpper(budgetMetric), budgetDatePreset)
+	}
+
+	var pool, given int64
+	for i := range changes {
+		share := int64(math.Round(float64(changes[i].Before) * budgetShare / 100))
+		changes[i].After = changes[i].Before - share
+		pool += share
+	}
+	best := 0
+	for i := range changes {
+		got := int64(math.Floor(float64(pool) * scores[i] / total))
+		changes[i].After += got
+		given += got
+		if scores[i] > scores[best] {
+			best = i
+		}
+	}
+	// Rounding leftovers go to the best ad set so the total is unchanged.
+	changes[best].After += pool - given
+
+	sort.SliceStable(changes, func(i, j int) bool { return changes[i].After-changes[i].Before > changes[j].After-changes[j].Before })
+	return changes, nil
+}
+
+// applyBudgetChanges sends changes in one batch request. When some fail,
+// the ones Meta applied are set back to their previous budget, so either all
+// budgets change or none do. Results are recorded in the changes.
+func applyBudgetChanges(changes []budgetChange) {
+	requests := make([]api.BatchRequest, len(changes))
+	for i, c := range changes {
+		body := url.Values{}
+		body.Set(c.Field, strconv.FormatInt(c.After, 10))
+		requests[i] = api.BatchRequest{Method: "POST", RelativeURL: c.ID, Body: body.Encode()}
+	}
+	responses, err := client.Batch(requests)
+	if err != nil {
+		for i := range changes {
+			changes[i].Result, changes[i].Error = "error", err.Error()
+		}
+		return
+	}
+
+	failed := false
+	for i, resp := range responses {
+		changes[i].Result = "ok"
+		if err := resp.Err(); err != nil || resp.Code == 0 {
+			failed = true
+			changes[i].Result = "error"
+			changes[i].Error = "not processed by Meta"
+			if err != nil {
+				changes[i].Error = err.Error()
+			}
+		}
+	}
+	if !failed {
+		return
+	}
+	for i, c := range changes {
+		if c.Result != "ok" {
+			continue
+		}
+		body := url.Values{}
+		body.Set(c.Field, strconv.FormatInt(c.Before, 10))
+		if _, err := client.Post("/"+c.ID, body); err != nil {
+			changes[i].Result = "error"
+			changes[i].Error = "applied, but rolling back failed: " + err.Error()
+			continue
+		}
+		changes[i].Result = "rolled back"
+	}
+}
+
+// printBudgetChanges prints the before/after summary of changes.
+func printBudgetChanges(changes []budgetChange) {
+	performance := changes[0].Performance != ""
+	headers := []string{"AD SET", "NAME"}
+	if performance {
+		headers = append(headers, "PERFORMANCE")
+	}
+	headers = append(headers, "BEFORE", "AFTER", "CHANGE")
+	withResult := changes[0].Result != ""
+	if withResult {
+		headers = append(headers, "RESULT")
+	}
+
+	rows := make([][]string, len(changes))
+	for i, c := range changes {
+		row := []string{c.ID, output.Truncate(c.Name, 40)}
+		if performance {
+			row = append(row, c.Performance)
+		}
+		diff := output.FormatBudget(strconv.FormatInt(c.After-c.Before, 10))
+		if c.After > c.Before {
+			diff = "+" + diff
+		}
+		suffix := "/day"
+		if c.Field == "lifetime_budget" {
+			suffix = " lifetime"
+		}
+		row = append(row, output.FormatBudget(strconv.FormatInt(c.Before, 10))+suffix, output.FormatBudget(strconv.FormatInt(c.After, 10))+suffix, diff)
+		if withResult {
+			result := output.Green(c.Result)
+			if c.Error != "" {
+				result = output.Red(c.Result) + ": " + output.Truncate(c.Error, 40)
+			} else if c.Result == "rolled back" {
+				result = output.Yellow(c.Result)
+			}
+			row = append(row, result)
+		}
+		rows[i] = row
+	}
+	output.PrintTable(headers, rows)
+}
@@ -0,0 +1,151 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	checkMaxDaily int64
+	checkMaxSpend int64
+)
+
+var budgetsCheckCmd = &cobra.Command{
+	Use:   "check",
+	Short: "Fail when active daily budgets or yesterday's spend exceed a limit",
+	Long: `Sum the daily budgets of everything delivering in the account (campaign
+budgets, plus ad set budgets of campaigns without one) and compare the total
+with --max-daily. --max-spend also compares yesterday's spend.
+
+The command exits with status 1 when a limit is exceeded, so CI jobs and cron
+scripts can stop before launching more. Use --json for a machine-readable
+report. Amounts are in cents.
+
+Examples:
+  meta-ads budgets check --max-daily 50000 -a act_123456789
+  meta-ads budgets check --max-daily 50000 --max-spend 60000 --json
+  meta-ads budgets check --max-daily 50000 && meta-ads apply -f launch.yaml`,
+	Args: cobra.NoArgs,
+	RunE: runBudgetsCheck,
+}
+
+func init() {
+	budgetsCheckCmd.Flags().Int64Var(&checkMaxDaily, "max-daily", 0, "Limit for the sum of active daily budgets, in cents")
+	budgetsCheckCmd.Flags().Int64Var(&checkMaxSpend, "max-spend", 0, "Limit for yesterday's spend, in cents")
+	budgetsCmd.AddCommand(budgetsCheckCmd)
+}
+
+// budgetCheckReport is the output of budgets check. Amounts are in cents.
+type budgetCheckReport struct {
+	Account        string        `json:"account"`
+	DailyBudgets   int64         `json:"daily_budgets"`
+	MaxDaily       int64         `json:"max_daily,omitempty"`
+	YesterdaySpend *int64        `json:"yesterday_spend,omitempty"`
+	MaxSpend       int64         `json:"max_spend,omitempty"`
+	Exceeded       bool          `json:"exceeded"`
+	Checks         []doctorCheck `json:"checks"`
+}
+
+func runBudgetsCheck(cmd *cobra.Command, args []string) error {
+	if checkMaxDaily <= 0 && checkMaxSpend <= 0 {
+		return fmt.Errorf("set a limit with --max-daily and/or --max-spend (in cents)")
+	}
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+	useAccountCurrency(account)
+	money := func(v int64) string { return output.FormatBudget(strconv.FormatInt(v, 10)) }
+
+	report := budgetCheckReport{Account: account, MaxDaily: checkMaxDaily, MaxSpend: checkMaxSpend, Checks: []doctorCheck{}}
+	add := func(name string, value, limit int64, what string) {
+		c := doctorCheck{Name: name, Status: checkOK, Detail: fmt.Sprintf("%s %s (limit %s)", money(value), what, money(limit))}
+		if value > limit {
+			c.Status = checkFail
+			c.Detail = fmt.Sprintf("%s %s exceeds the limit of %s by %s", money(value), what, money(limit), money(value-limit))
+			report.Exceeded = true
+		}
+		report.Checks = append(report.Checks, c)
+	}
+
+	total, count, err := activeDailyBudgets(account)
+	if err != nil {
+		return err
+	}
+	report.DailyBudgets = total
+	if checkMaxDaily > 0 {
+		add("daily budgets", total, checkMaxDaily, fmt.Sprintf("a day across %d active budget(s)", count))
+	}
+
+	if checkMaxSpend > 0 {
+		params := url.Values{}
+		params.Set("fields", "spend")
+		params.Set("date_preset", "yesterday")
+		body, err := client.Get("/"+account+"/insights", params)
+		if err != nil {
+			return err
+		}
+		var resp struct {
+			Data []struct {
+				Spend api.FlexString `json:"spend"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return fmt.Errorf("parsing insights: %w", err)
+		}
+		var spend float64
+		for _, r := range resp.Data {
+			v, _ := strconv.ParseFloat(r.Spend.String(), 64)
+			spend += v
+		}
+		cents := int64(math.Round(spend * float64(output.CurrencyOffset(accountCurrency(account)))))
+		report.YesterdaySpend = &cents
+		add("yesterday spend", cents, checkMaxSpend, "spent yesterday")
+	}
+
+	if !output.IsTable(cmd) {
+		if err := output.Print(cmd, report); err != nil {
+			return err
+		}
+	} else {
+		printChecks(report.Checks)
+	}
+	if report.Exceeded {
+		return fmt.Errorf("budget limits exceeded in %s", account)
+	}
+	return nil
+}
+
+// activeDailyBudgets returns the sum of the daily budgets, in cents, of the
+// delivering campaigns of account and of the delivering ad sets of campaigns
+// without a budget, and how many budgets were summed.
+func activeDailyBudgets(account string) (total int64, count int, err error) {
+	// Ad sets of campaigns with a budget have none of their own, so summing
+	// both levels counts every budget once.
+	for _, edge := range []string{"campaigns", "adsets"} {
+		var objects []struct {
+			EffectiveStatus string         `json:"effective_status"`
+			DailyBudget     api.FlexString `json:"daily_budget"`
+		}
+		if err := getAllInto("/"+account+"/"+edge, "effective_status,daily_budget", &objects); err != nil {
+			return 0, 0, err
+		}
+		for _, o := range objects {
+			if o.EffectiveStatus != "ACTIVE" {
+				continue
+			}
+			if v, _ := strconv.ParseInt(o.DailyBudget.String(), 10, 64); v > 0 {
+				total += v
+				count++
+			}
+		}
+	}
+	return total, count, nil
+}
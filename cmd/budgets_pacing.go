@@ -0,0 +1,345 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	pacingMonth     string
+	pacingTarget    int64
+	pacingTolerance float64
+)
+
+var budgetsPacingCmd = &cobra.Command{
+	Use:   "pacing",
+	Short: "Compare month-to-date spend with budgets and the monthly target",
+	Long: `Compare the spend of a month so far with what the budgets of each campaign
+planned for it, project the spend at the end of the month from the current
+run rate, and flag campaigns spending faster (OVER) or slower (UNDER) than
+planned by more than --tolerance percent.
+
+A campaign's plan for the month is its daily budget times the days it runs in
+the month, or the share of its lifetime budget falling in the month. Campaigns
+without a budget of their own use the sum of their ad sets' budgets. Lifetime
+budgets without an end time can't be spread over months and aren't planned.
+
+The account total is compared with --target, else the account's
+monthly_target (see: meta-ads config account). Days follow the account's
+timezone. Amounts in JSON output are in cents.
+
+Examples:
+  meta-ads budgets pacing -a act_123456789
+  meta-ads budgets pacing --month 2026-02
+  meta-ads budgets pacing --target 1500000 --tolerance 15`,
+	Args: cobra.NoArgs,
+	RunE: runBudgetsPacing,
+}
+
+func init() {
+	budgetsPacingCmd.Flags().StringVar(&pacingMonth, "month", "", "Month to report, YYYY-MM (default: the current month)")
+	budgetsPacingCmd.Flags().Int64Var(&pacingTarget, "target", 0, "Monthly spend target in cents (default: the account's monthly_target)")
+	budgetsPacingCmd.Flags().Float64Var(&pacingTolerance, "tolerance", 10, "Percent above or below plan before a campaign is flagged")
+	budgetsCmd.AddCommand(budgetsPacingCmd)
+}
+
+// pacingCampaign is the pacing of one campaign. Amounts are in cents.
+type pacingCampaign struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Status   string `json:"effective_status"`
+	Budget   string `json:"budget,omitempty"`
+	Planned  int64  `json:"planned"`
+	Expected int64  `json:"expected_to_date"`
+	Spend    int64  `json:"spend"`
+	// Projected is the month's spend at the current run rate.
+	Projected int64 `json:"projected"`
+	// Pace is spend / expected to date; 0 without a plan.
+	Pace float64 `json:"pace,omitempty"`
+	Flag string  `json:"flag,omitempty"`
+}
+
+// pacingReport is the output of budgets pacing. Amounts are in cents.
+type pacingReport struct {
+	Account     string           `json:"account"`
+	Month       string           `json:"month"`
+	DaysElapsed float64          `json:"days_elapsed"`
+	DaysInMonth int              `json:"days_in_month"`
+	Spend       int64            `json:"spend"`
+	Projected   int64            `json:"projected"`
+	Target      int64            `json:"target,omitempty"`
+	Pace        float64          `json:"pace,omitempty"`
+	Flag        string           `json:"flag,omitempty"`
+	Campaigns   []pacingCampaign `json:"campaigns"`
+}
+
+// pacingObject is a campaign or ad set with the fields its plan is based on.
+type pacingObject struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	EffectiveStatus string         `json:"effective_status"`
+	CampaignID      string         `json:"campaign_id"`
+	DailyBudget     api.FlexString `json:"daily_budget"`
+	LifetimeBudget  api.FlexString `json:"lifetime_budget"`
+	StartTime       string         `json:"start_time"`
+	StopTime        string         `json:"stop_time"`
+	EndTime         string         `json:"end_time"`
+}
+
+// plan returns the budget of o planned for [from, to) and up to now, in cents,
+// and a label of the budget. ok is false when o has no budget that can be
+// spread over time.
+func (o pacingObject) plan(from, to, now time.Time) (planned, expected float64, label string, ok bool) {
+	start, hasStart := parseGraphTime(o.StartTime)
+	stop, hasStop := parseGraphTime(o.StopTime)
+	if !hasStop {
+		stop, hasStop = parseGraphTime(o.EndTime)
+	}
+	start, stop = start.In(from.Location()), stop.In(from.Location())
+
+	var perDay float64
+	if v, _ := strconv.ParseFloat(o.DailyBudget.String(), 64); v > 0 {
+		perDay = v
+		label = output.FormatBudget(o.DailyBudget.String()) + "/day"
+	} else if v, _ := strconv.ParseFloat(o.LifetimeBudget.String(), 64); v > 0 && hasStart && hasStop && stop.After(start) {
+		perDay = v / daysBetween(start, stop)
+		label = output.FormatBudget(o.LifetimeBudget.String()) + " lifetime"
+	} else {
+		return 0, 0, "", false
+	}
+
+	if hasStart && start.After(from) {
+		from = start
+	}
+	if hasStop && stop.Before(to) {
+		to = stop
+	}
+	if !to.After(from) {
+		return 0, 0, label, true
+	}
+	if now.After(to) {
+		now = to
+	}
+	planned = perDay * daysBetween(from, to)
+	if now.After(from) {
+		expected = perDay * daysBetween(from, now)
+	}
+	return planned, expected, label, true
+}
+
+// daysBetween returns the fractional number of calendar days from from to
+// to, counting a day as 24 hours of wall-clock time in from's location so
+// DST changes don't stretch the month.
+func daysBetween(from, to time.Time) float64 {
+	wall := func(t time.Time) time.Time {
+		t = t.In(from.Location())
+		return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, time.UTC)
+	}
+	return wall(to).Sub(wall(from)).Hours() / 24
+}
+
+func runBudgetsPacing(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+	useAccountCurrency(account)
+	loc := accountLocation(account)
+
+	now := time.Now().In(loc)
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, loc)
+	if pacingMonth != "" {
+		m, err := time.ParseInLocation("2006-01", pacingMonth, loc)
+		if err != nil {
+			return usageError("invalid --month %q: expected YYYY-MM", pacingMonth)
+		}
+		monthStart = m
+	}
+	monthEnd := monthStart.AddDate(0, 1, 0)
+	if now.Before(monthStart) {
+		return fmt.Errorf("%s hasn't started yet", monthStart.Format("2006-01"))
+	}
+	if now.After(monthEnd) {
+		now = monthEnd
+	}
+	elapsed := daysBetween(monthStart, now)
+
+	target := pacingTarget
+	if target == 0 {
+		target, _ = strconv.ParseInt(accountOverrides(account).MonthlyTarget, 10, 64)
+	}
+
+	const fields = "id,name,effective_status,daily_budget,lifetime_budget,start_time"
+	var campaigns, adsets []pacingObject
+	if err := getAllInto("/"+account+"/campaigns", fields+",stop_time", &campaigns); err != nil {
+		return err
+	}
+	if err := getAllInto("/"+account+"/adsets", fields+",end_time,campaign_id", &adsets); err != nil {
+		return err
+	}
+	adsetsOf := map[string][]pacingObject{}
+	for _, a := range adsets {
+		adsetsOf[a.CampaignID] = append(adsetsOf[a.CampaignID], a)
+	}
+
+	spend, err := pacingSpend(account, monthStart, now)
+	if err != nil {
+		return err
+	}
+	offset := float64(output.CurrencyOffset(accountCurrency(account)))
+
+	report := pacingReport{
+		Account:     account,
+		Month:       monthStart.Format("2006-01"),
+		DaysElapsed: math.Round(elapsed*10) / 10,
+		DaysInMonth: int(math.Round(daysBetween(monthStart, monthEnd))),
+		Campaigns:   []pacingCampaign{},
+	}
+	// The total includes campaigns deleted since they spent.
+	var total float64
+	for _, s := range spend {
+		total += s * offset
+	}
+	for _, c := range campaigns {
+		p := pacingCampaign{ID: c.ID, Name: c.Name, Status: c.EffectiveStatus}
+		planned, expected, label, ok := c.plan(monthStart, monthEnd, now)
+		if !ok {
+			// No budget of its own: sum its ad sets' plans.
+			for _, a := range adsetsOf[c.ID] {
+				if a.EffectiveStatus != "ACTIVE" {
+					continue
+				}
+				if ap, ae, _, ok := a.plan(monthStart, monthEnd, now); ok {
+					planned += ap
+					expected += ae
+					label = "ad sets"
+				}
+			}
+		}
+		s := spend[c.ID] * offset
+		if s == 0 && c.EffectiveStatus != "ACTIVE" {
+			continue
+		}
+		p.Budget = label
+		p.Planned, p.Expected, p.Spend = int64(math.Round(planned)), int64(math.Round(expected)), int64(math.Round(s))
+		p.Projected = projectSpend(s, elapsed, daysBetween(monthStart, monthEnd))
+		if c.EffectiveStatus == "ACTIVE" && expected > 0 {
+			p.Pace = math.Round(s/expected*100) / 100
+			p.Flag = pacingFlag(p.Pace)
+		}
+		report.Campaigns = append(report.Campaigns, p)
+	}
+
+	report.Spend = int64(math.Round(total))
+	report.Projected = projectSpend(total, elapsed, daysBetween(monthStart, monthEnd))
+	if target > 0 {
+		report.Target = target
+		if expected := float64(target) * elapsed / daysBetween(monthStart, monthEnd); expected > 0 {
+			report.Pace = math.Round(total/expected*100) / 100
+			report.Flag = pacingFlag(report.Pace)
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, report)
+	}
+	money := func(v int64) string { return output.FormatBudget(strconv.FormatInt(v, 10)) }
+	if len(report.Campaigns) == 0 {
+		fmt.Printf("No active campaigns or spend in %s.\n", report.Month)
+	} else {
+		rows := make([][]string, len(report.Campaigns))
+		for i, c := range report.Campaigns {
+			pace := "-"
+			if c.Pace > 0 {
+				pace = fmt.Sprintf("%.0f%%", c.Pace*100)
+			}
+			rows[i] = []string{c.ID, output.Truncate(c.Name, 40), output.Status(c.Status), orDash(c.Budget),
+				money(c.Planned), money(c.Spend), money(c.Projected), pace, pacingFlagLabel(c.Flag)}
+		}
+		output.PrintTable([]string{"ID", "NAME", "STATUS", "BUDGET", "PLANNED", "SPEND", "PROJECTED", "PACE", "FLAG"}, rows)
+		fmt.Println()
+	}
+
+	summary := [][]string{
+		{"Month", fmt.Sprintf("%s (day %.1f of %d)", report.Month, report.DaysElapsed, report.DaysInMonth)},
+		{"Spend to date", money(report.Spend)},
+		{"Projected", money(report.Projected)},
+	}
+	if report.Target > 0 {
+		summary = append(summary, []string{"Target", money(report.Target)})
+		if report.Flag != "" {
+			summary = append(summary, []string{"Pace", fmt.Sprintf("%.0f%% %s", report.Pace*100, pacingFlagLabel(report.Flag))})
+		}
+		if left := daysBetween(now, monthEnd); left >= 1 && report.Target > report.Spend {
+			summary = append(summary, []string{"To reach target", money(int64(float64(report.Target-report.Spend)/left)) + "/day"})
+		}
+	}
+	output.PrintKeyValue(summary)
+	return nil
+}
+
+// pacingSpend returns the spend of each campaign of account between from and
+// to, in account currency units.
+func pacingSpend(account string, from, to time.Time) (map[string]float64, error) {
+	params := url.Values{}
+	params.Set("level", "campaign")
+	params.Set("fields", "campaign_id,spend")
+	params.Set("time_range", fmt.Sprintf(`{"since":"%s","until":"%s"}`, from.Format("2006-01-02"), to.Add(-time.Nanosecond).Format("2006-01-02")))
+	spend := map[string]float64{}
+	err := client.GetEach("/"+account+"/insights", params, func(raw json.RawMessage) error {
+		var row struct {
+			CampaignID string         `json:"campaign_id"`
+			Spend      api.FlexString `json:"spend"`
+		}
+		if err := json.Unmarshal(raw, &row); err != nil {
+			return fmt.Errorf("parsing insight: %w", err)
+		}
+		v, _ := strconv.ParseFloat(row.Spend.String(), 64)
+		spend[row.CampaignID] += v
+		return nil
+	})
+	return spend, err
+}
+
+// projectSpend extrapolates spend over elapsed days to the whole month.
+func projectSpend(spend, elapsed, month float64) int64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	if elapsed > month {
+		elapsed = month
+	}
+	return int64(math.Round(spend / elapsed * month))
+}
+
+// pacingFlag classifies pace (spend / expected) against --tolerance.
+func pacingFlag(pace float64) string {
+	switch {
+	case pace > 1+pacingTolerance/100:
+		return "over"
+	case pace < 1-pacingTolerance/100:
+		return "under"
+	}
+	return "on_track"
+}
+
+// pacingFlagLabel renders a pacing flag for tables.
+func pacingFlagLabel(flag string) string {
+	switch flag {
+	case "over":
+		return output.Red("OVER")
+	case "under":
+		return output.Yellow("UNDER")
+	case "on_track":
+		return output.Green("ON TRACK")
+	}
+	return "-"
+}
@@ -0,0 +1,381 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	bulkFile     string
+	bulkTemplate string
+	bulkResults  string
+	bulkValidate bool
+)
+
+var bulkCmd = &cobra.Command{
+	Use:   "bulk",
+	Short: "Bulk operations from spreadsheets",
+}
+
+var bulkCreateCmd = &cobra.Command{
+	Use:   "create --file <csv> --template <campaign|adset|ad>",
+	Short: "Create campaigns, ad sets or ads from a CSV file (one row per object)",
+	Long: `Create one object per CSV row. Column names are API field names; JSON fields
+(targeting, promoted_object, creative, ...) hold JSON. Empty cells are skipped.
+
+Required columns:
+  campaign  name, objective
+  adset     name, campaign_id, billing_event, optimization_goal, targeting
+  ad        name, adset_id, creative_id (or creative as JSON)
+
+Every row is validated before anything is created; nothing runs if a row is
+invalid. With --if-not-exists, rows whose name already exists under the same
+parent (the account, campaign_id or adset_id) are skipped; with --upsert, the
+existing object is updated with the row instead. Rows are then sent through the batch API (50 per request, 4 requests
+at a time; rows Meta throttled or didn't process are sent again) and objects
+are created PAUSED unless a status column is set. A results CSV with the input
+columns plus id and error is written next to the input file (--results).
+
+Example launch.csv:
+  name,campaign_id,daily_budget,billing_event,optimization_goal,targeting
+  US 25-45,120210000000001,5000,IMPRESSIONS,REACH,"{""geo_locations"":{""countries"":[""US""]}}"
+
+  meta-ads bulk create --file launch.csv --template adset`,
+	Args: cobra.NoArgs,
+	RunE: runBulkCreate,
+}
+
+func init() {
+	bulkCreateCmd.Flags().StringVar(&bulkFile, "file", "", "CSV file, or - for stdin (required)")
+	bulkCreateCmd.Flags().StringVar(&bulkTemplate, "template", "", "Object type: campaign, adset, ad (required)")
+	bulkCreateCmd.Flags().StringVar(&bulkResults, "results", "", "Results CSV path (default: <file>.results.csv, stdout when reading stdin)")
+	bulkCreateCmd.Flags().BoolVar(&bulkValidate, "validate", false, "Only validate the file; create nothing")
+	_ = bulkCreateCmd.MarkFlagRequired("file")
+	_ = bulkCreateCmd.MarkFlagRequired("template")
+	addCreateModeFlags(bulkCreateCmd, "the row's object")
+
+	bulkCmd.AddCommand(bulkCreateCmd)
+	rootCmd.AddCommand(bulkCmd)
+}
+
+// bulkKind describes how the rows of a bulk template are created.
+type bulkKind struct {
+	edge string
+	// parent is the column holding the ID of the parent object, the account
+	// when empty; --if-not-exists and --upsert look for names under it.
+	parent   string
+	required []string
+	// jsonColumns must hold valid JSON.
+	jsonColumns []string
+	// intColumns must hold integers (amounts in cents).
+	intColumns []string
+	// defaults are set on created objects, not on updated ones.
+	defaults map[string]string
+	// immutable columns aren't sent when updating with --upsert.
+	immutable []string
+}
+
+var bulkKinds = map[string]bulkKind{
+	"campaign": {
+		edge:        "campaigns",
+		required:    []string{"name", "objective"},
+		jsonColumns: []string{"special_ad_categories"},
+		intColumns:  []string{"daily_budget", "lifetime_budget", "spend_cap"},
+		defaults:    map[string]string{"status": "PAUSED", "special_ad_categories": "[]"},
+		immutable:   []string{"name", "objective", "buying_type"},
+	},
+	"adset": {
+		edge:        "adsets",
+		parent:      "campaign_id",
+		required:    []string{"name", "campaign_id", "billing_event", "optimization_goal", "targeting"},
+		jsonColumns: []string{"targeting", "promoted_object"},
+		intColumns:  []string{"daily_budget", "lifetime_budget", "bid_amount"},
+		defaults:    map[string]string{"status": "PAUSED"},
+		immutable:   []string{"name", "campaign_id"},
+	},
+	"ad": {
+		edge:        "ads",
+		parent:      "adset_id",
+		required:    []string{"name", "adset_id", "creative"},
+		jsonColumns: []string{"creative", "tracking_specs"},
+		defaults:    map[string]string{"status": "PAUSED"},
+		immutable:   []string{"name", "adset_id"},
+	},
+}
+
+// bulkRow is the outcome of one CSV row.
+type bulkRow struct {
+	Line int    `json:"line"`
+	Name string `json:"name"`
+	ID   string `json:"id,omitempty"`
+	// Action is created, updated or skipped with --if-not-exists or --upsert.
+	Action string `json:"action,omitempty"`
+	Error  string `json:"error,omitempty"`
+
+	fields url.Values
+}
+
+func runBulkCreate(cmd *cobra.Command, args []string) error {
+	kind, ok := bulkKinds[bulkTemplate]
+	if !ok {
+		return usageError("invalid --template %q — use campaign, adset, or ad", bulkTemplate)
+	}
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+	mode, err := createModeOf(cmd)
+	if err != nil {
+		return err
+	}
+
+	records, err := readCSVFile(bulkFile)
+	if err != nil {
+		return err
+	}
+	if len(records) < 2 {
+		return fmt.Errorf("%s: no data rows", bulkFile)
+	}
+	header := make([]string, len(records[0]))
+	for i, h := range records[0] {
+		header[i] = strings.ToLower(strings.TrimSpace(h))
+	}
+
+	// Validation pass: nothing is created unless every row is valid.
+	rows := make([]bulkRow, len(records)-1)
+	invalid := 0
+	for n, rec := range records[1:] {
+		row := bulkRow{Line: n + 2}
+		row.fields, err = bulkFields(kind, header, rec)
+		if err != nil {
+			row.Error = err.Error()
+			invalid++
+		}
+		row.Name = row.fields.Get("name")
+		rows[n] = row
+	}
+	if invalid > 0 || bulkValidate {
+		if err := printBulkRows(cmd, rows, true); err != nil {
+			return err
+		}
+		if invalid > 0 {
+			return usageError("%d of %d rows are invalid — nothing was created", invalid, len(rows))
+		}
+		if output.IsTable(cmd) {
+			fmt.Printf("✓ %d rows valid\n", len(rows))
+		}
+		return nil
+	}
+
+	var items []api.BulkItem
+	var itemRows []int
+	existing := existingObjects{}
+	for i := range rows {
+		row := &rows[i]
+		if mode != createAlways {
+			parent := account
+			if kind.parent != "" {
+				parent = row.fields.Get(kind.parent)
+			}
+			id, err := existing.findOne("/"+parent+"/"+kind.edge, bulkTemplate, row.Name)
+			if err != nil {
+				row.Error = err.Error()
+				continue
+			}
+			if id != "" {
+				row.ID = id
+				if mode == createIfNotExists {
+					row.Action = "skipped"
+					continue
+				}
+				row.Action = "updated"
+				items = append(items, api.BulkItem{Method: "POST", Path: "/" + id, Params: upsertFields(row.fields, kind.immutable...)})
+				itemRows = append(itemRows, i)
+				continue
+			}
+			row.Action = "created"
+		}
+		params := maps.Clone(row.fields)
+		for k, v := range kind.defaults {
+			if params.Get(k) == "" {
+				params.Set(k, v)
+			}
+		}
+		items = append(items, api.BulkItem{Method: "POST", Path: "/" + account + "/" + kind.edge, Params: params})
+		itemRows = append(itemRows, i)
+	}
+
+	executor := client.NewBulkExecutor()
+	executor.Progress = func(done, total int) {
+		progress("Sending %ss: %d of %d sent...", bulkTemplate, done, total)
+	}
+	for n, result := range executor.Run(items) {
+		row := &rows[itemRows[n]]
+		if result.Err != nil {
+			row.Error = result.Err.Error()
+			continue
+		}
+		if row.Action == "updated" {
+			continue
+		}
+		var created struct {
+			ID string `json:"id"`
+		}
+		if err := json.Unmarshal(result.Response, &created); err != nil || created.ID == "" {
+			row.Error = "unexpected response: " + string(result.Response)
+			continue
+		}
+		row.ID = created.ID
+	}
+
+	failed := 0
+	actions := map[string]int{}
+	for _, row := range rows {
+		if row.Error != "" {
+			failed++
+		} else {
+			actions[row.Action]++
+		}
+	}
+
+	resultsPath := bulkResults
+	if resultsPath == "" && bulkFile != "-" {
+		resultsPath = strings.TrimSuffix(bulkFile, ".csv") + ".results.csv"
+	}
+	if err := writeBulkResults(resultsPath, records, rows, mode != createAlways); err != nil {
+		return err
+	}
+
+	if resultsPath != "" {
+		if err := printBulkRows(cmd, rows, false); err != nil {
+			return err
+		}
+		switch {
+		case !output.IsTable(cmd):
+		case mode != createAlways:
+			fmt.Printf("✓ %d created, %d updated, %d skipped of %d %ss — results: %s\n",
+				actions["created"], actions["updated"], actions["skipped"], len(rows), bulkTemplate, resultsPath)
+		default:
+			fmt.Printf("✓ Created %d of %d %ss — results: %s\n", len(rows)-failed, len(rows), bulkTemplate, resultsPath)
+		}
+	}
+	if failed > 0 {
+		return partialFailure("%d of %d rows failed", failed, len(rows))
+	}
+	return nil
+}
+
+// bulkFields builds the API fields of one CSV row and validates them.
+func bulkFields(kind bulkKind, header, rec []string) (url.Values, error) {
+	fields := url.Values{}
+	for i, h := range header {
+		if i >= len(rec) || h == "" {
+			continue
+		}
+		v := strings.TrimSpace(rec[i])
+		if v == "" {
+			continue
+		}
+		if h == "creative_id" {
+			fields.Set("creative", fmt.Sprintf(`{"creative_id":%q}`, v))
+			continue
+		}
+		fields.Set(h, v)
+	}
+	var problems []string
+	for _, k := range kind.required {
+		if fields.Get(k) == "" {
+			problems = append(problems, "missing "+k)
+		}
+	}
+	for _, k := range kind.jsonColumns {
+		if v := fields.Get(k); v != "" && !json.Valid([]byte(v)) {
+			problems = append(problems, k+" is not valid JSON")
+		}
+	}
+	for _, k := range kind.intColumns {
+		if v := fields.Get(k); v != "" {
+			if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+				problems = append(problems, k+" must be an integer amount in cents")
+			}
+		}
+	}
+	if len(problems) > 0 {
+		sort.Strings(problems)
+		return fields, fmt.Errorf("%s", strings.Join(problems, "; "))
+	}
+	return fields, nil
+}
+
+// printBulkRows prints the outcome of each row; with onlyErrors, valid rows
+// are left out of the table.
+func printBulkRows(cmd *cobra.Command, rows []bulkRow, onlyErrors bool) error {
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, rows)
+	}
+	var table [][]string
+	for _, r := range rows {
+		if onlyErrors && r.Error == "" {
+			continue
+		}
+		status := output.Green("created")
+		switch r.Action {
+		case "updated":
+			status = output.Green("updated")
+		case "skipped":
+			status = output.Yellow("skipped")
+		}
+		if r.Error != "" {
+			status = output.Red("error")
+		}
+		if onlyErrors {
+			status = output.Red("invalid")
+		}
+		table = append(table, []string{strconv.Itoa(r.Line), output.Truncate(r.Name, 40), status, r.ID, r.Error})
+	}
+	if len(table) > 0 {
+		output.PrintTable([]string{"LINE", "NAME", "RESULT", "ID", "ERROR"}, table)
+	}
+	return nil
+}
+
+// writeBulkResults writes the input rows with id and error columns appended
+// (and action, withAction) to path, or to stdout when path is empty.
+func writeBulkResults(path string, records [][]string, rows []bulkRow, withAction bool) error {
+	var w io.Writer = os.Stdout
+	if path != "" {
+		f, err := os.Create(path)
+		if err != nil {
+			return fmt.Errorf("creating results file: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	cw := csv.NewWriter(w)
+	extra := []string{"id", "error"}
+	if withAction {
+		extra = append(extra, "action")
+	}
+	_ = cw.Write(append(append([]string{}, records[0]...), extra...))
+	for i, row := range rows {
+		extra := []string{row.ID, row.Error}
+		if withAction {
+			extra = append(extra, row.Action)
+		}
+		_ = cw.Write(append(append([]string{}, records[i+1]...), extra...))
+	}
+	cw.Flush()
+	return cw.Error()
+}
@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var businessCmd = &cobra.Command{
+	Use:   "business",
+	Short: "Navigate Business Managers and the assets they own",
+}
+
+var businessListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List Business Managers you have access to",
+	RunE:  runBusinessList,
+}
+
+var businessAccountsCmd = &cobra.Command{
+	Use:   "accounts <business_id>",
+	Short: "List ad accounts of a business (owned and client)",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBusinessAccounts,
+}
+
+var businessPagesCmd = &cobra.Command{
+	Use:   "pages <business_id>",
+	Short: "List pages of a business (owned and client)",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runBusinessPages,
+}
+
+func init() {
+	addCountFlag(businessListCmd)
+	addPagingFlags(businessListCmd)
+	addLimitFlag(businessListCmd, "businesses")
+
+	businessCmd.AddCommand(businessListCmd, businessAccountsCmd, businessPagesCmd)
+	rootCmd.AddCommand(businessCmd)
+}
+
+// businessAccount is an ad account tagged with how the business relates to it.
+type businessAccount struct {
+	api.Account
+	Relationship string `json:"relationship"`
+}
+
+// businessPage is a page tagged with how the business relates to it.
+type businessPage struct {
+	api.Page
+	Relationship string `json:"relationship"`
+}
+
+func runBusinessList(cmd *cobra.Command, args []string) error {
+	params := url.Values{}
+	params.Set("fields", "id,name,verification_status,created_time")
+
+	businesses, streamed, err := listAll[api.Business](cmd, "/me/businesses", params, "business", nil)
+	if err != nil || streamed {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, businesses)
+	}
+
+	headers := []string{"ID", "NAME", "VERIFICATION", "CREATED"}
+	rows := make([][]string, len(businesses))
+	for i, b := range businesses {
+		rows[i] = []string{
+			b.ID,
+			output.Truncate(b.Name, 40),
+			b.VerificationStatus,
+			output.FormatTime(b.CreatedTime),
+		}
+	}
+	output.PrintTable(headers, rows)
+	return nil
+}
+
+func runBusinessAccounts(cmd *cobra.Command, args []string) error {
+	businessID := args[0]
+	params := url.Values{}
+	params.Set("fields", "id,name,currency,account_status,timezone_name,amount_spent,balance")
+
+	var accounts []businessAccount
+	for _, edge := range []struct{ path, relationship string }{
+		{"owned_ad_accounts", "owned"},
+		{"client_ad_accounts", "client"},
+	} {
+		items, err := client.GetAll("/"+businessID+"/"+edge.path, params)
+		if err != nil {
+			return fmt.Errorf("fetching %s: %w", edge.path, err)
+		}
+		for _, raw := range items {
+			var a api.Account
+			if err := json.Unmarshal(raw, &a); err != nil {
+				return fmt.Errorf("parsing account: %w", err)
+			}
+			accounts = append(accounts, businessAccount{Account: a, Relationship: edge.relationship})
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, accounts)
+	}
+
+	headers := []string{"ID", "NAME", "RELATIONSHIP", "CURRENCY", "STATUS", "TIMEZONE", "AMOUNT SPENT"}
+	rows := make([][]string, len(accounts))
+	for i, a := range accounts {
+		currency := displayCurrency(a.ID, a.Currency)
+		rows[i] = []string{
+			a.ID,
+			output.Truncate(a.Name, 40),
+			a.Relationship,
+			currency,
+			output.Status(accountStatusLabel(a.Status)),
+			a.TimezoneName,
+			output.FormatMoneyLabel(a.AmountSpent, a.Currency, currency),
+		}
+	}
+	output.PrintTable(headers, rows)
+	return nil
+}
+
+func runBusinessPages(cmd *cobra.Command, args []string) error {
+	businessID := args[0]
+	params := url.Values{}
+	params.Set("fields", "id,name,category")
+
+	var pages []businessPage
+	for _, edge := range []struct{ path, relationship string }{
+		{"owned_pages", "owned"},
+		{"client_pages", "client"},
+	} {
+		items, err := client.GetAll("/"+businessID+"/"+edge.path, params)
+		if err != nil {
+			return fmt.Errorf("fetching %s: %w", edge.path, err)
+		}
+		for _, raw := range items {
+			var p api.Page
+			if err := json.Unmarshal(raw, &p); err != nil {
+				return fmt.Errorf("parsing page: %w", err)
+			}
+			pages = append(pages, businessPage{Page: p, Relationship: edge.relationship})
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, pages)
+	}
+
+	headers := []string{"ID", "NAME", "RELATIONSHIP", "CATEGORY"}
+	rows := make([][]string, len(pages))
+	for i, p := range pages {
+		rows[i] = []string{
+			p.ID,
+			output.Truncate(p.Name, 40),
+			p.Relationship,
+			p.Category,
+		}
+	}
+	output.PrintTable(headers, rows)
+	return nil
+}
@@ -12,7 +12,6 @@ import (
 
 var (
 	campaignStatusFilter string
-	campaignLimit        int
 
 	// create flags
 	campaignName          string
@@ -40,9 +39,9 @@ var campaignsListCmd = &cobra.Command{
 }
 
 var campaignsGetCmd = &cobra.Command{
-	Use:   "get <campaign_id>",
+	Use:   "get [campaign_id]",
 	Short: "Get details for a campaign",
-	Args:  cobra.ExactArgs(1),
+	Args:  cobra.MaximumNArgs(1),
 	RunE:  runCampaignsGet,
 }
 
@@ -53,23 +52,23 @@ var campaignsCreateCmd = &cobra.Command{
 }
 
 var campaignsPauseCmd = &cobra.Command{
-	Use:   "pause <campaign_id>",
+	Use:   "pause [campaign_id]",
 	Short: "Pause a campaign",
-	Args:  cobra.ExactArgs(1),
+	Args:  cobra.MaximumNArgs(1),
 	RunE:  runCampaignsPause,
 }
 
 var campaignsUpdateCmd = &cobra.Command{
-	Use:   "update <campaign_id>",
+	Use:   "update [campaign_id]",
 	Short: "Update a campaign",
-	Args:  cobra.ExactArgs(1),
+	Args:  cobra.MaximumNArgs(1),
 	RunE:  runCampaignsUpdate,
 }
 
 func init() {
 	// list flags
 	campaignsListCmd.Flags().StringVar(&campaignStatusFilter, "status", "", "Filter by status (ACTIVE, PAUSED, ARCHIVED, etc.)")
-	campaignsListCmd.Flags().IntVar(&campaignLimit, "limit", 0, "Max number of campaigns to return (0 = all)")
+	addLimitFlag(campaignsListCmd, "campaigns")
 
 	// create flags
 	campaignsCreateCmd.Flags().StringVar(&campaignName, "name", "", "Campaign name (required)")
@@ -86,6 +85,25 @@ func init() {
 	campaignsUpdateCmd.Flags().StringVar(&campaignUpdateDailyBudget, "daily-budget", "", "New daily budget in cents")
 	campaignsUpdateCmd.Flags().StringVar(&campaignUpdateLifetimeBudget, "lifetime-budget", "", "New lifetime budget in cents")
 
+	addFromJSONFlag(campaignsCreateCmd, jsonBody{
+		fields:   append([]string{"objective", "buying_type", "promoted_object"}, campaignJSONFields...),
+		required: []string{"name", "objective"},
+		flags:    []string{"name", "objective", "daily-budget", "lifetime-budget", "status"},
+	})
+	addCreateModeFlags(campaignsCreateCmd, "the campaign")
+	addFromJSONFlag(campaignsUpdateCmd, jsonBody{
+		fields: campaignJSONFields,
+		flags:  []string{"name", "status", "daily-budget", "lifetime-budget"},
+	})
+
+	addNameFlags(campaignsGetCmd, "name", false)
+	addNameFlags(campaignsPauseCmd, "name", true)
+	addNameFlags(campaignsUpdateCmd, "match-name", true)
+
+	addColumnsFlag(campaignsListCmd, campaignColumns)
+	addCountFlag(campaignsListCmd)
+	addPagingFlags(campaignsListCmd)
+
 	campaignsCmd.AddCommand(campaignsListCmd, campaignsGetCmd, campaignsCreateCmd, campaignsPauseCmd, campaignsUpdateCmd)
 	rootCmd.AddCommand(campaignsCmd)
 }
@@ -97,71 +115,53 @@ func runCampaignsList(cmd *cobra.Command, args []string) error {
 	}
 
 	fields := "id,name,status,effective_status,objective,daily_budget,lifetime_budget,budget_remaining,bid_strategy,start_time,stop_time,created_time"
+	fields, err = campaignColumns.fields(fields)
+	if err != nil {
+		return err
+	}
 	params := url.Values{}
 	params.Set("fields", fields)
 	if campaignStatusFilter != "" {
 		params.Set("effective_status", fmt.Sprintf(`["%s"]`, campaignStatusFilter))
 	}
-	if campaignLimit > 0 {
-		params.Set("limit", fmt.Sprintf("%d", campaignLimit))
-	}
-
-	path := "/" + account + "/campaigns"
-
-	var items []json.RawMessage
-	if campaignLimit > 0 {
-		// Fetch at most campaignLimit items (single page)
-		params.Set("limit", fmt.Sprintf("%d", campaignLimit))
-		body, err := client.Get(path, params)
-		if err != nil {
-			return err
-		}
-		var page struct {
-			Data []json.RawMessage `json:"data"`
-		}
-		if err := json.Unmarshal(body, &page); err != nil {
-			return fmt.Errorf("parsing response: %w", err)
-		}
-		items = page.Data
-	} else {
-		items, err = client.GetAll(path, params)
-		if err != nil {
-			return err
-		}
+	campaigns, streamed, err := listAll[api.Campaign](cmd, "/"+account+"/campaigns", params, "campaign", nil)
+	if err != nil || streamed {
+		return err
 	}
 
-	campaigns := make([]api.Campaign, 0, len(items))
-	for _, raw := range items {
-		var c api.Campaign
-		if err := json.Unmarshal(raw, &c); err != nil {
-			return fmt.Errorf("parsing campaign: %w", err)
-		}
-		campaigns = append(campaigns, c)
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, campaigns)
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(campaigns, prettyFlag)
-	}
+	useAccountCurrency(account)
+	return campaignColumns.print(campaigns)
+}
 
-	headers := []string{"ID", "NAME", "STATUS", "OBJECTIVE", "DAILY BUDGET", "LIFETIME BUDGET"}
-	rows := make([][]string, len(campaigns))
-	for i, c := range campaigns {
-		rows[i] = []string{
-			c.ID,
-			output.Truncate(c.Name, 45),
-			c.EffectiveStatus,
-			c.Objective,
-			output.FormatBudget(c.DailyBudget),
-			output.FormatBudget(c.LifetimeBudget),
-		}
-	}
-	output.PrintTable(headers, rows)
-	return nil
+// campaignColumns are the columns selectable with campaigns list --columns.
+var campaignColumns = columnSet[api.Campaign]{
+	columns: []tableColumn[api.Campaign]{
+		{name: "id", header: "ID", value: func(c api.Campaign) string { return c.ID }},
+		{name: "name", header: "NAME", value: func(c api.Campaign) string { return output.Truncate(c.Name, 45) }},
+		{name: "status", header: "STATUS", field: "effective_status", value: func(c api.Campaign) string { return output.Status(c.EffectiveStatus) }},
+		{name: "objective", header: "OBJECTIVE", value: func(c api.Campaign) string { return c.Objective }},
+		{name: "daily_budget", header: "DAILY BUDGET", value: func(c api.Campaign) string { return output.FormatBudget(c.DailyBudget) }},
+		{name: "lifetime_budget", header: "LIFETIME BUDGET", value: func(c api.Campaign) string { return output.FormatBudget(c.LifetimeBudget) }},
+		{name: "budget_remaining", header: "BUDGET REMAINING", value: func(c api.Campaign) string { return output.FormatBudget(c.BudgetRemaining) }},
+		{name: "bid_strategy", header: "BID STRATEGY", value: func(c api.Campaign) string { return c.BidStrategy }},
+		{name: "start_time", header: "START", value: func(c api.Campaign) string { return output.FormatTime(c.StartTime) }},
+		{name: "stop_time", header: "STOP", value: func(c api.Campaign) string { return output.FormatTime(c.StopTime) }},
+		{name: "created_time", header: "CREATED", value: func(c api.Campaign) string { return output.FormatTime(c.CreatedTime) }},
+	},
+	defaults: []string{"id", "name", "status", "objective", "daily_budget", "lifetime_budget"},
 }
 
 func runCampaignsGet(cmd *cobra.Command, args []string) error {
-	id := args[0]
-	fields := "id,name,status,effective_status,objective,daily_budget,lifetime_budget,budget_remaining,bid_strategy,start_time,stop_time,created_time,updated_time"
+	ids, err := objectIDs(cmd, args, "campaign")
+	if err != nil {
+		return err
+	}
+	id := ids[0]
+	fields := "id,name,status,effective_status,objective,daily_budget,lifetime_budget,budget_remaining,bid_strategy,start_time,stop_time,created_time,updated_time,account_id"
 	params := url.Values{}
 	params.Set("fields", fields)
 
@@ -175,15 +175,19 @@ func runCampaignsGet(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("parsing campaign: %w", err)
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(c, prettyFlag)
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, c)
+	}
+
+	if c.AccountID != "" {
+		useAccountCurrency("act_" + c.AccountID)
 	}
 
 	rows := [][]string{
 		{"ID", c.ID},
 		{"Name", c.Name},
-		{"Status", c.Status},
-		{"Effective Status", c.EffectiveStatus},
+		{"Status", output.Status(c.Status)},
+		{"Effective Status", output.Status(c.EffectiveStatus)},
 		{"Objective", c.Objective},
 		{"Daily Budget", output.FormatBudget(c.DailyBudget)},
 		{"Lifetime Budget", output.FormatBudget(c.LifetimeBudget)},
@@ -198,65 +202,136 @@ func runCampaignsGet(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// campaignJSONFields are the campaign fields a --from-json body can set on
+// both create and update.
+var campaignJSONFields = []string{
+	"name", "status", "daily_budget", "lifetime_budget", "spend_cap", "bid_strategy",
+	"start_time", "stop_time", "special_ad_categories", "special_ad_category_country",
+	"is_adset_budget_sharing_enabled", "adlabels",
+}
+
 func runCampaignsCreate(cmd *cobra.Command, args []string) error {
 	account, err := resolveAccount()
 	if err != nil {
 		return err
 	}
 
-	body := url.Values{}
-	body.Set("name", campaignName)
-	body.Set("objective", campaignObjective)
-	body.Set("status", campaignStatus)
-	body.Set("special_ad_categories", "[]")
-	if campaignDailyBudget != "" {
-		body.Set("daily_budget", campaignDailyBudget)
+	body, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
 	}
-	if campaignLifetimeBudget != "" {
-		body.Set("lifetime_budget", campaignLifetimeBudget)
+	if body == nil {
+		body = url.Values{}
+		body.Set("name", campaignName)
+		body.Set("objective", campaignObjective)
+		if cmd.Flags().Changed("status") {
+			body.Set("status", campaignStatus)
+		}
+		if campaignDailyBudget != "" {
+			body.Set("daily_budget", campaignDailyBudget)
+		}
+		if campaignLifetimeBudget != "" {
+			body.Set("lifetime_budget", campaignLifetimeBudget)
+		}
 	}
 
-	resp, err := client.Post("/"+account+"/campaigns", body)
+	mode, err := createModeOf(cmd)
 	if err != nil {
 		return err
 	}
+	if mode != createAlways {
+		id, err := existingObjects{}.findOne("/"+account+"/campaigns", "campaign", body.Get("name"))
+		if err != nil {
+			return err
+		}
+		if id != "" {
+			return reuseCampaign(cmd, mode, id, body)
+		}
+	}
 
-	var result struct {
-		ID string `json:"id"`
+	// Created paused unless the body says otherwise.
+	if !body.Has("status") {
+		body.Set("status", campaignStatus)
 	}
+	if !body.Has("special_ad_categories") {
+		body.Set("special_ad_categories", "[]")
+	}
+
+	resp, err := client.Post("/"+account+"/campaigns", body)
+	if err != nil {
+		return err
+	}
+
+	var result createResult
 	if err := json.Unmarshal(resp, &result); err != nil {
 		return fmt.Errorf("parsing response: %w", err)
 	}
+	if mode != createAlways {
+		result.Action = "created"
+	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(result, prettyFlag)
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, result)
 	}
 	fmt.Printf("✓ Campaign created: %s\n", result.ID)
 	return nil
 }
 
-func runCampaignsPause(cmd *cobra.Command, args []string) error {
-	id := args[0]
-	body := url.Values{}
-	body.Set("status", "PAUSED")
-
-	resp, err := client.Post("/"+id, body)
-	if err != nil {
-		return err
+// reuseCampaign handles campaigns create finding campaign id with the same
+// name: it is left as it is, or updated with body under --upsert.
+func reuseCampaign(cmd *cobra.Command, mode createMode, id string, body url.Values) error {
+	result := createResult{ID: id, Action: "skipped"}
+	if mode == createUpsert {
+		result.Action = "updated"
+		update := upsertFields(body, "name", "objective", "buying_type")
+		if len(update) > 0 {
+			if err := confirmStatus("campaign", id, update.Get("status")); err != nil {
+				return err
+			}
+			if err := confirmBudget("campaign", id, update.Get("daily_budget"), update.Get("lifetime_budget")); err != nil {
+				return err
+			}
+			if _, err := client.Post("/"+id, update); err != nil {
+				return err
+			}
+		}
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(json.RawMessage(resp), prettyFlag)
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, result)
+	}
+	if result.Action == "updated" {
+		fmt.Printf("✓ Campaign updated: %s\n", id)
+	} else {
+		fmt.Printf("✓ Campaign already exists: %s\n", id)
 	}
-	fmt.Printf("✓ Campaign %s paused\n", id)
 	return nil
 }
 
-func runCampaignsUpdate(cmd *cobra.Command, args []string) error {
-	id := args[0]
+func runCampaignsPause(cmd *cobra.Command, args []string) error {
+	ids, err := objectIDs(cmd, args, "campaign")
+	if err != nil {
+		return err
+	}
+
 	body := url.Values{}
+	body.Set("status", "PAUSED")
+	return postObjects(cmd, ids, body, "Campaign %s paused")
+}
 
-	changed := false
+func runCampaignsUpdate(cmd *cobra.Command, args []string) error {
+	ids, err := objectIDs(cmd, args, "campaign")
+	if err != nil {
+		return err
+	}
+	body, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	changed := body != nil
+	if body == nil {
+		body = url.Values{}
+	}
 	if campaignUpdateName != "" {
 		body.Set("name", campaignUpdateName)
 		changed = true
@@ -275,17 +350,16 @@ func runCampaignsUpdate(cmd *cobra.Command, args []string) error {
 	}
 
 	if !changed {
-		return fmt.Errorf("no fields to update — use --name, --status, --daily-budget, or --lifetime-budget")
-	}
-
-	resp, err := client.Post("/"+id, body)
-	if err != nil {
-		return err
+		return usageError("no fields to update — use --name, --status, --daily-budget, --lifetime-budget, or --from-json")
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(json.RawMessage(resp), prettyFlag)
+	for _, id := range ids {
+		if err := confirmStatus("campaign", id, body.Get("status")); err != nil {
+			return err
+		}
+		if err := confirmBudget("campaign", id, body.Get("daily_budget"), body.Get("lifetime_budget")); err != nil {
+			return err
+		}
 	}
-	fmt.Printf("✓ Campaign %s updated\n", id)
-	return nil
+	return postObjects(cmd, ids, body, "Campaign %s updated")
 }
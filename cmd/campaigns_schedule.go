@@ -0,0 +1,269 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	scheduleIncrease string
+	scheduleStart    string
+	scheduleEnd      string
+)
+
+const budgetScheduleFields = "id,budget_value,budget_value_type,time_start,time_end,recurrence_type"
+
+var campaignsScheduleBudgetCmd = &cobra.Command{
+	Use:   "schedule-budget <campaign_id>",
+	Short: "Schedule a budget increase for a period (e.g. Black Friday)",
+	Long: `Schedule a temporary increase of a campaign budget (a Meta budget schedule,
+or high demand period). During the period the budget is raised by --increase:
+a percentage ("50%") or an amount in cents ("20000"). Meta restores the
+budget when the period ends.
+
+--start and --end are in the ad account's timezone: YYYY-MM-DDTHH:MM,
+YYYY-MM-DD (a date --end covers the whole day) or an RFC 3339 time.
+
+Only campaigns with a campaign budget can be scheduled; the ad sets of other
+campaigns hold the budget.
+
+Examples:
+  meta-ads campaigns schedule-budget 120210000000000 --increase 50% \
+    --start 2026-11-28T00:00 --end 2026-11-30T23:59
+  meta-ads campaigns schedule-budget 120210000000000 --increase 20000 \
+    --start 2026-12-24 --end 2026-12-26`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCampaignsScheduleBudget,
+}
+
+var campaignsBudgetSchedulesCmd = &cobra.Command{
+	Use:   "budget-schedules <campaign_id>",
+	Short: "List the scheduled budget increases of a campaign",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCampaignsBudgetSchedules,
+}
+
+var campaignsDeleteBudgetScheduleCmd = &cobra.Command{
+	Use:   "delete-budget-schedule <schedule_id>",
+	Short: "Delete a scheduled budget increase",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCampaignsDeleteBudgetSchedule,
+}
+
+func init() {
+	campaignsScheduleBudgetCmd.Flags().StringVar(&scheduleIncrease, "increase", "", `Budget increase: a percentage ("50%") or an amount in cents (required)`)
+	campaignsScheduleBudgetCmd.Flags().StringVar(&scheduleStart, "start", "", "Start of the period, in the account timezone (required)")
+	campaignsScheduleBudgetCmd.Flags().StringVar(&scheduleEnd, "end", "", "End of the period, in the account timezone (required)")
+	_ = campaignsScheduleBudgetCmd.MarkFlagRequired("increase")
+	_ = campaignsScheduleBudgetCmd.MarkFlagRequired("start")
+	_ = campaignsScheduleBudgetCmd.MarkFlagRequired("end")
+	addFromJSONFlag(campaignsScheduleBudgetCmd, jsonBody{
+		fields:   []string{"budget_value", "budget_value_type", "time_start", "time_end", "recurrence_type"},
+		required: []string{"budget_value", "budget_value_type", "time_start", "time_end"},
+		flags:    []string{"increase", "start", "end"},
+	})
+	campaignsScheduleBudgetCmd.ValidArgsFunction = completeObjects("campaign")
+	campaignsBudgetSchedulesCmd.ValidArgsFunction = completeObjects("campaign")
+
+	addCountFlag(campaignsBudgetSchedulesCmd)
+	addPagingFlags(campaignsBudgetSchedulesCmd)
+	campaignsCmd.AddCommand(campaignsScheduleBudgetCmd, campaignsBudgetSchedulesCmd, campaignsDeleteBudgetScheduleCmd)
+}
+
+// parseBudgetIncrease parses --increase into Meta's budget_value and
+// budget_value_type.
+func parseBudgetIncrease(v string) (value, valueType string, err error) {
+	if pct, ok := strings.CutSuffix(strings.TrimSpace(v), "%"); ok {
+		n, err := strconv.ParseInt(strings.TrimSpace(pct), 10, 64)
+		if err != nil || n <= 0 {
+			return "", "", usageError("invalid --increase %q: expected a whole positive percentage such as 50%%", v)
+		}
+		return strconv.FormatInt(n, 10), "MULTIPLIER", nil
+	}
+	n, err := strconv.ParseInt(strings.TrimSpace(v), 10, 64)
+	if err != nil || n <= 0 {
+		return "", "", usageError("invalid --increase %q: expected a percentage (50%%) or an amount in cents (20000)", v)
+	}
+	return strconv.FormatInt(n, 10), "ABSOLUTE", nil
+}
+
+// parseScheduleTime parses a --start or --end value in loc. A date alone
+// means the start of the day, or its end when end is set.
+func parseScheduleTime(v, flag string, loc *time.Location, end bool) (time.Time, error) {
+	for _, layout := range []string{"2006-01-02T15:04", "2006-01-02 15:04", "2006-01-02T15:04:05"} {
+		if t, err := time.ParseInLocation(layout, v, loc); err == nil {
+			return t, nil
+		}
+	}
+	if t, err := time.ParseInLocation("2006-01-02", v, loc); err == nil {
+		if end {
+			t = t.AddDate(0, 0, 1).Add(-time.Second)
+		}
+		return t, nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	return time.Time{}, usageError("invalid --%s %q — expected YYYY-MM-DDTHH:MM, YYYY-MM-DD or an RFC 3339 time", flag, v)
+}
+
+// budgetScheduleIncrease describes the increase of s, e.g. "+50%".
+func budgetScheduleIncrease(s api.BudgetSchedule) string {
+	if s.BudgetValueType == "MULTIPLIER" {
+		return "+" + s.BudgetValue.String() + "%"
+	}
+	return "+" + output.FormatBudget(s.BudgetValue.String())
+}
+
+func runCampaignsScheduleBudget(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	jsonBody, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	var value, valueType string
+	if jsonBody == nil {
+		if value, valueType, err = parseBudgetIncrease(scheduleIncrease); err != nil {
+			return err
+		}
+	}
+
+	var campaign struct {
+		Name           string         `json:"name"`
+		AccountID      string         `json:"account_id"`
+		DailyBudget    api.FlexString `json:"daily_budget"`
+		LifetimeBudget api.FlexString `json:"lifetime_budget"`
+	}
+	if err := getObject(id, "name,account_id,daily_budget,lifetime_budget", &campaign); err != nil {
+		return err
+	}
+	if campaign.DailyBudget.String() == "" && campaign.LifetimeBudget.String() == "" {
+		return fmt.Errorf("campaign %s has no campaign budget — its ad sets hold the budget", id)
+	}
+	account := "act_" + campaign.AccountID
+	useAccountCurrency(account)
+	if jsonBody != nil {
+		resp, err := client.Post("/"+id+"/budget_schedules", jsonBody)
+		if err != nil {
+			return err
+		}
+		if !output.IsTable(cmd) {
+			return output.Print(cmd, json.RawMessage(resp))
+		}
+		fmt.Printf("✓ Budget schedule created for %s\n", orDash(campaign.Name))
+		return nil
+	}
+	loc := accountLocation(account)
+
+	start, err := parseScheduleTime(scheduleStart, "start", loc, false)
+	if err != nil {
+		return err
+	}
+	end, err := parseScheduleTime(scheduleEnd, "end", loc, true)
+	if err != nil {
+		return err
+	}
+	if !end.After(start) {
+		return usageError("--end must be after --start")
+	}
+	if start.Before(time.Now()) {
+		return usageError("--start %s is in the past", start.Format("2006-01-02 15:04 MST"))
+	}
+
+	body := url.Values{}
+	body.Set("budget_value", value)
+	body.Set("budget_value_type", valueType)
+	body.Set("time_start", strconv.FormatInt(start.Unix(), 10))
+	body.Set("time_end", strconv.FormatInt(end.Unix(), 10))
+
+	resp, err := client.Post("/"+id+"/budget_schedules", body)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, result)
+	}
+	s := api.BudgetSchedule{BudgetValue: api.FlexString(value), BudgetValueType: valueType}
+	fmt.Printf("✓ Budget schedule %s created: %s %s from %s to %s\n", result.ID, orDash(campaign.Name), budgetScheduleIncrease(s),
+		start.Format("2006-01-02 15:04"), end.Format("2006-01-02 15:04 MST"))
+	return nil
+}
+
+func runCampaignsBudgetSchedules(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	var campaign struct {
+		AccountID string `json:"account_id"`
+	}
+	if err := getObject(id, "account_id", &campaign); err != nil {
+		return err
+	}
+	account := "act_" + campaign.AccountID
+	useAccountCurrency(account)
+
+	params := url.Values{}
+	params.Set("fields", budgetScheduleFields)
+	schedules, streamed, err := listAll[api.BudgetSchedule](cmd, "/"+id+"/budget_schedules", params, "budget schedule", nil)
+	if err != nil || streamed {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, schedules)
+	}
+	if len(schedules) == 0 {
+		fmt.Println("No budget schedules found.")
+		return nil
+	}
+	loc := accountLocation(account)
+	now := time.Now()
+	rows := make([][]string, len(schedules))
+	for i, s := range schedules {
+		start, end := time.Unix(s.TimeStart, 0).In(loc), time.Unix(s.TimeEnd, 0).In(loc)
+		state := "scheduled"
+		switch {
+		case now.After(end):
+			state = "ended"
+		case now.After(start):
+			state = "running"
+		}
+		rows[i] = []string{
+			s.ID,
+			budgetScheduleIncrease(s),
+			start.Format("2006-01-02 15:04"),
+			end.Format("2006-01-02 15:04"),
+			state,
+		}
+	}
+	output.PrintTable([]string{"ID", "INCREASE", "START", "END", "STATE"}, rows)
+	return nil
+}
+
+func runCampaignsDeleteBudgetSchedule(cmd *cobra.Command, args []string) error {
+	if err := confirm("Delete budget schedule " + args[0]); err != nil {
+		return err
+	}
+	resp, err := client.Delete("/"+args[0], nil)
+	if err != nil {
+		return err
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, json.RawMessage(resp))
+	}
+	fmt.Printf("✓ Budget schedule %s deleted\n", args[0])
+	return nil
+}
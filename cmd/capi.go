@@ -0,0 +1,472 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/capi"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	capiPixel        string
+	capiTestCode     string
+	capiEvent        string
+	capiValue        string
+	capiCurrency     string
+	capiEmail        string
+	capiPhone        string
+	capiFirstName    string
+	capiLastName     string
+	capiExternalID   string
+	capiClientIP     string
+	capiUserAgent    string
+	capiSourceURL    string
+	capiActionSource string
+	capiEventID      string
+
+	capiFile      string
+	capiBatchSize int
+	capiRetries   int
+)
+
+// capiBatchMax is the maximum number of events Meta accepts per /events request.
+const capiBatchMax = 1000
+
+var capiCmd = &cobra.Command{
+	Use:   "capi",
+	Short: "Send events through the Conversions API",
+}
+
+var capiSendCmd = &cobra.Command{
+	Use:   "send",
+	Short: "Send a single (test) server event to a pixel",
+	Long: `Send one server event to /{pixel_id}/events.
+
+Customer information (email, phone, names, external ID) is normalized and
+SHA-256 hashed locally before it is sent. Pass --test-code with the code shown in
+Events Manager → Test events to see the event there without affecting reporting.
+
+Examples:
+  meta-ads capi send --pixel 123456789 --event Purchase --value 42.50 --currency USD \
+    --email test@example.com --test-code TEST1234
+
+  meta-ads capi send --pixel 123456789 --event Lead --phone "+1 (555) 010-9999" \
+    --client-ip 203.0.113.7 --user-agent "Mozilla/5.0"`,
+	RunE: runCapiSend,
+}
+
+var capiUploadCmd = &cobra.Command{
+	Use:   "upload",
+	Short: "Upload server events in bulk from an NDJSON or CSV file",
+	Long: `Validate, hash, and upload server events from a file.
+
+NDJSON files (.ndjson, .jsonl) hold one Conversions API event object per line,
+with user_data values in clear text or already hashed. CSV files (.csv) use a
+header row with these columns:
+  event_name, event_time, event_id, event_source_url, action_source,
+  value, currency, custom_data.<field>,
+  em (email), ph (phone), fn (first_name), ln (last_name), ct (city), st (state),
+  zp (zip), country, external_id, client_ip_address, client_user_agent, fbc, fbp
+
+Every event is validated before anything is sent; invalid rows are reported and
+skipped. Valid events are sent in batches of up to 1000. Batches Meta rejects
+as throttled or temporarily failed are retried, the latter with exponential
+backoff; other failures, such as invalid parameters, are reported and not
+sent again.
+
+Examples:
+  meta-ads capi upload --pixel 123456789 --file events.ndjson
+  meta-ads capi upload --pixel 123456789 --file orders.csv --test-code TEST1234`,
+	RunE: runCapiUpload,
+}
+
+func init() {
+	capiUploadCmd.Flags().StringVar(&capiPixel, "pixel", "", "Pixel (dataset) ID (required)")
+	capiUploadCmd.Flags().StringVar(&capiFile, "file", "", "NDJSON or CSV file, or - for NDJSON on stdin (required)")
+	capiUploadCmd.Flags().StringVar(&capiTestCode, "test-code", "", "Test event code from Events Manager")
+	capiUploadCmd.Flags().StringVar(&capiActionSource, "action-source", "website", "Action source for events that do not set one")
+	capiUploadCmd.Flags().IntVar(&capiBatchSize, "batch-size", capiBatchMax, "Events per request (max 1000)")
+	capiUploadCmd.Flags().IntVar(&capiRetries, "retries", 3, "Retries per throttled or temporarily failed batch")
+	_ = capiUploadCmd.MarkFlagRequired("pixel")
+	_ = capiUploadCmd.MarkFlagRequired("file")
+
+	capiSendCmd.Flags().StringVar(&capiPixel, "pixel", "", "Pixel (dataset) ID (required)")
+	capiSendCmd.Flags().StringVar(&capiEvent, "event", "", "Event name, e.g. Purchase, Lead, AddToCart (required)")
+	capiSendCmd.Flags().StringVar(&capiTestCode, "test-code", "", "Test event code from Events Manager")
+	capiSendCmd.Flags().StringVar(&capiValue, "value", "", "Event value, e.g. 42.50")
+	capiSendCmd.Flags().StringVar(&capiCurrency, "currency", "", "ISO 4217 currency code, e.g. USD")
+	capiSendCmd.Flags().StringVar(&capiEmail, "email", "", "Customer email (hashed before sending)")
+	capiSendCmd.Flags().StringVar(&capiPhone, "phone", "", "Customer phone with country code (hashed before sending)")
+	capiSendCmd.Flags().StringVar(&capiFirstName, "first-name", "", "Customer first name (hashed before sending)")
+	capiSendCmd.Flags().StringVar(&capiLastName, "last-name", "", "Customer last name (hashed before sending)")
+	capiSendCmd.Flags().StringVar(&capiExternalID, "external-id", "", "Your customer ID (hashed before sending)")
+	capiSendCmd.Flags().StringVar(&capiClientIP, "client-ip", "", "Client IP address")
+	capiSendCmd.Flags().StringVar(&capiUserAgent, "user-agent", "", "Client user agent")
+	capiSendCmd.Flags().StringVar(&capiSourceURL, "event-source-url", "", "URL where the event happened")
+	capiSendCmd.Flags().StringVar(&capiActionSource, "action-source", "website", "Action source: website, app, email, phone_call, chat, physical_store, system_generated, other")
+	capiSendCmd.Flags().StringVar(&capiEventID, "event-id", "", "Event ID used to deduplicate against the browser pixel")
+	_ = capiSendCmd.MarkFlagRequired("pixel")
+	_ = capiSendCmd.MarkFlagRequired("event")
+
+	capiCmd.AddCommand(capiSendCmd, capiUploadCmd)
+	rootCmd.AddCommand(capiCmd)
+}
+
+func runCapiSend(cmd *cobra.Command, args []string) error {
+	event := capi.Event{
+		EventName:      capiEvent,
+		EventTime:      time.Now().Unix(),
+		EventID:        capiEventID,
+		EventSourceURL: capiSourceURL,
+		ActionSource:   capiActionSource,
+	}
+
+	for key, value := range map[string]string{
+		"em":                capiEmail,
+		"ph":                capiPhone,
+		"fn":                capiFirstName,
+		"ln":                capiLastName,
+		"external_id":       capiExternalID,
+		"client_ip_address": capiClientIP,
+		"client_user_agent": capiUserAgent,
+	} {
+		event.UserData.Set(key, value)
+	}
+	if event.UserData.IsEmpty() {
+		return fmt.Errorf("no customer information — pass at least one of --email, --phone, --external-id, --client-ip/--user-agent")
+	}
+	if event.ActionSource == "website" && (event.UserData.ClientUserAgent == "" || event.EventSourceURL == "") {
+		fmt.Fprintln(cmd.ErrOrStderr(), "Note: website events should include --user-agent and --event-source-url for best match quality.")
+	}
+
+	if capiValue != "" || capiCurrency != "" {
+		event.CustomData = map[string]any{}
+		if capiValue != "" {
+			value, err := capi.ParseNumber(capiValue)
+			if err != nil {
+				return usageError("invalid --value %q: use a number, e.g. 42.50", capiValue)
+			}
+			event.CustomData["value"] = value
+		}
+		if capiCurrency != "" {
+			event.CustomData["currency"] = strings.ToUpper(capiCurrency)
+		}
+	}
+
+	result, err := sendCapiEvents(capiPixel, []capi.Event{event}, capiTestCode)
+	if err != nil {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, result)
+	}
+	fmt.Printf("✓ %s sent to pixel %s (events received: %d)\n", capiEvent, capiPixel, result.EventsReceived)
+	if capiTestCode != "" {
+		fmt.Printf("  Test code: %s — check Events Manager → Test events\n", capiTestCode)
+	}
+	if result.FBTraceID != "" {
+		fmt.Printf("  fbtrace_id: %s\n", result.FBTraceID)
+	}
+	for _, m := range result.Messages {
+		fmt.Printf("  %s\n", m)
+	}
+	return nil
+}
+
+// sendCapiEvents posts a batch of events to /{pixel_id}/events.
+func sendCapiEvents(pixelID string, events []capi.Event, testCode string) (*capi.Response, error) {
+	data, err := json.Marshal(events)
+	if err != nil {
+		return nil, fmt.Errorf("encoding events: %w", err)
+	}
+
+	body := url.Values{}
+	body.Set("data", string(data))
+	if testCode != "" {
+		body.Set("test_event_code", testCode)
+	}
+
+	resp, err := client.Post("/"+pixelID+"/events", body)
+	if err != nil {
+		return nil, err
+	}
+
+	var result capi.Response
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return nil, fmt.Errorf("parsing response: %w", err)
+	}
+	return &result, nil
+}
+
+// capiUploadResult summarises a bulk Conversions API upload.
+type capiUploadResult struct {
+	PixelID        string   `json:"pixel_id"`
+	Events         int      `json:"events"`
+	Invalid        int      `json:"invalid"`
+	Batches        int      `json:"batches"`
+	FailedBatches  int      `json:"failed_batches"`
+	Retries        int      `json:"retries"`
+	EventsReceived int      `json:"events_received"`
+	FBTraceIDs     []string `json:"fbtrace_ids,omitempty"`
+	Errors         []string `json:"errors,omitempty"`
+}
+
+func runCapiUpload(cmd *cobra.Command, args []string) error {
+	if capiBatchSize <= 0 || capiBatchSize > capiBatchMax {
+		return usageError("--batch-size must be between 1 and %d", capiBatchMax)
+	}
+
+	var (
+		events  []capi.Event
+		invalid []string
+		err     error
+	)
+	if strings.HasSuffix(strings.ToLower(capiFile), ".csv") {
+		events, invalid, err = readCapiCSV(capiFile)
+	} else {
+		events, invalid, err = readCapiNDJSON(capiFile)
+	}
+	if err != nil {
+		return err
+	}
+
+	result := capiUploadResult{
+		PixelID: capiPixel,
+		Events:  len(events),
+		Invalid: len(invalid),
+		Errors:  invalid,
+	}
+
+	for start := 0; start < len(events); start += capiBatchSize {
+		end := start + capiBatchSize
+		if end > len(events) {
+			end = len(events)
+		}
+		result.Batches++
+		progress("Sending events %d–%d of %d...", start+1, end, len(events))
+
+		var resp *capi.Response
+		for attempt := 0; ; attempt++ {
+			resp, err = sendCapiEvents(capiPixel, events[start:end], capiTestCode)
+			var metaErr *api.MetaError
+			if err == nil || attempt >= capiRetries || !errors.As(err, &metaErr) {
+				// Other errors, such as a dropped connection, may come after
+				// Meta accepted the batch: sending it again would duplicate
+				// the events without an event_id.
+				break
+			}
+			if metaErr.IsThrottled() {
+				// The client already waited for the cooldown Meta reported.
+				result.Retries++
+				progress("  batch %d throttled (%v) — retrying", result.Batches, err)
+				continue
+			}
+			if !metaErr.IsTemporary() {
+				break
+			}
+			result.Retries++
+			wait := time.Duration(1<<attempt) * time.Second
+			progress("  batch %d failed (%v) — retrying in %s", result.Batches, err, wait)
+			time.Sleep(wait)
+		}
+		if err != nil {
+			result.FailedBatches++
+			result.Errors = append(result.Errors, fmt.Sprintf("batch %d (events %d–%d): %v", result.Batches, start+1, end, err))
+			continue
+		}
+		result.EventsReceived += resp.EventsReceived
+		if resp.FBTraceID != "" {
+			result.FBTraceIDs = append(result.FBTraceIDs, resp.FBTraceID)
+		}
+		for _, m := range resp.Messages {
+			result.Errors = append(result.Errors, fmt.Sprintf("batch %d: %s", result.Batches, m))
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		if err := output.Print(cmd, result); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("✓ Upload to pixel %s finished\n", capiPixel)
+		output.PrintKeyValue([][]string{
+			{"Valid Events", fmt.Sprintf("%d", result.Events)},
+			{"Invalid Rows", fmt.Sprintf("%d", result.Invalid)},
+			{"Batches", fmt.Sprintf("%d", result.Batches)},
+			{"Failed Batches", fmt.Sprintf("%d", result.FailedBatches)},
+			{"Retries", fmt.Sprintf("%d", result.Retries)},
+			{"Events Received", fmt.Sprintf("%d", result.EventsReceived)},
+		})
+		for _, e := range result.Errors {
+			fmt.Fprintf(os.Stderr, "  %s\n", e)
+		}
+	}
+
+	if result.FailedBatches > 0 {
+		return partialFailure("%d of %d batches failed", result.FailedBatches, result.Batches)
+	}
+	return nil
+}
+
+// readCapiNDJSON parses and validates one event per line. Invalid lines are
+// returned as error messages rather than aborting the upload.
+func readCapiNDJSON(path string) ([]capi.Event, []string, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("opening %s: %w", path, err)
+		}
+		defer f.Close()
+		r = f
+	}
+
+	var (
+		events  []capi.Event
+		invalid []string
+	)
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for line := 1; sc.Scan(); line++ {
+		text := strings.TrimSpace(sc.Text())
+		if text == "" {
+			continue
+		}
+		ev, err := capi.ParseJSONEvent([]byte(text), capiActionSource)
+		if err == nil {
+			err = ev.Validate()
+		}
+		if err != nil {
+			invalid = append(invalid, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		events = append(events, ev)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return events, invalid, nil
+}
+
+// capiCSVUserData maps CSV column names to user_data keys.
+var capiCSVUserData = map[string]string{
+	"em": "em", "email": "em",
+	"ph": "ph", "phone": "ph",
+	"fn": "fn", "first_name": "fn",
+	"ln": "ln", "last_name": "ln",
+	"ct": "ct", "city": "ct",
+	"st": "st", "state": "st",
+	"zp": "zp", "zip": "zp",
+	"country":           "country",
+	"external_id":       "external_id",
+	"client_ip_address": "client_ip_address",
+	"client_user_agent": "client_user_agent",
+	"fbc":               "fbc",
+	"fbp":               "fbp",
+}
+
+// readCapiCSV parses and validates one event per CSV row.
+func readCapiCSV(path string) ([]capi.Event, []string, error) {
+	records, err := readCSVFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) < 2 {
+		return nil, nil, fmt.Errorf("%s: no data rows", path)
+	}
+	header := records[0]
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+
+	var (
+		events  []capi.Event
+		invalid []string
+	)
+	for n, rec := range records[1:] {
+		line := n + 2
+		ev := capi.Event{ActionSource: capiActionSource}
+		var rowErr error
+		for i, col := range header {
+			if i >= len(rec) {
+				break
+			}
+			v := strings.TrimSpace(rec[i])
+			if v == "" {
+				continue
+			}
+			switch {
+			case col == "event_name":
+				ev.EventName = v
+			case col == "event_time":
+				t, err := parseEventTime(v)
+				if err != nil {
+					rowErr = err
+					continue
+				}
+				ev.EventTime = t
+			case col == "event_id":
+				ev.EventID = v
+			case col == "event_source_url":
+				ev.EventSourceURL = v
+			case col == "action_source":
+				ev.ActionSource = v
+			case col == "value":
+				n, err := capi.ParseNumber(v)
+				if err != nil {
+					rowErr = fmt.Errorf("invalid value %q", v)
+					continue
+				}
+				setCustomData(&ev, "value", n)
+			case col == "currency":
+				setCustomData(&ev, "currency", strings.ToUpper(v))
+			case strings.HasPrefix(col, "custom_data."):
+				key := strings.TrimPrefix(col, "custom_data.")
+				if !slices.Contains(capi.NumericCustomData, key) {
+					setCustomData(&ev, key, v)
+					continue
+				}
+				n, err := capi.ParseNumber(v)
+				if err != nil {
+					rowErr = fmt.Errorf("invalid %s %q", col, v)
+					continue
+				}
+				setCustomData(&ev, key, n)
+			default:
+				if key, ok := capiCSVUserData[col]; ok {
+					ev.UserData.Set(key, v)
+				}
+			}
+		}
+		if rowErr == nil {
+			rowErr = ev.Validate()
+		}
+		if rowErr != nil {
+			invalid = append(invalid, fmt.Sprintf("line %d: %v", line, rowErr))
+			continue
+		}
+		events = append(events, ev)
+	}
+	return events, invalid, nil
+}
+
+func setCustomData(ev *capi.Event, key string, v any) {
+	if ev.CustomData == nil {
+		ev.CustomData = map[string]any{}
+	}
+	ev.CustomData[key] = v
+}
@@ -0,0 +1,368 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/catalog"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	catalogsBusiness  string
+	productSetCatalog string
+	productSetName    string
+	productSetFilter  string
+	productSetDryRun  bool
+)
+
+const (
+	catalogFields     = "id,name,vertical,product_count"
+	productSetFields  = "id,name,filter,product_count"
+	productFeedFields = "id,name,product_count,latest_upload{id,start_time,end_time,input_method,error_count,warning_count,num_detected_items,num_persisted_items}"
+)
+
+var catalogsCmd = &cobra.Command{
+	Use:   "catalogs",
+	Short: "Inspect product catalogs, product sets, and feeds",
+	Long: `Inspect the product catalogs behind Advantage+ catalog ads: product
+counts, product sets, and the status and errors of feed uploads.`,
+}
+
+var catalogsListCmd = &cobra.Command{
+	Use:   "list --business <business_id>",
+	Short: "List catalogs of a business (owned and client)",
+	Args:  cobra.NoArgs,
+	RunE:  runCatalogsList,
+}
+
+var catalogsGetCmd = &cobra.Command{
+	Use:   "get <catalog_id>",
+	Short: "Show a catalog with its product count",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCatalogsGet,
+}
+
+var catalogsSetsCmd = &cobra.Command{
+	Use:     "product-sets",
+	Aliases: []string{"sets"},
+	Short:   "List, inspect, and create product sets",
+}
+
+var catalogsSetsListCmd = &cobra.Command{
+	Use:   "list <catalog_id>",
+	Short: "List product sets of a catalog",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCatalogsSetsList,
+}
+
+var catalogsSetsGetCmd = &cobra.Command{
+	Use:   "get <product_set_id>",
+	Short: "Show a product set with its filter",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCatalogsSetsGet,
+}
+
+var catalogsSetsCreateCmd = &cobra.Command{
+	Use:   "create --catalog <catalog_id> --name <name> --filter <filter>",
+	Short: "Create a product set from a filter",
+	Long: `Create a product set whose products match --filter, written as
+"<field> <operator> <value>" conditions joined with "and" / "or" ("and" binds
+tighter). Operators: ` + catalog.Operators + `.
+Text comparisons are case-insensitive; "in" takes a comma-separated list; quote
+values containing "and" or "or". Raw filter JSON is accepted as is.
+
+Examples:
+  meta-ads catalogs product-sets create --catalog 123 --name "SKU-A" \
+    --filter 'retailer_id starts_with SKU-A'
+
+  meta-ads catalogs product-sets create --catalog 123 --name "Premium shoes" \
+    --filter 'product_type contains shoes and price >= 10000 or brand in Nike, Adidas'`,
+	Args: cobra.NoArgs,
+	RunE: runCatalogsSetsCreate,
+}
+
+var catalogsFeedsCmd = &cobra.Command{
+	Use:   "feeds",
+	Short: "Check product feeds and their uploads",
+}
+
+var catalogsFeedsListCmd = &cobra.Command{
+	Use:   "list <catalog_id>",
+	Short: "List feeds of a catalog with the status of their latest upload",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCatalogsFeedsList,
+}
+
+var catalogsFeedsErrorsCmd = &cobra.Command{
+	Use:   "errors <feed_id>",
+	Short: "Show the errors and warnings of a feed's latest upload",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCatalogsFeedsErrors,
+}
+
+func init() {
+	catalogsListCmd.Flags().StringVar(&catalogsBusiness, "business", "", "Business ID (required)")
+	_ = catalogsListCmd.MarkFlagRequired("business")
+
+	catalogsSetsCreateCmd.Flags().StringVar(&productSetCatalog, "catalog", "", "Catalog ID (required)")
+	catalogsSetsCreateCmd.Flags().StringVar(&productSetName, "name", "", "Product set name (required)")
+	catalogsSetsCreateCmd.Flags().StringVar(&productSetFilter, "filter", "", "Filter, e.g. 'retailer_id starts_with SKU-A' (required)")
+	catalogsSetsCreateCmd.Flags().BoolVar(&productSetDryRun, "dry-run", false, "Print the compiled filter without creating the set")
+	_ = catalogsSetsCreateCmd.MarkFlagRequired("catalog")
+	_ = catalogsSetsCreateCmd.MarkFlagRequired("name")
+	_ = catalogsSetsCreateCmd.MarkFlagRequired("filter")
+	addFromJSONFlag(catalogsSetsCreateCmd, jsonBody{
+		fields:   []string{"name", "filter", "retailer_id", "metadata", "ordering_info"},
+		required: []string{"name", "filter"},
+		flags:    []string{"name", "filter", "dry-run"},
+	})
+
+	addCountFlag(catalogsSetsListCmd)
+	addPagingFlags(catalogsSetsListCmd)
+	addCountFlag(catalogsFeedsListCmd)
+	addPagingFlags(catalogsFeedsListCmd)
+	catalogsSetsCmd.AddCommand(catalogsSetsListCmd, catalogsSetsGetCmd, catalogsSetsCreateCmd)
+	catalogsFeedsCmd.AddCommand(catalogsFeedsListCmd, catalogsFeedsErrorsCmd)
+	catalogsCmd.AddCommand(catalogsListCmd, catalogsGetCmd, catalogsSetsCmd, catalogsFeedsCmd)
+	rootCmd.AddCommand(catalogsCmd)
+}
+
+// businessCatalog is a catalog tagged with how the business relates to it.
+type businessCatalog struct {
+	api.ProductCatalog
+	Relationship string `json:"relationship"`
+}
+
+func runCatalogsList(cmd *cobra.Command, args []string) error {
+	var catalogs []businessCatalog
+	for _, edge := range []struct{ path, relationship string }{
+		{"owned_product_catalogs", "owned"},
+		{"client_product_catalogs", "client"},
+	} {
+		var items []api.ProductCatalog
+		if err := getAllInto("/"+catalogsBusiness+"/"+edge.path, catalogFields, &items); err != nil {
+			return fmt.Errorf("fetching %s: %w", edge.path, err)
+		}
+		for _, c := range items {
+			catalogs = append(catalogs, businessCatalog{ProductCatalog: c, Relationship: edge.relationship})
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, catalogs)
+	}
+	if len(catalogs) == 0 {
+		fmt.Println("No catalogs found.")
+		return nil
+	}
+	rows := make([][]string, len(catalogs))
+	for i, c := range catalogs {
+		rows[i] = []string{
+			c.ID,
+			output.Truncate(c.Name, 40),
+			c.Vertical,
+			strconv.Itoa(c.ProductCount),
+			c.Relationship,
+		}
+	}
+	output.PrintTable([]string{"ID", "NAME", "VERTICAL", "PRODUCTS", "RELATIONSHIP"}, rows)
+	return nil
+}
+
+func runCatalogsGet(cmd *cobra.Command, args []string) error {
+	var catalog api.ProductCatalog
+	if err := getObject(args[0], catalogFields, &catalog); err != nil {
+		return err
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, catalog)
+	}
+	output.PrintKeyValue([][]string{
+		{"ID", catalog.ID},
+		{"Name", catalog.Name},
+		{"Vertical", catalog.Vertical},
+		{"Products", strconv.Itoa(catalog.ProductCount)},
+	})
+	return nil
+}
+
+func runCatalogsSetsList(cmd *cobra.Command, args []string) error {
+	params := url.Values{}
+	params.Set("fields", productSetFields)
+
+	sets, streamed, err := listAll[api.ProductSet](cmd, "/"+args[0]+"/product_sets", params, "product set", nil)
+	if err != nil || streamed {
+		return err
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, sets)
+	}
+	if len(sets) == 0 {
+		fmt.Println("No product sets found.")
+		return nil
+	}
+	rows := make([][]string, len(sets))
+	for i, s := range sets {
+		rows[i] = []string{
+			s.ID,
+			output.Truncate(s.Name, 40),
+			strconv.Itoa(s.ProductCount),
+			output.Truncate(s.Filter, 50),
+		}
+	}
+	output.PrintTable([]string{"ID", "NAME", "PRODUCTS", "FILTER"}, rows)
+	return nil
+}
+
+func runCatalogsSetsGet(cmd *cobra.Command, args []string) error {
+	var set api.ProductSet
+	if err := getObject(args[0], productSetFields, &set); err != nil {
+		return err
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, set)
+	}
+	filter := set.Filter
+	if filter == "" {
+		filter = "(all products)"
+	}
+	output.PrintKeyValue([][]string{
+		{"ID", set.ID},
+		{"Name", set.Name},
+		{"Products", strconv.Itoa(set.ProductCount)},
+		{"Filter", filter},
+	})
+	return nil
+}
+
+func runCatalogsSetsCreate(cmd *cobra.Command, args []string) error {
+	body, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	if body == nil {
+		filter, err := catalog.ParseFilter(productSetFilter)
+		if err != nil {
+			return err
+		}
+		filterJSON, _ := json.Marshal(filter)
+		if productSetDryRun {
+			fmt.Println(string(filterJSON))
+			return nil
+		}
+		body = url.Values{}
+		body.Set("name", productSetName)
+		body.Set("filter", string(filterJSON))
+	}
+	resp, err := client.Post("/"+productSetCatalog+"/product_sets", body)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, result)
+	}
+	fmt.Printf("✓ Product set created: %s\n", result.ID)
+	fmt.Printf("  Filter: %s\n", body.Get("filter"))
+	return nil
+}
+
+func runCatalogsFeedsList(cmd *cobra.Command, args []string) error {
+	params := url.Values{}
+	params.Set("fields", productFeedFields)
+
+	feeds, streamed, err := listAll[api.ProductFeed](cmd, "/"+args[0]+"/product_feeds", params, "product feed", nil)
+	if err != nil || streamed {
+		return err
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, feeds)
+	}
+	if len(feeds) == 0 {
+		fmt.Println("No feeds found.")
+		return nil
+	}
+	rows := make([][]string, len(feeds))
+	for i, f := range feeds {
+		lastUpload, status, errs, warnings := "-", "-", "-", "-"
+		if u := f.LatestUpload; u != nil {
+			lastUpload = output.FormatTime(u.StartTime)
+			status = feedUploadStatus(u)
+			errs = strconv.Itoa(u.ErrorCount)
+			warnings = strconv.Itoa(u.WarningCount)
+		}
+		rows[i] = []string{
+			f.ID,
+			output.Truncate(f.Name, 40),
+			strconv.Itoa(f.ProductCount),
+			lastUpload,
+			status,
+			errs,
+			warnings,
+		}
+	}
+	output.PrintTable([]string{"ID", "NAME", "PRODUCTS", "LAST UPLOAD", "STATUS", "ERRORS", "WARNINGS"}, rows)
+	return nil
+}
+
+// feedUploadStatus summarizes an upload for the terminal.
+func feedUploadStatus(u *api.ProductFeedUpload) string {
+	switch {
+	case u.EndTime == "":
+		return output.Yellow("in progress")
+	case u.ErrorCount > 0:
+		return output.Red("errors")
+	case u.WarningCount > 0:
+		return output.Yellow("warnings")
+	}
+	return output.Green("ok")
+}
+
+func runCatalogsFeedsErrors(cmd *cobra.Command, args []string) error {
+	var feed api.ProductFeed
+	if err := getObject(args[0], productFeedFields, &feed); err != nil {
+		return err
+	}
+	if feed.LatestUpload == nil {
+		return fmt.Errorf("feed %s has no uploads yet", args[0])
+	}
+
+	var uploadErrors []api.ProductFeedUploadError
+	if err := getAllInto("/"+feed.LatestUpload.ID+"/errors", "id,summary,description,severity,total_count", &uploadErrors); err != nil {
+		return err
+	}
+	if uploadErrors == nil {
+		uploadErrors = []api.ProductFeedUploadError{}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, uploadErrors)
+	}
+	u := feed.LatestUpload
+	fmt.Printf("Upload %s (%s): %d items detected, %d persisted\n\n", u.ID, output.FormatTime(u.StartTime), u.NumDetectedItems, u.NumPersistedItems)
+	if len(uploadErrors) == 0 {
+		fmt.Println("No errors or warnings.")
+		return nil
+	}
+	rows := make([][]string, len(uploadErrors))
+	for i, e := range uploadErrors {
+		rows[i] = []string{
+			output.Status(e.Severity),
+			strconv.Itoa(e.TotalCount),
+			output.Truncate(e.Summary, 60),
+		}
+	}
+	output.PrintTable([]string{"SEVERITY", "COUNT", "SUMMARY"}, rows)
+	return nil
+}
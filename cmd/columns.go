@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+// columnsFlag holds --columns for whichever list command is running.
+var columnsFlag string
+
+// tableColumn is one selectable column of a list command's table.
+type tableColumn[T any] struct {
+	name   string // --columns key; also the API field requested unless field is set
+	header string
+	field  string // API field(s) backing the column, when they differ from name
+	value  func(T) string
+}
+
+// columnSet is the set of columns a list command can display.
+type columnSet[T any] struct {
+	columns  []tableColumn[T]
+	defaults []string // shown when --columns is not set
+}
+
+// addColumnsFlag registers --columns on a list command.
+func addColumnsFlag[T any](cmd *cobra.Command, set columnSet[T]) {
+	cmd.Flags().StringVar(&columnsFlag, "columns", "", fmt.Sprintf("Comma-separated table columns (default %s; available: %s)",
+		strings.Join(set.defaults, ","), strings.Join(set.names(), ",")))
+}
+
+func (s columnSet[T]) names() []string {
+	names := make([]string, len(s.columns))
+	for i, c := range s.columns {
+		names[i] = c.name
+	}
+	sort.Strings(names)
+	return names
+}
+
+// selected returns the columns chosen with --columns, or the defaults.
+func (s columnSet[T]) selected() ([]tableColumn[T], error) {
+	keys := s.defaults
+	if columnsFlag != "" {
+		keys = strings.Split(columnsFlag, ",")
+	}
+	cols := make([]tableColumn[T], 0, len(keys))
+	for _, key := range keys {
+		key = strings.ToLower(strings.TrimSpace(key))
+		if key == "" {
+			continue
+		}
+		found := false
+		for _, c := range s.columns {
+			if c.name == key {
+				cols = append(cols, c)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, usageError("unknown column %q — available: %s", key, strings.Join(s.names(), ", "))
+		}
+	}
+	if len(cols) == 0 {
+		return nil, usageError("--columns must name at least one column")
+	}
+	return cols, nil
+}
+
+// fields returns the API fields to request. When --columns is set only the
+// selected columns (plus id and any extra fields the command needs) are
+// requested; otherwise all is returned unchanged.
+func (s columnSet[T]) fields(all string, extra ...string) (string, error) {
+	if columnsFlag == "" {
+		return all, nil
+	}
+	cols, err := s.selected()
+	if err != nil {
+		return "", err
+	}
+	seen := map[string]bool{}
+	var fields []string
+	add := func(list string) {
+		for _, f := range strings.Split(list, ",") {
+			if f != "" && !seen[f] {
+				seen[f] = true
+				fields = append(fields, f)
+			}
+		}
+	}
+	add("id")
+	for _, c := range cols {
+		if c.field != "" {
+			add(c.field)
+		} else {
+			add(c.name)
+		}
+	}
+	for _, e := range extra {
+		add(e)
+	}
+	return strings.Join(fields, ","), nil
+}
+
+// print renders items as a table with the selected columns.
+func (s columnSet[T]) print(items []T) error {
+	cols, err := s.selected()
+	if err != nil {
+		return err
+	}
+	headers := make([]string, len(cols))
+	for i, c := range cols {
+		headers[i] = c.header
+	}
+	rows := make([][]string, len(items))
+	for i, item := range items {
+		row := make([]string, len(cols))
+		for j, c := range cols {
+			row[j] = c.value(item)
+		}
+		rows[i] = row
+	}
+	output.PrintTable(headers, rows)
+	return nil
+}
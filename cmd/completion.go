@@ -0,0 +1,186 @@
+package cmd
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/config"
+)
+
+// completionTTL is how long completion candidates fetched from the API are
+// reused, so repeated <TAB>s don't each wait on Meta.
+const completionTTL = 2 * time.Minute
+
+func init() {
+	for _, c := range []*cobra.Command{campaignsGetCmd, campaignsPauseCmd, campaignsUpdateCmd} {
+		c.ValidArgsFunction = completeObjects("campaign")
+	}
+	for _, c := range []*cobra.Command{adsetsGetCmd, adsetsPauseCmd, adsetsUpdateBudgetCmd} {
+		c.ValidArgsFunction = completeObjects("adset")
+	}
+	for _, c := range []*cobra.Command{adsGetCmd, adsPauseCmd} {
+		c.ValidArgsFunction = completeObjects("ad")
+	}
+	diagnoseCmd.ValidArgsFunction = completeObjects("campaign", "adset")
+	accountsFundingCmd.ValidArgsFunction = completeAccounts
+}
+
+// completeObjects completes the first argument with the IDs of the
+// account's objects of the given kinds, described by name and status.
+func completeObjects(kinds ...string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return objectCandidates(kinds...), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeObjectFlag completes a flag taking an object ID of kind.
+func completeObjectFlag(kind string) func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		return objectCandidates(kind), cobra.ShellCompDirectiveNoFileComp
+	}
+}
+
+// completeAccountFlag completes --account with ad account IDs and aliases.
+func completeAccountFlag(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	return accountCandidates(), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeAccounts completes the first argument with an ad account ID.
+func completeAccounts(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if len(args) > 0 {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return accountCandidates(), cobra.ShellCompDirectiveNoFileComp
+}
+
+// objectCandidates returns "id\tname (STATUS)" for the objects of the given
+// kinds in the resolved account. Errors yield no candidates: completion must
+// never print anything but candidates.
+func objectCandidates(kinds ...string) []string {
+	completionConfig()
+	account, err := resolveAccount()
+	if err != nil {
+		return nil
+	}
+	var candidates []string
+	for _, kind := range kinds {
+		candidates = append(candidates, cachedCandidates(account+"-"+kind, func() ([]string, error) {
+			objects, err := listNamedObjects(account, kind)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]string, len(objects))
+			for i, o := range objects {
+				out[i] = o.ID + "\t" + o.Name + " (" + o.EffectiveStatus + ")"
+			}
+			return out, nil
+		})...)
+	}
+	return candidates
+}
+
+// accountCandidates returns the ad accounts of the user plus the account
+// aliases defined with `config alias add`.
+func accountCandidates() []string {
+	completionConfig()
+	candidates := cachedCandidates("accounts", func() ([]string, error) {
+		var accounts []struct {
+			ID   string `json:"id"`
+			Name string `json:"name"`
+		}
+		if err := getAllInto("/me/adaccounts", "id,name", &accounts); err != nil {
+			return nil, err
+		}
+		out := make([]string, len(accounts))
+		for i, a := range accounts {
+			out[i] = a.ID + "\t" + a.Name
+		}
+		return out, nil
+	})
+
+	loadConfig()
+	if cfg != nil {
+		aliases := make([]string, 0, len(cfg.Aliases))
+		for name, id := range cfg.Aliases {
+			aliases = append(aliases, name+"\talias for "+api.NormalizeAccountID(id))
+		}
+		sort.Strings(aliases)
+		candidates = append(candidates, aliases...)
+	}
+	return candidates
+}
+
+// cachedCandidates returns the candidates cached under key when they are
+// younger than completionTTL, and otherwise fetches and caches them.
+func cachedCandidates(key string, fetch func() ([]string, error)) []string {
+	path := completionCachePath(key)
+	if path != "" {
+		if fi, err := os.Stat(path); err == nil && time.Since(fi.ModTime()) < completionTTL {
+			var cached []string
+			if data, err := os.ReadFile(path); err == nil && json.Unmarshal(data, &cached) == nil {
+				return cached
+			}
+		}
+	}
+
+	if !completionClient() {
+		return nil
+	}
+	candidates, err := fetch()
+	if err != nil {
+		return nil
+	}
+	if path != "" {
+		if data, err := json.Marshal(candidates); err == nil {
+			if os.MkdirAll(filepath.Dir(path), 0o700) == nil {
+				_ = os.WriteFile(path, data, 0o600)
+			}
+		}
+	}
+	return candidates
+}
+
+// completionCachePath returns the cache file of key, or "" when there is no
+// user cache directory.
+func completionCachePath(key string) string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "meta-ads", "completion", strings.ReplaceAll(key, string(filepath.Separator), "_")+".json")
+}
+
+// completionConfig honors --config, which PersistentPreRunE skips for
+// completion requests.
+func completionConfig() {
+	if configFlag != "" {
+		config.SetPath(configFlag)
+	}
+}
+
+// completionClient sets up the API client, which PersistentPreRunE skips for
+// completion requests.
+func completionClient() bool {
+	if client != nil {
+		return true
+	}
+	token, appSecret, err := resolveToken()
+	if err != nil {
+		return false
+	}
+	c, err := newClient(token, appSecret)
+	if err != nil {
+		return false
+	}
+	client = c
+	return true
+}
@@ -0,0 +1,261 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Read and write the stored configuration",
+	Long: `Read and write the stored configuration without hand-editing config.json.
+
+Examples:
+  meta-ads config set default_account act_123456789
+  meta-ads config get default_account
+  meta-ads config list
+  meta-ads config unset default_account`,
+}
+
+var configListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List configuration keys and values (secrets masked)",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigList,
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get <key>",
+	Short: "Print the value of a configuration key",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConfigGet,
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set <key> <value>",
+	Short: "Set a configuration key",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runConfigSet,
+}
+
+var configUnsetCmd = &cobra.Command{
+	Use:   "unset <key>",
+	Short: "Remove a configuration key",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runConfigUnset,
+}
+
+var configAliasCmd = &cobra.Command{
+	Use:   "alias",
+	Short: "Manage ad account aliases",
+	Long: `Give ad accounts human names usable anywhere an account is expected
+(--account, META_ADS_ACCOUNT, default_account, account arguments).
+
+Examples:
+  meta-ads config alias add shop act_123456789
+  meta-ads campaigns list --account shop
+  META_ADS_ACCOUNT=shop meta-ads ads list
+  meta-ads config alias list
+  meta-ads config alias remove shop`,
+}
+
+var configAliasAddCmd = &cobra.Command{
+	Use:   "add <alias> <account_id>",
+	Short: "Define or replace an account alias",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runConfigAliasAdd,
+}
+
+var configAliasListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List account aliases",
+	Args:  cobra.NoArgs,
+	RunE:  runConfigAliasList,
+}
+
+var configAliasRemoveCmd = &cobra.Command{
+	Use:     "remove <alias>",
+	Aliases: []string{"rm"},
+	Short:   "Remove an account alias",
+	Args:    cobra.ExactArgs(1),
+	RunE:    runConfigAliasRemove,
+}
+
+func init() {
+	configAliasCmd.AddCommand(configAliasAddCmd, configAliasListCmd, configAliasRemoveCmd)
+	configCmd.AddCommand(configListCmd, configGetCmd, configSetCmd, configUnsetCmd, configAliasCmd)
+	rootCmd.AddCommand(configCmd)
+}
+
+// configEntry is one row of config list.
+type configEntry struct {
+	Key         string `json:"key"`
+	Value       string `json:"value"`
+	Description string `json:"description"`
+}
+
+func runConfigList(cmd *cobra.Command, args []string) error {
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+
+	entries := make([]configEntry, len(config.Keys))
+	for i, k := range config.Keys {
+		v := k.Get(c)
+		if k.Secret && v != "" {
+			v = maskOrEmpty(v)
+		}
+		entries[i] = configEntry{Key: k.Name, Value: v, Description: k.Help}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, entries)
+	}
+
+	headers := []string{"KEY", "VALUE", "DESCRIPTION"}
+	rows := make([][]string, len(entries))
+	for i, e := range entries {
+		v := e.Value
+		if v == "" {
+			v = "-"
+		}
+		rows[i] = []string{e.Key, v, e.Description}
+	}
+	output.PrintTable(headers, rows)
+	fmt.Printf("\nConfig: %s\n", config.Path())
+	return nil
+}
+
+func runConfigGet(cmd *cobra.Command, args []string) error {
+	k, err := config.LookupKey(args[0])
+	if err != nil {
+		return err
+	}
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	v := k.Get(c)
+	if v == "" {
+		return fmt.Errorf("%s is not set", k.Name)
+	}
+	fmt.Println(v)
+	return nil
+}
+
+func runConfigSet(cmd *cobra.Command, args []string) error {
+	k, err := config.LookupKey(args[0])
+	if err != nil {
+		return err
+	}
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := k.Set(c, args[1]); err != nil {
+		return err
+	}
+	if err := config.Save(c); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	v := k.Get(c)
+	if k.Secret {
+		v = maskOrEmpty(v)
+	}
+	fmt.Printf("✓ %s = %s\n", k.Name, v)
+	return nil
+}
+
+func runConfigUnset(cmd *cobra.Command, args []string) error {
+	k, err := config.LookupKey(args[0])
+	if err != nil {
+		return err
+	}
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if err := k.Unset(c); err != nil {
+		return err
+	}
+	if err := config.Save(c); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	fmt.Printf("✓ %s unset\n", k.Name)
+	return nil
+}
+
+// accountAlias is one row of config alias list.
+type accountAlias struct {
+	Alias     string `json:"alias"`
+	AccountID string `json:"account_id"`
+}
+
+func runConfigAliasAdd(cmd *cobra.Command, args []string) error {
+	alias, account := args[0], api.NormalizeAccountID(args[1])
+	if alias == "" || strings.HasPrefix(alias, "act_") || strings.Trim(alias, "0123456789") == "" {
+		return usageError("invalid alias %q — aliases can't look like account IDs", alias)
+	}
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if c.Aliases == nil {
+		c.Aliases = map[string]string{}
+	}
+	c.Aliases[alias] = account
+	if err := config.Save(c); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	fmt.Printf("✓ %s → %s\n", alias, account)
+	return nil
+}
+
+func runConfigAliasList(cmd *cobra.Command, args []string) error {
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	aliases := make([]accountAlias, 0, len(c.Aliases))
+	for alias, account := range c.Aliases {
+		aliases = append(aliases, accountAlias{Alias: alias, AccountID: account})
+	}
+	sort.Slice(aliases, func(i, j int) bool { return aliases[i].Alias < aliases[j].Alias })
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, aliases)
+	}
+	if len(aliases) == 0 {
+		fmt.Println("No aliases defined. Add one with: meta-ads config alias add <alias> <account_id>")
+		return nil
+	}
+	rows := make([][]string, len(aliases))
+	for i, a := range aliases {
+		rows[i] = []string{a.Alias, a.AccountID}
+	}
+	output.PrintTable([]string{"ALIAS", "ACCOUNT ID"}, rows)
+	return nil
+}
+
+func runConfigAliasRemove(cmd *cobra.Command, args []string) error {
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if _, ok := c.Aliases[args[0]]; !ok {
+		return fmt.Errorf("no alias named %q", args[0])
+	}
+	delete(c.Aliases, args[0])
+	if err := config.Save(c); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	fmt.Printf("✓ Alias %s removed\n", args[0])
+	return nil
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var configAccountCmd = &cobra.Command{
+	Use:   "account",
+	Short: "Manage per-account overrides",
+	Long: `Per-account overrides are applied automatically to commands run against
+that account (via --account, META_ADS_ACCOUNT, or default_account).
+
+Keys:
+  label                Display name shown next to the account ID
+  insight_fields       Default insights get --fields
+  attribution_windows  Default insights get --attribution-windows (e.g. 7d_click,1d_view)
+  currency             Currency code used to display money amounts
+  monthly_target       Monthly spend target in cents, for budgets pacing
+  max_frequency        Frequency flagged by insights frequency (default 3)
+
+Examples:
+  meta-ads config account set shop insight_fields spend,purchase_roas,actions
+  meta-ads config account set act_123 attribution_windows 7d_click,1d_view
+  meta-ads config account show
+  meta-ads config account unset shop currency`,
+}
+
+var configAccountShowCmd = &cobra.Command{
+	Use:   "show [account]",
+	Short: "Show the overrides of one or every account",
+	Args:  cobra.MaximumNArgs(1),
+	RunE:  runConfigAccountShow,
+}
+
+var configAccountSetCmd = &cobra.Command{
+	Use:   "set <account> <key> <value>",
+	Short: "Set a per-account override",
+	Args:  cobra.ExactArgs(3),
+	RunE:  runConfigAccountSet,
+}
+
+var configAccountUnsetCmd = &cobra.Command{
+	Use:   "unset <account> <key>",
+	Short: "Remove a per-account override",
+	Args:  cobra.ExactArgs(2),
+	RunE:  runConfigAccountUnset,
+}
+
+func init() {
+	configAccountCmd.AddCommand(configAccountShowCmd, configAccountSetCmd, configAccountUnsetCmd)
+	configCmd.AddCommand(configAccountCmd)
+}
+
+// accountOverride is one row of config account show.
+type accountOverride struct {
+	Account string `json:"account"`
+	Key     string `json:"key"`
+	Value   string `json:"value"`
+}
+
+func runConfigAccountShow(cmd *cobra.Command, args []string) error {
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	cfg = c
+
+	var accounts []string
+	if len(args) == 1 {
+		accounts = []string{accountID(args[0])}
+	} else {
+		for account := range c.Accounts {
+			accounts = append(accounts, account)
+		}
+		sort.Strings(accounts)
+	}
+
+	overrides := []accountOverride{}
+	for _, account := range accounts {
+		for _, k := range config.AccountKeys {
+			if v := k.Get(c, account); v != "" {
+				overrides = append(overrides, accountOverride{Account: account, Key: k.Name, Value: v})
+			}
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, overrides)
+	}
+	if len(overrides) == 0 {
+		fmt.Println("No account overrides. Add one with: meta-ads config account set <account> <key> <value>")
+		return nil
+	}
+	rows := make([][]string, len(overrides))
+	for i, o := range overrides {
+		rows[i] = []string{o.Account, o.Key, o.Value}
+	}
+	output.PrintTable([]string{"ACCOUNT", "KEY", "VALUE"}, rows)
+	return nil
+}
+
+func runConfigAccountSet(cmd *cobra.Command, args []string) error {
+	return setAccountOverride(args[0], args[1], args[2])
+}
+
+func runConfigAccountUnset(cmd *cobra.Command, args []string) error {
+	return setAccountOverride(args[0], args[1], "")
+}
+
+// setAccountOverride stores (or, for an empty value, removes) key for account.
+func setAccountOverride(account, key, value string) error {
+	k, err := config.LookupAccountKey(key)
+	if err != nil {
+		return err
+	}
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	cfg = c
+	account = accountID(account)
+	if err := k.Set(c, account, value); err != nil {
+		return err
+	}
+	if err := config.Save(c); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+	if value == "" {
+		fmt.Printf("✓ %s %s unset\n", account, k.Name)
+	} else {
+		fmt.Printf("✓ %s %s = %s\n", account, k.Name, k.Get(c, account))
+	}
+	return nil
+}
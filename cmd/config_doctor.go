@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"runtime"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var configDoctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the configuration, token, and account access",
+	Long: `Validates the whole setup and suggests a fix for each problem:
+
+  - config file parses and is only readable by you (0600)
+  - the token is valid (via /debug_token) and not about to expire
+  - the app secret belongs to the token's app
+  - the token has the ads_read / ads_management scopes
+  - the default account is accessible
+
+Exits with a non-zero status when a check fails.`,
+	Args: cobra.NoArgs,
+	RunE: runConfigDoctor,
+}
+
+func init() {
+	configCmd.AddCommand(configDoctorCmd)
+}
+
+// Doctor check outcomes.
+const (
+	checkOK   = "ok"
+	checkWarn = "warn"
+	checkFail = "fail"
+)
+
+// doctorCheck is the outcome of one config doctor check.
+type doctorCheck struct {
+	Name   string `json:"check"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	Fix    string `json:"fix,omitempty"`
+}
+
+func runConfigDoctor(cmd *cobra.Command, args []string) error {
+	var checks []doctorCheck
+	add := func(name, status, detail, fix string) {
+		checks = append(checks, doctorCheck{Name: name, Status: status, Detail: detail, Fix: fix})
+	}
+
+	// Config file
+	path := config.Path()
+	c, err := config.Load()
+	switch {
+	case err != nil:
+		add("config file", checkFail, fmt.Sprintf("%s: %v", path, err), "fix or remove the file, then run: meta-ads auth login")
+	default:
+		if _, statErr := os.Stat(path); errors.Is(statErr, os.ErrNotExist) {
+			add("config file", checkWarn, path+" does not exist", "run: meta-ads auth login (or set META_TOKEN)")
+		} else {
+			add("config file", checkOK, path, "")
+		}
+		cfg = c
+	}
+	if info, statErr := os.Stat(path); statErr == nil && runtime.GOOS != "windows" {
+		if perm := info.Mode().Perm(); perm&0o077 != 0 {
+			add("permissions", checkFail, fmt.Sprintf("%s is %#o — readable by other users", path, perm), "run: chmod 600 "+path)
+		} else {
+			add("permissions", checkOK, fmt.Sprintf("%#o", perm), "")
+		}
+	}
+
+	// Token
+	token, appSecret, err := resolveToken()
+	if err != nil {
+		add("token", checkFail, err.Error(), "run: meta-ads auth login")
+		return printDoctor(cmd, checks)
+	}
+	if client, err = newClient(token, appSecret); err != nil {
+		add("preferences", checkFail, err.Error(), "fix with: meta-ads config set")
+		client = api.NewClient(token, appSecret, api.HTTPOptions{})
+	}
+
+	// The token inspects itself; no appsecret_proof so a wrong secret doesn't hide token problems.
+	params := url.Values{}
+	params.Set("input_token", token)
+	body, err := api.NewClient(token, "", api.HTTPOptions{}).Get("/debug_token", params)
+	var debug api.TokenDebug
+	if err == nil {
+		var resp struct {
+			Data api.TokenDebug `json:"data"`
+		}
+		err = json.Unmarshal(body, &resp)
+		debug = resp.Data
+	}
+	switch {
+	case err != nil:
+		add("token", checkFail, err.Error(), "get a new token: meta-ads auth login")
+	case !debug.IsValid:
+		detail := "token is invalid"
+		if debug.Error != nil {
+			detail += ": " + debug.Error.Message
+		}
+		add("token", checkFail, detail, "get a new token: meta-ads auth login")
+	default:
+		add("token", checkOK, fmt.Sprintf("valid %s token for app %s (%s)", strings.ToLower(debug.Type), debug.Application, debug.AppID), "")
+	}
+
+	if err == nil && debug.IsValid {
+		// Expiry
+		switch {
+		case debug.ExpiresAt == 0:
+			add("expiry", checkOK, "never expires", "")
+		default:
+			exp := time.Unix(debug.ExpiresAt, 0)
+			left := time.Until(exp)
+			switch {
+			case left <= 0:
+				add("expiry", checkFail, "expired on "+exp.Format("2006-01-02"), "run: meta-ads auth login")
+			case left < 24*time.Hour*2 && debug.Type == "USER":
+				add("expiry", checkWarn, fmt.Sprintf("short-lived token, expires on %s", exp.Format("2006-01-02 15:04")),
+					"upgrade it: meta-ads auth extend-token <token> --save")
+			case left < 7*24*time.Hour:
+				add("expiry", checkWarn, fmt.Sprintf("expires on %s (%d days left)", exp.Format("2006-01-02"), int(left.Hours()/24)),
+					"refresh it: meta-ads auth extend-token <token> --save")
+			default:
+				add("expiry", checkOK, fmt.Sprintf("expires on %s (%d days left)", exp.Format("2006-01-02"), int(left.Hours()/24)), "")
+			}
+		}
+
+		// Scopes
+		switch {
+		case slices.Contains(debug.Scopes, "ads_management"):
+			add("scopes", checkOK, strings.Join(debug.Scopes, ", "), "")
+		case slices.Contains(debug.Scopes, "ads_read"):
+			add("scopes", checkWarn, "ads_read only — read-only commands will work, changes will fail",
+				"log in again granting ads_management: meta-ads auth login")
+		default:
+			add("scopes", checkFail, "missing ads_read / ads_management (has: "+strings.Join(debug.Scopes, ", ")+")",
+				"log in again granting ads permissions: meta-ads auth login")
+		}
+		if !slices.Contains(debug.Scopes, "business_management") {
+			add("business scope", checkWarn, "business_management not granted — business commands will fail",
+				"log in again: meta-ads auth login")
+		}
+
+		// App secret
+		appID := os.Getenv("META_APP_ID")
+		if appID == "" && cfg != nil {
+			appID = cfg.AppID
+		}
+		switch {
+		case appSecret == "":
+			add("app secret", checkWarn, "not set — requests are sent without appsecret_proof",
+				"meta-ads config set app_secret <secret> (or export META_APP_SECRET)")
+		case appID != "" && appID != debug.AppID:
+			add("app secret", checkFail, fmt.Sprintf("app_id %s doesn't match the token's app %s", appID, debug.AppID),
+				"set the credentials of app "+debug.AppID+": meta-ads config set app_id / app_secret")
+		default:
+			if _, err := client.Get("/me", url.Values{"fields": {"id"}}); err != nil {
+				add("app secret", checkFail, err.Error(), "set the secret of app "+debug.AppID+": meta-ads config set app_secret <secret>")
+			} else {
+				add("app secret", checkOK, "appsecret_proof accepted", "")
+			}
+		}
+	}
+
+	// Default account
+	if account, err := resolveAccount(); err != nil {
+		add("default account", checkWarn, "none set", "meta-ads config set default_account <act_id>")
+	} else {
+		params := url.Values{}
+		params.Set("fields", "id,name,account_status")
+		if body, err := client.Get("/"+account, params); err != nil {
+			add("default account", checkFail, fmt.Sprintf("%s: %v", account, err), "check the ID with: meta-ads accounts list")
+		} else {
+			var a api.Account
+			_ = json.Unmarshal(body, &a)
+			status := checkOK
+			fix := ""
+			if a.Status != 1 {
+				status = checkWarn
+				fix = "see: meta-ads accounts funding " + account
+			}
+			add("default account", status, fmt.Sprintf("%s (%s) — %s", a.Name, account, accountStatusLabel(a.Status)), fix)
+		}
+	}
+
+	return printDoctor(cmd, checks)
+}
+
+// printDoctor renders the checks and returns an error when one failed.
+func printDoctor(cmd *cobra.Command, checks []doctorCheck) error {
+	failed := 0
+	for _, c := range checks {
+		if c.Status == checkFail {
+			failed++
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		if err := output.Print(cmd, checks); err != nil {
+			return err
+		}
+	} else {
+		printChecks(checks)
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("%d check(s) failed", failed)
+	}
+	return nil
+}
+
+// printChecks prints checks as a ✓ / ! / ✗ list with their fixes.
+func printChecks(checks []doctorCheck) {
+	for _, c := range checks {
+		var mark string
+		switch c.Status {
+		case checkOK:
+			mark = output.Green("✓")
+		case checkWarn:
+			mark = output.Yellow("!")
+		default:
+			mark = output.Red("✗")
+		}
+		fmt.Printf("%s %-16s %s\n", mark, c.Name, c.Detail)
+		if c.Fix != "" {
+			fmt.Printf("  %-16s → %s\n", "", c.Fix)
+		}
+	}
+}
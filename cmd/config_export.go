@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/config"
+)
+
+var (
+	configRedactSecrets bool
+	configImportReplace bool
+)
+
+var configExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Print the configuration as JSON (for provisioning other machines)",
+	Long: `Print the configuration as JSON, decrypted, on stdout.
+
+With --redact-secrets the access token, app secret and user fields are left
+out, so the file can be shared and the token injected via META_TOKEN.
+
+Examples:
+  meta-ads config export --redact-secrets > team.json
+  meta-ads config import team.json`,
+	Args: cobra.NoArgs,
+	RunE: runConfigExport,
+}
+
+var configImportCmd = &cobra.Command{
+	Use:   "import <file|->",
+	Short: "Merge settings from an exported config file (or stdin)",
+	Long: `Merge settings from a file written by config export ("-" reads stdin).
+
+Values in the file override local ones and aliases are merged; the local token
+is kept unless the file carries one. Use --replace to discard the local
+defaults, aliases and preferences first (credentials are still kept).`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConfigImport,
+}
+
+func init() {
+	configExportCmd.Flags().BoolVar(&configRedactSecrets, "redact-secrets", false, "Leave out the access token, app secret and user fields")
+	configImportCmd.Flags().BoolVar(&configImportReplace, "replace", false, "Replace the local settings instead of merging")
+	configCmd.AddCommand(configExportCmd, configImportCmd)
+}
+
+func runConfigExport(cmd *cobra.Command, args []string) error {
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if configRedactSecrets {
+		c = c.Redacted()
+	}
+	out := *c
+	out.Encrypted = nil
+	data, err := json.MarshalIndent(&out, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(data))
+	return nil
+}
+
+func runConfigImport(cmd *cobra.Command, args []string) error {
+	var data []byte
+	var err error
+	if args[0] == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(args[0])
+	}
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", args[0], err)
+	}
+
+	var imported config.Config
+	if err := json.Unmarshal(data, &imported); err != nil {
+		return fmt.Errorf("parsing %s: %w", args[0], err)
+	}
+	if imported.Encrypted != nil {
+		return fmt.Errorf("%s is encrypted — export it with config export on the source machine instead", args[0])
+	}
+	// Validate the preferences the same way config set does.
+	for _, k := range config.Keys {
+		if k.ReadOnly {
+			continue
+		}
+		if v := k.Get(&imported); v != "" {
+			if err := k.Set(&config.Config{}, v); err != nil {
+				return fmt.Errorf("%s: %w", args[0], err)
+			}
+		}
+	}
+
+	c, err := config.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load config: %w", err)
+	}
+	if configImportReplace {
+		c = c.Credentials()
+	}
+	c.Merge(&imported)
+	if err := config.Save(c); err != nil {
+		return fmt.Errorf("failed to save config: %w", err)
+	}
+
+	fmt.Printf("✓ Imported %s into %s\n", args[0], config.Path())
+	if c.AccessToken == "" {
+		fmt.Println("  No token stored — set META_TOKEN or run: meta-ads auth login")
+	}
+	return nil
+}
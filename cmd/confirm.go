@@ -0,0 +1,127 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+// yesFlag answers yes to every confirmation prompt.
+var yesFlag bool
+
+// defaultBudgetThreshold is the budget increase, in percent, above which
+// updates ask for confirmation unless confirm_budget_increase says otherwise.
+const defaultBudgetThreshold = 20
+
+// canConfirm reports whether confirmation prompts are shown: only in a
+// terminal, so scripts and pipes are never blocked, and not with --yes or
+// --dry-run.
+func canConfirm() bool {
+	return !yesFlag && !dryRunFlag && isatty.IsTerminal(os.Stdin.Fd()) && isatty.IsTerminal(os.Stderr.Fd())
+}
+
+// confirm asks "<action>? [y/N]" and fails unless the user answers yes.
+func confirm(action string) error {
+	if !canConfirm() {
+		return nil
+	}
+	fmt.Fprintf(os.Stderr, "%s? [y/N] ", action)
+	line, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return nil
+	}
+	return errors.New("aborted (pass --yes to skip this prompt)")
+}
+
+// objectLabel returns `id "name"` for prompts, so a mistyped ID shows which
+// object it actually points at. The name is only fetched when prompts are shown.
+func objectLabel(id string) string {
+	if !canConfirm() {
+		return id
+	}
+	var o struct {
+		Name string `json:"name"`
+	}
+	if err := getObject(id, "name", &o); err != nil || o.Name == "" {
+		return id
+	}
+	return fmt.Sprintf("%s %q", id, o.Name)
+}
+
+// confirmStatus asks before setting a kind's status to one that starts
+// spending or can't be undone: ACTIVE, ARCHIVED or DELETED.
+func confirmStatus(kind, id, status string) error {
+	status = strings.ToUpper(status)
+	if status != "ACTIVE" && status != "ARCHIVED" && status != "DELETED" || !canConfirm() {
+		return nil
+	}
+	return confirm(fmt.Sprintf("Set %s %s to %s", kind, objectLabel(id), status))
+}
+
+// confirmBudget asks before raising the daily or lifetime budget (in cents)
+// of a campaign or ad set by more than the confirm_budget_increase threshold.
+func confirmBudget(kind, id, daily, lifetime string) error {
+	if daily == "" && lifetime == "" || !canConfirm() {
+		return nil
+	}
+	threshold, enabled, err := budgetThreshold()
+	if err != nil || !enabled {
+		return err
+	}
+
+	var cur struct {
+		Name           string         `json:"name"`
+		AccountID      string         `json:"account_id"`
+		DailyBudget    api.FlexString `json:"daily_budget"`
+		LifetimeBudget api.FlexString `json:"lifetime_budget"`
+	}
+	if err := getObject(id, "name,account_id,daily_budget,lifetime_budget", &cur); err != nil {
+		// Without the current budget there is nothing to compare against.
+		return nil
+	}
+	useAccountCurrency("act_" + cur.AccountID)
+
+	for _, b := range []struct {
+		what     string
+		from, to string
+	}{
+		{"daily", cur.DailyBudget.String(), daily},
+		{"lifetime", cur.LifetimeBudget.String(), lifetime},
+	} {
+		from, _ := strconv.ParseFloat(b.from, 64)
+		to, err := strconv.ParseFloat(b.to, 64)
+		if b.to == "" || err != nil || from <= 0 || to <= from*(1+threshold/100) {
+			continue
+		}
+		action := fmt.Sprintf("Raise the %s budget of %s %s %q from %s to %s (+%.0f%%)",
+			b.what, kind, id, cur.Name, output.FormatBudget(b.from), output.FormatBudget(b.to), (to/from-1)*100)
+		if err := confirm(action); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// budgetThreshold returns the confirm_budget_increase preference
+// (META_ADS_CONFIRM_BUDGET_INCREASE > config > 20%).
+func budgetThreshold() (percent float64, enabled bool, err error) {
+	loadConfig()
+	var c config.Config
+	if cfg != nil {
+		c = *cfg
+	}
+	v := preference("META_ADS_CONFIRM_BUDGET_INCREASE", c.ConfirmBudgetIncrease)
+	if v == "" {
+		return defaultBudgetThreshold, true, nil
+	}
+	return config.ParseBudgetThreshold(v)
+}
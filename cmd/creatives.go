@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+	"github.com/the20100/meta-ads-cli/internal/spec"
+)
+
+var (
+	generateBase      string
+	generateHeadlines string
+	generateBodies    string
+	generateImages    []string
+	generateName      string
+	generateAdSet     string
+	generateAdStatus  string
+)
+
+// creativeVariation is one creative made by creatives generate.
+type creativeVariation struct {
+	N          int    `json:"n"`
+	Name       string `json:"name"`
+	Headline   string `json:"headline,omitempty"`
+	Body       string `json:"body,omitempty"`
+	ImageHash  string `json:"image_hash,omitempty"`
+	CreativeID string `json:"creative_id,omitempty"`
+	AdID       string `json:"ad_id,omitempty"`
+}
+
+var creativesCmd = &cobra.Command{
+	Use:   "creatives",
+	Short: "Create ad creatives",
+}
+
+var creativesGenerateCmd = &cobra.Command{
+	Use:   "generate --base <creative> [--headlines file] [--bodies file] [--images hashes]",
+	Short: "Create every combination of headlines, texts and images as separate creatives",
+	Long: `Create one creative per combination of --headlines, --bodies and --images,
+starting from a base creative in YAML or JSON (the creative: block of an
+apply spec; @file, or - for stdin). Variations replace the headline, primary
+text and image of object_story_spec.link_data, or the title, message and
+thumbnail of video_data; a list that isn't given keeps the base value.
+
+With --adset, an ad is also created for each creative (PAUSED unless
+--ad-status), named like the creative.
+
+--name is a template; placeholders:
+  {base}      name of the base creative
+  {n}         number of the variation, from 1
+  {h} {b} {i} number of the headline, text and image used, from 1
+  {headline} {body} {image}  the values themselves
+
+Examples:
+  meta-ads creatives generate --base @creative.yaml --headlines headlines.txt \
+    --images 1a2b3c,4d5e6f -a act_123456789
+  meta-ads creatives generate --base @creative.yaml --bodies texts.txt \
+    --adset 120210000000001 --name "{base} | T{b} | {image}"
+  meta-ads creatives generate --base @creative.yaml --headlines headlines.txt --dry-run`,
+	Args: cobra.NoArgs,
+	RunE: runCreativesGenerate,
+}
+
+func init() {
+	creativesGenerateCmd.Flags().StringVar(&generateBase, "base", "", "Base creative in YAML or JSON: @file, - for stdin, or inline (required)")
+	creativesGenerateCmd.Flags().StringVar(&generateHeadlines, "headlines", "", "File with one headline per line (- for stdin)")
+	creativesGenerateCmd.Flags().StringVar(&generateBodies, "bodies", "", "File with one primary text per line (- for stdin)")
+	creativesGenerateCmd.Flags().StringSliceVar(&generateImages, "images", nil, "Image hashes (see assets images list)")
+	creativesGenerateCmd.Flags().StringVar(&generateName, "name", "{base} #{n}", "Name template of the creatives (and ads)")
+	creativesGenerateCmd.Flags().StringVar(&generateAdSet, "adset", "", "Also create an ad for each creative in this ad set")
+	creativesGenerateCmd.Flags().StringVar(&generateAdStatus, "ad-status", "PAUSED", "Status of the ads created with --adset")
+	_ = creativesGenerateCmd.MarkFlagRequired("base")
+	_ = creativesGenerateCmd.RegisterFlagCompletionFunc("adset", completeObjectFlag("adset"))
+
+	creativesCmd.AddCommand(creativesGenerateCmd)
+	rootCmd.AddCommand(creativesCmd)
+}
+
+// readLines reads the non-empty lines of path ("-" for stdin, "@path" also
+// accepted).
+func readLines(path string) ([]string, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		data, err := os.ReadFile(strings.TrimPrefix(path, "@"))
+		if err != nil {
+			return nil, err
+		}
+		r = bytes.NewReader(data)
+	}
+	var lines []string
+	sc := bufio.NewScanner(r)
+	for sc.Scan() {
+		if line := strings.TrimSpace(sc.Text()); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines, sc.Err()
+}
+
+// variationKeys are the object_story_spec keys holding the headline, text and
+// image of link and video creatives.
+var variationKeys = map[string][3]string{
+	"link_data":  {"name", "message", "image_hash"},
+	"video_data": {"title", "message", "image_hash"},
+}
+
+// creativeVariationFields returns the fields creating a variation of base.
+// Empty values keep the base ones.
+func creativeVariationFields(base *spec.Creative, name, headline, body, image string) (map[string]any, error) {
+	fields := base.Fields()
+	fields["name"] = name
+	if headline == "" && body == "" && image == "" {
+		return fields, nil
+	}
+	for dataKey, keys := range variationKeys {
+		data, ok := base.ObjectStorySpec[dataKey].(map[string]any)
+		if !ok {
+			continue
+		}
+		data = maps.Clone(data)
+		for i, v := range []string{headline, body, image} {
+			if v != "" {
+				data[keys[i]] = v
+			}
+		}
+		if image != "" {
+			// An image hash replaces the image or thumbnail given by URL.
+			delete(data, "picture")
+			delete(data, "image_url")
+		}
+		story := maps.Clone(base.ObjectStorySpec)
+		story[dataKey] = data
+		fields["object_story_spec"] = story
+		return fields, nil
+	}
+	return nil, fmt.Errorf("the base creative has no object_story_spec.link_data or video_data to vary")
+}
+
+// expandNameTemplate fills the placeholders of the --name template.
+func expandNameTemplate(tmpl, base string, v creativeVariation, h, b, i int) string {
+	return strings.NewReplacer(
+		"{base}", base,
+		"{n}", strconv.Itoa(v.N),
+		"{h}", strconv.Itoa(h),
+		"{b}", strconv.Itoa(b),
+		"{i}", strconv.Itoa(i),
+		"{headline}", v.Headline,
+		"{body}", v.Body,
+		"{image}", v.ImageHash,
+	).Replace(tmpl)
+}
+
+func runCreativesGenerate(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+	data, err := readArgValue(generateBase)
+	if err != nil {
+		return err
+	}
+	base, err := spec.ParseCreative(data)
+	if err != nil {
+		return usageError("--base: %w", err)
+	}
+
+	// A list that isn't given varies nothing: one empty value keeps the base.
+	headlines, bodies, images := []string{""}, []string{""}, []string{""}
+	if generateHeadlines != "" {
+		if headlines, err = readLines(generateHeadlines); err != nil {
+			return fmt.Errorf("reading --headlines: %w", err)
+		}
+	}
+	if generateBodies != "" {
+		if bodies, err = readLines(generateBodies); err != nil {
+			return fmt.Errorf("reading --bodies: %w", err)
+		}
+	}
+	if len(generateImages) > 0 {
+		images = generateImages
+	}
+	total := len(headlines) * len(bodies) * len(images)
+	if total == 0 {
+		return fmt.Errorf("no variations: --headlines or --bodies has no lines")
+	}
+	if total == 1 && generateHeadlines == "" && generateBodies == "" && len(generateImages) == 0 {
+		return fmt.Errorf("nothing to vary — give --headlines, --bodies or --images")
+	}
+
+	var results []creativeVariation
+	report := func() error {
+		if !output.IsTable(cmd) {
+			return output.Print(cmd, results)
+		}
+		rows := make([][]string, len(results))
+		for i, v := range results {
+			rows[i] = []string{strconv.Itoa(v.N), output.Truncate(v.Name, 50), orDash(v.CreativeID), orDash(v.AdID)}
+		}
+		output.PrintTable([]string{"N", "NAME", "CREATIVE", "AD"}, rows)
+		return nil
+	}
+
+	for h, headline := range headlines {
+		for b, body := range bodies {
+			for i, image := range images {
+				v := creativeVariation{N: len(results) + 1, Headline: headline, Body: body, ImageHash: image}
+				v.Name = expandNameTemplate(generateName, base.Name, v, h+1, b+1, i+1)
+				fields, err := creativeVariationFields(base, v.Name, headline, body, image)
+				if err != nil {
+					return err
+				}
+				if _, err := applyObject("", "/"+account+"/adcreatives", fields, func(id string) { v.CreativeID = id }); err != nil {
+					_ = report()
+					return fmt.Errorf("creative %q: %w", v.Name, err)
+				}
+				if generateAdSet != "" {
+					ad := map[string]any{
+						"name":     v.Name,
+						"adset_id": generateAdSet,
+						"creative": map[string]any{"creative_id": v.CreativeID},
+						"status":   strings.ToUpper(generateAdStatus),
+					}
+					if _, err := applyObject("", "/"+account+"/ads", ad, func(id string) { v.AdID = id }); err != nil {
+						results = append(results, v)
+						_ = report()
+						return fmt.Errorf("ad %q: %w", v.Name, err)
+					}
+				}
+				results = append(results, v)
+			}
+		}
+	}
+	if err := report(); err != nil {
+		return err
+	}
+	if output.IsTable(cmd) {
+		fmt.Printf("\n✓ %d creative(s) created\n", len(results))
+	}
+	return nil
+}
@@ -0,0 +1,248 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	linksCampaign string
+	linksTimeout  time.Duration
+	linksSlow     time.Duration
+)
+
+// linkCheck is the result of checking one destination URL.
+type linkCheck struct {
+	URL string `json:"url"`
+	// Status is "ok", "slow" or "broken".
+	Status     string   `json:"status"`
+	Code       int      `json:"code,omitempty"`
+	FinalURL   string   `json:"final_url,omitempty"`
+	Redirects  int      `json:"redirects"`
+	DurationMS int64    `json:"duration_ms"`
+	Error      string   `json:"error,omitempty"`
+	Ads        []string `json:"ads"`
+}
+
+var creativesCheckLinksCmd = &cobra.Command{
+	Use:   "check-links",
+	Short: "Check the landing pages of active ads for errors, redirects and slowness",
+	Long: `Collect the destination URLs of the account's active ads (link, carousel
+cards, call to action and dynamic creative URLs, with the creative's url_tags)
+and request each one, following redirects.
+
+A URL is broken when it can't be reached or ends with an HTTP error status,
+and slow when it takes longer than --slow. The command exits with status 1
+when a landing page is broken, so it can run from cron.
+
+Examples:
+  meta-ads creatives check-links -a act_123456789
+  meta-ads creatives check-links --campaign 120210000000000 --json`,
+	Args: cobra.NoArgs,
+	RunE: runCreativesCheckLinks,
+}
+
+func init() {
+	creativesCheckLinksCmd.Flags().StringVar(&linksCampaign, "campaign", "", "Only check the ads of this campaign")
+	creativesCheckLinksCmd.Flags().DurationVar(&linksTimeout, "timeout", 15*time.Second, "Timeout of each request")
+	creativesCheckLinksCmd.Flags().DurationVar(&linksSlow, "slow", 3*time.Second, "Report landing pages slower than this")
+	_ = creativesCheckLinksCmd.RegisterFlagCompletionFunc("campaign", completeObjectFlag("campaign"))
+	creativesCmd.AddCommand(creativesCheckLinksCmd)
+}
+
+// linkKeys are the creative keys holding destination URLs.
+var linkKeys = map[string]bool{"link": true, "link_url": true, "object_url": true, "website_url": true}
+
+// collectLinks records the destination URLs found at any depth of v.
+func collectLinks(v any, links map[string]bool) {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, child := range v {
+			if s, ok := child.(string); ok && linkKeys[k] {
+				if strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://") {
+					links[s] = true
+				}
+				continue
+			}
+			collectLinks(child, links)
+		}
+	case []any:
+		for _, child := range v {
+			collectLinks(child, links)
+		}
+	}
+}
+
+// withURLTags appends a creative's url_tags to the query of link, as Meta
+// does when serving the ad.
+func withURLTags(link, tags string) string {
+	if tags == "" {
+		return link
+	}
+	sep := "?"
+	if strings.Contains(link, "?") {
+		sep = "&"
+	}
+	return link + sep + strings.TrimPrefix(tags, "?")
+}
+
+// checkLink requests link with client and fills in the outcome.
+func checkLink(c *http.Client, link string) linkCheck {
+	r := linkCheck{URL: link}
+	req, err := http.NewRequest(http.MethodGet, link, nil)
+	if err != nil {
+		r.Status, r.Error = "broken", err.Error()
+		return r
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; meta-ads-cli link checker)")
+	start := time.Now()
+	resp, err := c.Do(req)
+	r.DurationMS = time.Since(start).Milliseconds()
+	if err != nil {
+		// Drop the "Get <url>:" prefix; the URL is reported already.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		r.Status, r.Error = "broken", err.Error()
+		return r
+	}
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<20))
+	resp.Body.Close()
+
+	r.Code = resp.StatusCode
+	r.FinalURL = resp.Request.URL.String()
+	for prev := resp.Request.Response; prev != nil; prev = prev.Request.Response {
+		r.Redirects++
+	}
+	switch {
+	case resp.StatusCode >= 400:
+		r.Status, r.Error = "broken", resp.Status
+	case time.Duration(r.DurationMS)*time.Millisecond > linksSlow:
+		r.Status = "slow"
+	default:
+		r.Status = "ok"
+	}
+	return r
+}
+
+func runCreativesCheckLinks(cmd *cobra.Command, args []string) error {
+	path := ""
+	if linksCampaign != "" {
+		path = "/" + linksCampaign + "/ads"
+	} else {
+		account, err := resolveAccount()
+		if err != nil {
+			return err
+		}
+		path = "/" + account + "/ads"
+	}
+
+	params := url.Values{}
+	params.Set("fields", "id,effective_status,creative{object_story_spec,asset_feed_spec,link_url,object_url,url_tags}")
+	params.Set("effective_status", `["ACTIVE"]`)
+	adsByLink := map[string][]string{}
+	err := client.GetEach(path, params, func(raw json.RawMessage) error {
+		var ad struct {
+			ID              string `json:"id"`
+			EffectiveStatus string `json:"effective_status"`
+			Creative        struct {
+				URLTags string `json:"url_tags"`
+			} `json:"creative"`
+		}
+		var tree map[string]any
+		if err := json.Unmarshal(raw, &ad); err != nil {
+			return fmt.Errorf("parsing ad: %w", err)
+		}
+		if ad.EffectiveStatus != "ACTIVE" || json.Unmarshal(raw, &tree) != nil {
+			return nil
+		}
+		links := map[string]bool{}
+		collectLinks(tree["creative"], links)
+		for link := range links {
+			link = withURLTags(link, ad.Creative.URLTags)
+			adsByLink[link] = append(adsByLink[link], ad.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	links := make([]string, 0, len(adsByLink))
+	for link := range adsByLink {
+		links = append(links, link)
+	}
+	sort.Strings(links)
+
+	httpClient := &http.Client{Timeout: linksTimeout}
+	results := make([]linkCheck, len(links))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, 8)
+	for i, link := range links {
+		wg.Add(1)
+		go func(i int, link string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			results[i] = checkLink(httpClient, link)
+			results[i].Ads = adsByLink[link]
+		}(i, link)
+	}
+	wg.Wait()
+
+	broken := 0
+	for _, r := range results {
+		if r.Status == "broken" {
+			broken++
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		if err := output.Print(cmd, results); err != nil {
+			return err
+		}
+	} else if len(results) == 0 {
+		fmt.Println("No destination URLs found in active ads.")
+	} else {
+		// Broken first, then slow.
+		order := map[string]int{"broken": 0, "slow": 1, "ok": 2}
+		sort.SliceStable(results, func(i, j int) bool { return order[results[i].Status] < order[results[j].Status] })
+		rows := make([][]string, len(results))
+		for i, r := range results {
+			code := "-"
+			if r.Code > 0 {
+				code = strconv.Itoa(r.Code)
+			}
+			status := r.Status
+			if r.Error != "" && r.Code == 0 {
+				status += ": " + output.Truncate(r.Error, 40)
+			}
+			rows[i] = []string{
+				status,
+				code,
+				fmt.Sprintf("%.1fs", float64(r.DurationMS)/1000),
+				strconv.Itoa(r.Redirects),
+				strconv.Itoa(len(r.Ads)),
+				output.Truncate(r.URL, 70),
+			}
+		}
+		output.PrintTable([]string{"STATUS", "CODE", "TIME", "REDIRECTS", "ADS", "URL"}, rows)
+	}
+	if broken > 0 {
+		return fmt.Errorf("%d of %d landing page(s) broken", broken, len(results))
+	}
+	return nil
+}
@@ -0,0 +1,323 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	ccName           string
+	ccDescription    string
+	ccPixel          string
+	ccEventType      string
+	ccDefaultValue   string
+	ccRule           string
+	ccURLContains    []string
+	ccURLEquals      []string
+	ccURLNotContains []string
+	ccEventName      string
+)
+
+var customConversionsCmd = &cobra.Command{
+	Use:     "custom-conversions",
+	Aliases: []string{"cc"},
+	Short:   "Manage custom conversions",
+}
+
+var customConversionsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List custom conversions for an ad account",
+	RunE:  runCustomConversionsList,
+}
+
+var customConversionsGetCmd = &cobra.Command{
+	Use:   "get <custom_conversion_id>",
+	Short: "Get details for a custom conversion (including its rule)",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCustomConversionsGet,
+}
+
+var customConversionsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a custom conversion from a pixel rule",
+	Long: `Create a custom conversion on the ad account.
+
+The rule can be passed as raw JSON (--rule, or --rule @rule.json) or built from
+URL and event conditions. Repeated --url-contains / --url-equals values are OR'd;
+every other condition is AND'd.
+
+Examples:
+  meta-ads custom-conversions create --name "Thank-you page" --pixel 123456789 \
+    --event-type PURCHASE --url-contains /thank-you
+
+  meta-ads custom-conversions create --name "Big purchases" --pixel 123456789 \
+    --event-type PURCHASE --event Purchase --url-not-contains /test
+
+  meta-ads custom-conversions create --name "From file" --pixel 123456789 \
+    --event-type LEAD --rule @rule.json`,
+	RunE: runCustomConversionsCreate,
+}
+
+var customConversionsDeleteCmd = &cobra.Command{
+	Use:   "delete <custom_conversion_id>",
+	Short: "Delete a custom conversion",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runCustomConversionsDelete,
+}
+
+func init() {
+	customConversionsCreateCmd.Flags().StringVar(&ccName, "name", "", "Custom conversion name (required)")
+	customConversionsCreateCmd.Flags().StringVar(&ccDescription, "description", "", "Description")
+	customConversionsCreateCmd.Flags().StringVar(&ccPixel, "pixel", "", "Pixel ID the rule applies to (required)")
+	customConversionsCreateCmd.Flags().StringVar(&ccEventType, "event-type", "OTHER", "Standard event category: PURCHASE, LEAD, COMPLETE_REGISTRATION, ADD_TO_CART, ..., OTHER")
+	customConversionsCreateCmd.Flags().StringVar(&ccDefaultValue, "default-value", "", "Default conversion value")
+	customConversionsCreateCmd.Flags().StringVar(&ccRule, "rule", "", "Raw rule JSON, or @file.json")
+	customConversionsCreateCmd.Flags().StringArrayVar(&ccURLContains, "url-contains", nil, "Match URLs containing this string (repeatable, OR'd)")
+	customConversionsCreateCmd.Flags().StringArrayVar(&ccURLEquals, "url-equals", nil, "Match this exact URL (repeatable, OR'd)")
+	customConversionsCreateCmd.Flags().StringArrayVar(&ccURLNotContains, "url-not-contains", nil, "Exclude URLs containing this string (repeatable)")
+	customConversionsCreateCmd.Flags().StringVar(&ccEventName, "event", "", "Match this pixel event name, e.g. Purchase")
+	_ = customConversionsCreateCmd.MarkFlagRequired("name")
+	_ = customConversionsCreateCmd.MarkFlagRequired("pixel")
+	addFromJSONFlag(customConversionsCreateCmd, jsonBody{
+		fields: []string{"name", "event_source_id", "custom_event_type", "rule", "description",
+			"default_conversion_value", "advanced_rule"},
+		required: []string{"name", "event_source_id", "rule"},
+		flags: []string{"name", "description", "pixel", "event-type", "default-value", "rule",
+			"url-contains", "url-equals", "url-not-contains", "event"},
+	})
+
+	addCountFlag(customConversionsListCmd)
+	addPagingFlags(customConversionsListCmd)
+	addLimitFlag(customConversionsListCmd, "custom conversions")
+
+	customConversionsCmd.AddCommand(customConversionsListCmd, customConversionsGetCmd, customConversionsCreateCmd, customConversionsDeleteCmd)
+	rootCmd.AddCommand(customConversionsCmd)
+}
+
+const customConversionFields = "id,name,description,custom_event_type,event_source_type,rule,default_conversion_value,pixel{id},creation_time,last_fired_time,is_archived,is_unavailable"
+
+func runCustomConversionsList(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+
+	params := url.Values{}
+	params.Set("fields", customConversionFields)
+
+	conversions, streamed, err := listAll[api.CustomConversion](cmd, "/"+account+"/customconversions", params, "custom conversion", nil)
+	if err != nil || streamed {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, conversions)
+	}
+
+	headers := []string{"ID", "NAME", "EVENT TYPE", "PIXEL", "LAST FIRED", "ARCHIVED"}
+	rows := make([][]string, len(conversions))
+	for i, c := range conversions {
+		pixel := ""
+		if c.Pixel != nil {
+			pixel = c.Pixel.ID
+		}
+		archived := "no"
+		if c.IsArchived {
+			archived = "yes"
+		}
+		rows[i] = []string{
+			c.ID,
+			output.Truncate(c.Name, 40),
+			c.CustomEventType,
+			pixel,
+			output.FormatTime(c.LastFiredTime),
+			archived,
+		}
+	}
+	output.PrintTable(headers, rows)
+	return nil
+}
+
+func runCustomConversionsGet(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	params := url.Values{}
+	params.Set("fields", customConversionFields)
+
+	body, err := client.Get("/"+id, params)
+	if err != nil {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, json.RawMessage(body))
+	}
+
+	var c api.CustomConversion
+	if err := json.Unmarshal(body, &c); err != nil {
+		return fmt.Errorf("parsing custom conversion: %w", err)
+	}
+
+	pixel := ""
+	if c.Pixel != nil {
+		pixel = c.Pixel.ID
+	}
+	rows := [][]string{
+		{"ID", c.ID},
+		{"Name", c.Name},
+		{"Description", c.Description},
+		{"Event Type", c.CustomEventType},
+		{"Event Source", c.EventSourceType},
+		{"Pixel", pixel},
+		{"Default Value", c.DefaultConversionValue.String()},
+		{"Created", output.FormatTime(c.CreationTime)},
+		{"Last Fired", output.FormatTime(c.LastFiredTime)},
+	}
+	output.PrintKeyValue(rows)
+
+	if len(c.Rule) > 0 {
+		fmt.Println()
+		fmt.Println("RULE")
+		fmt.Println(strings.Repeat("─", 60))
+		// The rule is returned as a JSON-encoded string.
+		rule := c.Rule
+		var s string
+		if json.Unmarshal(rule, &s) == nil {
+			rule = json.RawMessage(s)
+		}
+		printIndentedJSON(rule)
+	}
+	return nil
+}
+
+func runCustomConversionsCreate(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+
+	body, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	if body == nil {
+		rule, err := buildConversionRule()
+		if err != nil {
+			return err
+		}
+		body = url.Values{}
+		body.Set("name", ccName)
+		body.Set("event_source_id", ccPixel)
+		body.Set("custom_event_type", strings.ToUpper(ccEventType))
+		body.Set("rule", rule)
+		if ccDescription != "" {
+			body.Set("description", ccDescription)
+		}
+		if ccDefaultValue != "" {
+			body.Set("default_conversion_value", ccDefaultValue)
+		}
+	}
+
+	resp, err := client.Post("/"+account+"/customconversions", body)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, result)
+	}
+	fmt.Printf("✓ Custom conversion created: %s\n", result.ID)
+	fmt.Printf("  Rule: %s\n", body.Get("rule"))
+	return nil
+}
+
+func runCustomConversionsDelete(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	if err := confirm("Delete custom conversion " + objectLabel(id)); err != nil {
+		return err
+	}
+
+	resp, err := client.Delete("/"+id, nil)
+	if err != nil {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, json.RawMessage(resp))
+	}
+	fmt.Printf("✓ Custom conversion %s deleted\n", id)
+	return nil
+}
+
+// buildConversionRule returns the rule JSON from --rule or the condition flags.
+func buildConversionRule() (string, error) {
+	hasConditions := len(ccURLContains) > 0 || len(ccURLEquals) > 0 || len(ccURLNotContains) > 0 || ccEventName != ""
+	if ccRule != "" {
+		if hasConditions {
+			return "", usageError("--rule cannot be combined with --url-* or --event")
+		}
+		data, err := readArgValue(ccRule)
+		if err != nil {
+			return "", err
+		}
+		if !json.Valid(data) {
+			return "", usageError("--rule is not valid JSON")
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	if !hasConditions {
+		return "", fmt.Errorf("no rule — use --rule, --url-contains, --url-equals, --url-not-contains, or --event")
+	}
+	return pixelRule(ccURLContains, ccURLEquals, ccURLNotContains, ccEventName)
+}
+
+// pixelRule builds a pixel rule in the filter syntax shared by custom conversions
+// and website custom audiences: contains/equals URL conditions are OR'd together,
+// and the result is AND'd with the exclusions and the event name condition.
+func pixelRule(urlContains, urlEquals, urlNotContains []string, eventName string) (string, error) {
+	var and []map[string]any
+
+	var or []map[string]any
+	for _, v := range urlContains {
+		or = append(or, map[string]any{"url": map[string]string{"i_contains": v}})
+	}
+	for _, v := range urlEquals {
+		or = append(or, map[string]any{"url": map[string]string{"eq": v}})
+	}
+	switch len(or) {
+	case 0:
+	case 1:
+		and = append(and, or[0])
+	default:
+		and = append(and, map[string]any{"or": or})
+	}
+
+	for _, v := range urlNotContains {
+		and = append(and, map[string]any{"url": map[string]string{"i_not_contains": v}})
+	}
+	if eventName != "" {
+		and = append(and, map[string]any{"event": map[string]string{"eq": eventName}})
+	}
+
+	var rule any = map[string]any{"and": and}
+	if len(and) == 1 {
+		rule = and[0]
+	}
+	b, err := json.Marshal(rule)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,273 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+const (
+	diagnoseCommonFields   = "id,name,status,effective_status,daily_budget,lifetime_budget,budget_remaining,start_time,issues_info,recommendations,account_id"
+	diagnoseAdsetFields    = diagnoseCommonFields + ",end_time,learning_stage_info,campaign{id,name,effective_status,daily_budget,lifetime_budget,budget_remaining}"
+	diagnoseCampaignFields = diagnoseCommonFields + ",stop_time"
+)
+
+var diagnoseCmd = &cobra.Command{
+	Use:   "diagnose <campaign_or_adset_id>",
+	Short: "Explain why a campaign or ad set isn't delivering",
+	Long: `Gather everything that stops a campaign or ad set from spending into one
+report: account status, effective status, schedule, budget, delivery issues,
+learning phase, active children, Meta's recommendations, and the last 7 days of
+delivery.
+
+Each line is marked ✓ (fine), ! (may limit delivery) or ✗ (blocks delivery).`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDiagnose,
+}
+
+func init() {
+	rootCmd.AddCommand(diagnoseCmd)
+}
+
+// diagnosedObject holds the fields of a campaign or ad set used by diagnose.
+type diagnosedObject struct {
+	ID              string               `json:"id"`
+	Name            string               `json:"name"`
+	Status          string               `json:"status"`
+	EffectiveStatus string               `json:"effective_status"`
+	DailyBudget     api.FlexString       `json:"daily_budget"`
+	LifetimeBudget  api.FlexString       `json:"lifetime_budget"`
+	BudgetRemaining api.FlexString       `json:"budget_remaining"`
+	StartTime       string               `json:"start_time"`
+	EndTime         string               `json:"end_time"`
+	StopTime        string               `json:"stop_time"`
+	AccountID       string               `json:"account_id"`
+	Recommendations []api.Recommendation `json:"recommendations"`
+	IssuesInfo      []struct {
+		Level        string `json:"level"`
+		ErrorSummary string `json:"error_summary"`
+		ErrorMessage string `json:"error_message"`
+	} `json:"issues_info"`
+	LearningStageInfo *struct {
+		Status      string `json:"status"`
+		Conversions int    `json:"conversions"`
+	} `json:"learning_stage_info"`
+	Campaign *struct {
+		ID              string         `json:"id"`
+		Name            string         `json:"name"`
+		EffectiveStatus string         `json:"effective_status"`
+		DailyBudget     api.FlexString `json:"daily_budget"`
+		LifetimeBudget  api.FlexString `json:"lifetime_budget"`
+		BudgetRemaining api.FlexString `json:"budget_remaining"`
+	} `json:"campaign"`
+}
+
+// diagnoseDay is the delivery of one day.
+type diagnoseDay struct {
+	Date        string `json:"date_start"`
+	Spend       string `json:"spend"`
+	Impressions string `json:"impressions"`
+	Clicks      string `json:"clicks"`
+}
+
+// diagnoseReport is the output of diagnose.
+type diagnoseReport struct {
+	ID       string        `json:"id"`
+	Name     string        `json:"name"`
+	Level    string        `json:"level"`
+	Checks   []doctorCheck `json:"checks"`
+	Delivery []diagnoseDay `json:"delivery"`
+}
+
+func runDiagnose(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	level := "adset"
+	var obj diagnosedObject
+	if err := getObject(id, diagnoseAdsetFields, &obj); err != nil {
+		level = "campaign"
+		if err := getObject(id, diagnoseCampaignFields, &obj); err != nil {
+			return fmt.Errorf("%s is not a readable campaign or ad set: %w", id, err)
+		}
+	}
+	account := "act_" + obj.AccountID
+	useAccountCurrency(account)
+
+	report := diagnoseReport{ID: obj.ID, Name: obj.Name, Level: level}
+	add := func(name, status, detail, fix string) {
+		report.Checks = append(report.Checks, doctorCheck{Name: name, Status: status, Detail: detail, Fix: fix})
+	}
+
+	// Account
+	var acct struct {
+		AccountStatus int `json:"account_status"`
+	}
+	if err := getObject(account, "account_status", &acct); err != nil {
+		add("account", checkWarn, "could not read "+account+": "+err.Error(), "")
+	} else if acct.AccountStatus != 1 {
+		add("account", checkFail, account+" is "+accountStatusLabel(acct.AccountStatus), "resolve the account status in Ads Manager (billing, review, or policy)")
+	} else {
+		add("account", checkOK, account+" is active", "")
+	}
+
+	// Status
+	switch status := obj.EffectiveStatus; {
+	case obj.Status == "PAUSED":
+		fix := "activate the ad set"
+		if level == "campaign" {
+			fix = "meta-ads campaigns update " + id + " --status ACTIVE"
+		}
+		add("status", checkFail, "the "+level+" is paused", fix)
+	case status == "CAMPAIGN_PAUSED" || status == "ADSET_PAUSED":
+		add("status", checkFail, "effective status "+status, "activate the parent "+strings.ToLower(strings.TrimSuffix(status, "_PAUSED")))
+	case status == "WITH_ISSUES" || status == "DISAPPROVED":
+		add("status", checkFail, "effective status "+status, "see the issues below")
+	case status == "ACTIVE":
+		add("status", checkOK, "effective status ACTIVE", "")
+	default:
+		add("status", checkWarn, "effective status "+status, "")
+	}
+
+	// Schedule
+	end := obj.EndTime
+	if level == "campaign" {
+		end = obj.StopTime
+	}
+	now := time.Now()
+	if t, ok := parseGraphTime(obj.StartTime); ok && t.After(now) {
+		add("schedule", checkWarn, "starts "+output.FormatTime(obj.StartTime), "")
+	} else if t, ok := parseGraphTime(end); ok && t.Before(now) {
+		add("schedule", checkFail, "ended "+output.FormatTime(end), "extend the end time")
+	} else {
+		add("schedule", checkOK, "running (end: "+output.FormatTime(end)+")", "")
+	}
+
+	// Budget: the ad set's own, or the campaign's (campaign budget optimization).
+	daily, lifetime, remaining, owner := obj.DailyBudget, obj.LifetimeBudget, obj.BudgetRemaining, level
+	if level == "adset" && cents(daily) == 0 && cents(lifetime) == 0 && obj.Campaign != nil {
+		daily, lifetime, remaining, owner = obj.Campaign.DailyBudget, obj.Campaign.LifetimeBudget, obj.Campaign.BudgetRemaining, "campaign"
+	}
+	switch {
+	case cents(daily) > 0:
+		add("budget", checkOK, fmt.Sprintf("%s daily (%s budget), %s remaining today", output.FormatBudget(daily.String()), owner, output.FormatBudget(remaining.String())), "")
+	case cents(lifetime) > 0 && cents(remaining) <= 0:
+		add("budget", checkFail, fmt.Sprintf("lifetime budget of %s is spent", output.FormatBudget(lifetime.String())), "raise the lifetime budget")
+	case cents(lifetime) > 0:
+		add("budget", checkOK, fmt.Sprintf("%s lifetime (%s budget), %s remaining", output.FormatBudget(lifetime.String()), owner, output.FormatBudget(remaining.String())), "")
+	case level == "campaign":
+		add("budget", checkOK, "set on the ad sets", "")
+	default:
+		add("budget", checkWarn, "no budget found", "")
+	}
+
+	// Issues
+	for _, is := range obj.IssuesInfo {
+		detail := is.ErrorSummary
+		if is.ErrorMessage != "" {
+			detail += ": " + is.ErrorMessage
+		}
+		add("issue", checkFail, strings.ToLower(is.Level)+" — "+detail, "")
+	}
+
+	// Learning phase and children
+	if level == "adset" {
+		if li := obj.LearningStageInfo; li != nil {
+			switch li.Status {
+			case "LEARNING":
+				add("learning", checkWarn, fmt.Sprintf("in learning phase (%d conversions so far)", li.Conversions), "avoid significant edits until it exits")
+			case "FAIL":
+				add("learning", checkWarn, "learning limited", "broaden the audience, raise the budget, or pick a more frequent event")
+			default:
+				add("learning", checkOK, strings.ToLower(li.Status), "")
+			}
+		}
+		diagnoseChildren(add, id, "ads", "ad")
+	} else {
+		diagnoseChildren(add, id, "adsets", "ad set")
+	}
+
+	// Recommendations
+	for _, r := range obj.Recommendations {
+		add("recommendation", checkWarn, r.Title, r.Message)
+	}
+
+	// Recent delivery
+	params := url.Values{}
+	params.Set("fields", "spend,impressions,clicks")
+	params.Set("date_preset", "last_7d")
+	params.Set("time_increment", "1")
+	report.Delivery = []diagnoseDay{}
+	rows, err := client.GetAll("/"+id+"/insights", params)
+	if err != nil {
+		add("delivery", checkWarn, "could not read insights: "+err.Error(), "")
+	} else {
+		total := 0.0
+		for _, raw := range rows {
+			var d diagnoseDay
+			if err := json.Unmarshal(raw, &d); err != nil {
+				return fmt.Errorf("parsing insights: %w", err)
+			}
+			report.Delivery = append(report.Delivery, d)
+			v, _ := strconv.ParseFloat(d.Spend, 64)
+			total += v
+		}
+		if total == 0 {
+			add("delivery", checkFail, "no spend in the last 7 days", "")
+		} else {
+			add("delivery", checkOK, fmt.Sprintf("%.2f spent in the last 7 days", total), "")
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, report)
+	}
+	fmt.Printf("%s %s — %s\n\n", level, obj.ID, obj.Name)
+	printChecks(report.Checks)
+	if len(report.Delivery) > 0 {
+		fmt.Println()
+		table := make([][]string, len(report.Delivery))
+		for i, d := range report.Delivery {
+			table[i] = []string{d.Date, d.Spend, d.Impressions, d.Clicks}
+		}
+		output.PrintTable([]string{"DATE", "SPEND", "IMPRESSIONS", "CLICKS"}, table)
+	}
+	return nil
+}
+
+// diagnoseChildren checks that the object has active children on edge.
+func diagnoseChildren(add func(name, status, detail, fix string), id, edge, what string) {
+	var children []struct {
+		EffectiveStatus string `json:"effective_status"`
+	}
+	if err := getAllInto("/"+id+"/"+edge, "effective_status", &children); err != nil {
+		add(edge, checkWarn, "could not read "+edge+": "+err.Error(), "")
+		return
+	}
+	counts := map[string]int{}
+	for _, c := range children {
+		counts[c.EffectiveStatus]++
+	}
+	switch {
+	case len(children) == 0:
+		add(edge, checkFail, "no "+what+"s", "create at least one "+what)
+	case counts["ACTIVE"] == 0:
+		add(edge, checkFail, fmt.Sprintf("none of %d %ss is active", len(children), what), "")
+	default:
+		add(edge, checkOK, fmt.Sprintf("%d of %d %ss active", counts["ACTIVE"], len(children), what), "")
+	}
+	if n := counts["DISAPPROVED"]; n > 0 {
+		add(edge, checkWarn, fmt.Sprintf("%d %s(s) disapproved", n, what), "")
+	}
+}
+
+// parseGraphTime parses a Graph API timestamp like 2024-01-02T15:04:05-0700.
+func parseGraphTime(s string) (time.Time, bool) {
+	t, err := time.Parse("2006-01-02T15:04:05-0700", s)
+	return t, err == nil
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var envTemplate bool
+
+var envCmd = &cobra.Command{
+	Use:   "env",
+	Short: "List recognized environment variables and their current values",
+	Long: `List every environment variable meta-ads reads, with its current value
+(secrets masked) and the alias it was read from.
+
+Use --template to write a .env file for onboarding:
+  meta-ads env --template > .env.example`,
+	Args: cobra.NoArgs,
+	RunE: runEnv,
+}
+
+func init() {
+	envCmd.Flags().BoolVar(&envTemplate, "template", false, "Print a commented .env template instead")
+	rootCmd.AddCommand(envCmd)
+}
+
+// Environment variable names accepted for the token, app secret and account,
+// in priority order (the first is the documented name).
+var (
+	tokenEnvVars = []string{
+		"META_TOKEN", "META_ACCESS_TOKEN", "META_API_TOKEN", "META_BEARER_TOKEN",
+		"TOKEN_META", "META_KEY", "META_API_KEY", "META_API", "API_KEY_META", "API_META",
+	}
+	appSecretEnvVars = []string{
+		"META_APP_SECRET", "META_SECRET", "META_SECRET_KEY", "META_API_SECRET",
+		"META_APP_SECRET_KEY", "SECRET_META", "API_SECRET_META", "SK_META", "META_SK",
+	}
+	accountEnvVars = []string{"META_ADS_ACCOUNT", "META_ACCOUNT", "META_AD_ACCOUNT", "FACEBOOK_AD_ACCOUNT"}
+)
+
+// envVar is an environment variable recognized by meta-ads.
+type envVar struct {
+	names   []string
+	secret  bool
+	example string
+	help    string
+}
+
+// envVars lists every recognized environment variable, in display order.
+var envVars = []envVar{
+	{names: tokenEnvVars, secret: true, help: "Access token; overrides the stored token"},
+	{names: []string{"META_APP_ID"}, example: "1234567890", help: "Meta app ID, for auth login and token extension"},
+	{names: appSecretEnvVars, secret: true, help: "Meta app secret; enables appsecret_proof"},
+	{names: accountEnvVars, example: "act_123456789", help: "Default ad account (ID or alias)"},
+	{names: []string{config.EnvPath}, help: "Config file path"},
+	{names: []string{config.EnvPassphrase}, secret: true, help: "Passphrase of an encrypted config"},
+	{names: []string{"META_ADS_OUTPUT"}, example: "json", help: "Default output format"},
+	{names: []string{"META_ADS_PRETTY"}, example: "true", help: "Indent JSON output"},
+	{names: []string{"META_ADS_API_VERSION"}, example: "v23.0", help: "Graph API version"},
+	{names: []string{"META_ADS_TIMEOUT"}, example: "60s", help: "HTTP request timeout"},
+	{names: []string{"META_ADS_KEEP_ALIVE"}, example: "90s", help: "How long idle HTTP connections are kept for reuse, or off"},
+	{names: []string{"META_ADS_MAX_IDLE_CONNS"}, example: "10", help: "Idle HTTP connections kept open for reuse"},
+	{names: []string{"META_ADS_CONFIRM_BUDGET_INCREASE"}, example: "20", help: "Budget increase (percent) that asks for confirmation, or off"},
+	{names: []string{"META_ADS_REPORTING_CURRENCY"}, example: "EUR", help: "Currency insights amounts are converted to"},
+	{names: []string{"META_ADS_FX_RATES"}, example: "ecb", help: "Exchange rates: ecb or a rates file path"},
+	{names: []string{"META_ADS_LOG_LEVEL"}, example: "info", help: "Structured log level: debug, info, warn, error"},
+	{names: []string{"META_ADS_LOG_FILE"}, help: "File the structured logs are appended to (default stderr)"},
+	{names: []string{"META_ADS_MOCK_DIR"}, example: "./fixtures", help: "Answer API calls from JSON fixtures instead of Meta"},
+	{names: []string{"NO_COLOR"}, example: "1", help: "Disable colored output"},
+	{names: []string{"LC_ALL", "LC_NUMERIC", "LANG"}, example: "de_DE.UTF-8", help: "Locale of the numbers in tables (digit grouping, decimal mark)"},
+	{names: []string{serveTokenEnv}, secret: true, help: "Bearer token required by meta-ads serve"},
+	{names: []string{webhookVerifyTokenEnv}, secret: true, help: "Verify token of meta-ads webhooks listen"},
+}
+
+// envEntry is one row of env.
+type envEntry struct {
+	Name        string   `json:"name"`
+	Value       string   `json:"value"`
+	Source      string   `json:"source,omitempty"`
+	Aliases     []string `json:"aliases,omitempty"`
+	Description string   `json:"description"`
+}
+
+func runEnv(cmd *cobra.Command, args []string) error {
+	if envTemplate {
+		fmt.Println("# meta-ads environment — copy to .env and fill in")
+		for _, v := range envVars {
+			fmt.Println()
+			fmt.Printf("# %s\n", v.help)
+			fmt.Printf("%s=%s\n", v.names[0], v.example)
+		}
+		return nil
+	}
+
+	entries := make([]envEntry, len(envVars))
+	for i, v := range envVars {
+		e := envEntry{Name: v.names[0], Aliases: v.names[1:], Description: v.help}
+		for _, name := range v.names {
+			if val := os.Getenv(name); val != "" {
+				e.Value, e.Source = val, name
+				break
+			}
+		}
+		if v.secret && e.Value != "" {
+			e.Value = maskOrEmpty(e.Value)
+		}
+		entries[i] = e
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, entries)
+	}
+
+	rows := make([][]string, len(entries))
+	for i, e := range entries {
+		value := e.Value
+		switch {
+		case value == "":
+			value = "-"
+		case e.Source != e.Name:
+			value += " (from " + e.Source + ")"
+		}
+		rows[i] = []string{e.Name, value, e.Description}
+	}
+	output.PrintTable([]string{"NAME", "VALUE", "DESCRIPTION"}, rows)
+	return nil
+}
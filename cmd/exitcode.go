@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+)
+
+// Exit codes, so wrappers can tell failures apart without parsing messages.
+// They are documented in the README; don't renumber them.
+const (
+	// exitError is any other failure, e.g. the network or a bad response.
+	exitError = 1
+	// exitAuth is a missing, invalid or expired token, or a missing permission.
+	exitAuth = 2
+	// exitAPI is an error returned by the Graph API.
+	exitAPI = 3
+	// exitRateLimit is a Graph API rate limit that outlasted the retries.
+	exitRateLimit = 4
+	// exitUsage is an invalid flag, argument or input file.
+	exitUsage = 5
+	// exitPartial is a bulk command where some items failed and others didn't.
+	exitPartial = 6
+)
+
+// exitKinds names each exit code in the error line.
+var exitKinds = map[int]string{
+	exitError:     "error",
+	exitAuth:      "auth",
+	exitAPI:       "api",
+	exitRateLimit: "rate_limit",
+	exitUsage:     "usage",
+	exitPartial:   "partial",
+}
+
+// codedError is an error that sets the exit code itself.
+type codedError struct {
+	code int
+	err  error
+}
+
+func (e *codedError) Error() string { return e.err.Error() }
+func (e *codedError) Unwrap() error { return e.err }
+
+// withExitCode makes err exit with code rather than the one exitCode would
+// derive.
+func withExitCode(code int, err error) error {
+	return &codedError{code: code, err: err}
+}
+
+// partialFailure returns the error of a bulk command where some of the
+// items failed, e.g. "3 of 50 rows failed".
+func partialFailure(format string, a ...any) error {
+	return withExitCode(exitPartial, fmt.Errorf(format, a...))
+}
+
+// usageError returns the error of an invalid flag, argument or input.
+func usageError(format string, a ...any) error {
+	return withExitCode(exitUsage, fmt.Errorf(format, a...))
+}
+
+// tagUsageErrors makes the errors cobra reports about the command line of c
+// and its subcommands exit with exitUsage: unknown or invalid flags and wrong
+// arguments. Missing required flags are checked in PersistentPreRunE.
+func tagUsageErrors(c *cobra.Command) {
+	c.SetFlagErrorFunc(func(_ *cobra.Command, err error) error {
+		return withExitCode(exitUsage, err)
+	})
+	var wrap func(c *cobra.Command)
+	wrap = func(c *cobra.Command) {
+		if args := c.Args; args != nil {
+			c.Args = func(cmd *cobra.Command, a []string) error {
+				if err := args(cmd, a); err != nil {
+					return withExitCode(exitUsage, err)
+				}
+				return nil
+			}
+		}
+		for _, sub := range c.Commands() {
+			wrap(sub)
+		}
+	}
+	wrap(c)
+}
+
+// checkRequiredFlags returns the error cobra would for a missing required
+// flag or an invalid flag group, as a usage error. cobra checks them after
+// PersistentPreRunE, which calls this first.
+func checkRequiredFlags(cmd *cobra.Command) error {
+	if err := checkFromJSONFlags(cmd); err != nil {
+		return err
+	}
+	if err := cmd.ValidateRequiredFlags(); err != nil {
+		return withExitCode(exitUsage, err)
+	}
+	if err := cmd.ValidateFlagGroups(); err != nil {
+		return withExitCode(exitUsage, err)
+	}
+	return nil
+}
+
+// exitCode returns the exit code of err. Errors that are neither tagged nor
+// from the Graph API exit with exitError.
+func exitCode(err error) int {
+	var coded *codedError
+	if errors.As(err, &coded) {
+		return coded.code
+	}
+	var metaErr *api.MetaError
+	if errors.As(err, &metaErr) {
+		switch {
+		case metaErr.IsAuth():
+			return exitAuth
+		case metaErr.IsRateLimit():
+			return exitRateLimit
+		}
+		return exitAPI
+	}
+	return exitError
+}
+
+// errorLine is the machine-readable error printed to stderr.
+type errorLine struct {
+	Error struct {
+		ExitCode int    `json:"exit_code"`
+		Kind     string `json:"kind"`
+		Message  string `json:"message"`
+		// The Graph API error, if any.
+		Code        int    `json:"code,omitempty"`
+		Subcode     int    `json:"error_subcode,omitempty"`
+		Type        string `json:"type,omitempty"`
+		UserTitle   string `json:"error_user_title,omitempty"`
+		UserMsg     string `json:"error_user_msg,omitempty"`
+		FBTraceID   string `json:"fbtrace_id,omitempty"`
+		IsTransient bool   `json:"is_transient,omitempty"`
+		Docs        string `json:"docs,omitempty"`
+	} `json:"error"`
+}
+
+// printErrorDetails writes to stderr, after cobra's "Error: ..." line, what
+// Meta gave to act on a Graph API error besides its message: a hint and the
+// documentation for common codes, and the trace ID support asks for.
+func printErrorDetails(err error) {
+	var metaErr *api.MetaError
+	if !errors.As(err, &metaErr) {
+		return
+	}
+	hint, docs := metaErr.Hint()
+	if hint != "" {
+		fmt.Fprintf(os.Stderr, "Hint: %s\n", hint)
+	}
+	if docs != "" {
+		fmt.Fprintf(os.Stderr, "Docs: %s\n", docs)
+	}
+	if metaErr.FBTraceID != "" {
+		fmt.Fprintf(os.Stderr, "fbtrace_id: %s (quote it when contacting Meta support)\n", metaErr.FBTraceID)
+	}
+}
+
+// printErrorLine writes err as one JSON line to stderr, after cobra's
+// "Error: ..." line, when stderr isn't a terminal: a wrapper reads the last
+// line of stderr instead of parsing the message.
+func printErrorLine(err error, code int) {
+	if isatty.IsTerminal(os.Stderr.Fd()) {
+		return
+	}
+	var line errorLine
+	line.Error.ExitCode = code
+	line.Error.Kind = exitKinds[code]
+	line.Error.Message = err.Error()
+	var metaErr *api.MetaError
+	if errors.As(err, &metaErr) {
+		line.Error.Code = metaErr.Code
+		line.Error.Subcode = metaErr.Subcode
+		line.Error.Type = metaErr.Type
+		line.Error.UserTitle = metaErr.UserTitle
+		line.Error.UserMsg = metaErr.UserMsg
+		line.Error.FBTraceID = metaErr.FBTraceID
+		line.Error.IsTransient = metaErr.IsTransient
+		_, line.Error.Docs = metaErr.Hint()
+	}
+	data, _ := json.Marshal(line)
+	fmt.Fprintln(os.Stderr, string(data))
+}
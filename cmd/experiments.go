@@ -0,0 +1,436 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/monitor"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	experimentBusiness    string
+	experimentName        string
+	experimentDescription string
+	experimentStart       string
+	experimentEnd         string
+	experimentCells       []string
+	experimentCellType    string
+	experimentSplit       string
+	experimentConversion  string
+)
+
+const experimentFields = "id,name,description,type,start_time,end_time,created_time,canceled_time,observation_end_time"
+
+var experimentsCmd = &cobra.Command{
+	Use:     "experiments",
+	Aliases: []string{"studies"},
+	Short:   "Manage A/B tests (ad studies)",
+}
+
+var experimentsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List ad studies (yours, or a business's with --business)",
+	Args:  cobra.NoArgs,
+	RunE:  runExperimentsList,
+}
+
+var experimentsGetCmd = &cobra.Command{
+	Use:   "get <study_id>",
+	Short: "Show an ad study with its cells and per-cell results",
+	Long: `Show an ad study, its cells, and the performance of each cell over the
+study window: spend, conversions and cost per conversion. The cell with the
+lowest cost per conversion is marked as the leader.
+
+Conversions count the "purchase" action unless --conversion is given.`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExperimentsGet,
+}
+
+var experimentsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a split test from existing campaigns or ad sets",
+	Long: `Create a split test (ad study) whose cells are existing campaigns or ad sets.
+
+Each --cell is "<name>=<id>[,<id>...]". Traffic is split evenly unless --split
+gives the percentages in cell order.
+
+Examples:
+  meta-ads experiments create --business 123 --name "Video vs image" \
+    --start 2026-03-01 --end 2026-03-15 \
+    --cell "Video=120210000000001" --cell "Image=120210000000002"
+
+  meta-ads experiments create --business 123 --name "Audiences" --cell-type adset \
+    --start 2026-03-01 --end 2026-03-15 --split 70,30 \
+    --cell "Broad=120210000000010" --cell "Lookalike=120210000000011"`,
+	Args: cobra.NoArgs,
+	RunE: runExperimentsCreate,
+}
+
+func init() {
+	experimentsListCmd.Flags().StringVar(&experimentBusiness, "business", "", "Business ID (default: your own studies)")
+
+	experimentsGetCmd.Flags().StringVar(&experimentConversion, "conversion", monitor.DefaultConversion, "Action type counted as a conversion")
+
+	experimentsCreateCmd.Flags().StringVar(&experimentBusiness, "business", "", "Business ID that owns the study (required)")
+	experimentsCreateCmd.Flags().StringVar(&experimentName, "name", "", "Study name (required)")
+	experimentsCreateCmd.Flags().StringVar(&experimentDescription, "description", "", "Study description")
+	experimentsCreateCmd.Flags().StringVar(&experimentStart, "start", "", "Start date YYYY-MM-DD or RFC 3339 time (required)")
+	experimentsCreateCmd.Flags().StringVar(&experimentEnd, "end", "", "End date YYYY-MM-DD or RFC 3339 time (required)")
+	experimentsCreateCmd.Flags().StringArrayVar(&experimentCells, "cell", nil, `Cell as "<name>=<id>,<id>" (repeatable, at least 2)`)
+	experimentsCreateCmd.Flags().StringVar(&experimentCellType, "cell-type", "campaign", "Objects in the cells: campaign or adset")
+	experimentsCreateCmd.Flags().StringVar(&experimentSplit, "split", "", "Treatment percentages in cell order, e.g. 70,30 (default: even)")
+	_ = experimentsCreateCmd.MarkFlagRequired("business")
+	_ = experimentsCreateCmd.MarkFlagRequired("name")
+	_ = experimentsCreateCmd.MarkFlagRequired("start")
+	_ = experimentsCreateCmd.MarkFlagRequired("end")
+	addFromJSONFlag(experimentsCreateCmd, jsonBody{
+		fields: []string{"name", "description", "type", "start_time", "end_time", "cells", "objectives",
+			"confidence_level", "cooldown_start_time", "observation_end_time"},
+		required: []string{"name", "type", "start_time", "end_time", "cells"},
+		flags:    []string{"name", "description", "start", "end", "cell", "cell-type", "split"},
+	})
+
+	addCountFlag(experimentsListCmd)
+	addPagingFlags(experimentsListCmd)
+	experimentsCmd.AddCommand(experimentsListCmd, experimentsGetCmd, experimentsCreateCmd)
+	rootCmd.AddCommand(experimentsCmd)
+}
+
+func runExperimentsList(cmd *cobra.Command, args []string) error {
+	owner := "me"
+	if experimentBusiness != "" {
+		owner = experimentBusiness
+	}
+	params := url.Values{}
+	params.Set("fields", experimentFields)
+
+	studies, streamed, err := listAll[api.AdStudy](cmd, "/"+owner+"/ad_studies", params, "study", nil)
+	if err != nil || streamed {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, studies)
+	}
+	if len(studies) == 0 {
+		fmt.Println("No ad studies found.")
+		return nil
+	}
+	rows := make([][]string, len(studies))
+	for i, s := range studies {
+		rows[i] = []string{
+			s.ID,
+			output.Truncate(s.Name, 40),
+			s.Type,
+			output.Status(experimentStatus(s)),
+			output.FormatTime(s.StartTime),
+			output.FormatTime(s.EndTime),
+		}
+	}
+	output.PrintTable([]string{"ID", "NAME", "TYPE", "STATUS", "START", "END"}, rows)
+	return nil
+}
+
+// experimentCell is a study cell with its performance over the study window.
+type experimentCell struct {
+	api.AdStudyCell
+	Spend       float64 `json:"spend"`
+	Conversions float64 `json:"conversions"`
+	CPA         float64 `json:"cpa,omitempty"`
+	Leader      bool    `json:"leader,omitempty"`
+}
+
+// experimentResult is an ad study with per-cell results.
+type experimentResult struct {
+	api.AdStudy
+	Status  string           `json:"status"`
+	Results []experimentCell `json:"results"`
+}
+
+func runExperimentsGet(cmd *cobra.Command, args []string) error {
+	var study api.AdStudy
+	if err := getObject(args[0], experimentFields+",cells{id,name,treatment_percentage}", &study); err != nil {
+		return err
+	}
+
+	// The study window, as an insights time range.
+	timeRange := ""
+	if start, err := time.Parse("2006-01-02T15:04:05-0700", study.StartTime); err == nil {
+		end := time.Now()
+		if e, err := time.Parse("2006-01-02T15:04:05-0700", study.EndTime); err == nil && e.Before(end) {
+			end = e
+		}
+		timeRange = fmt.Sprintf(`{"since":"%s","until":"%s"}`, start.Format("2006-01-02"), end.Format("2006-01-02"))
+	}
+
+	result := experimentResult{AdStudy: study, Status: experimentStatus(study)}
+	for i := range study.Cells {
+		cell := experimentCell{AdStudyCell: study.Cells[i]}
+		if err := getAllInto("/"+cell.ID+"/campaigns", "id,name", &cell.Campaigns); err != nil {
+			return fmt.Errorf("campaigns of cell %s: %w", cell.Name, err)
+		}
+		if err := getAllInto("/"+cell.ID+"/adsets", "id,name", &cell.AdSets); err != nil {
+			return fmt.Errorf("ad sets of cell %s: %w", cell.Name, err)
+		}
+		if timeRange != "" {
+			if err := addCellResults(&cell, timeRange); err != nil {
+				return fmt.Errorf("results of cell %s: %w", cell.Name, err)
+			}
+		}
+		result.Results = append(result.Results, cell)
+	}
+	result.Cells = nil
+	markExperimentLeader(result.Results)
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, result)
+	}
+
+	rows := [][]string{
+		{"ID", study.ID},
+		{"Name", study.Name},
+		{"Description", study.Description},
+		{"Type", study.Type},
+		{"Status", output.Status(result.Status)},
+		{"Start", output.FormatTime(study.StartTime)},
+		{"End", output.FormatTime(study.EndTime)},
+	}
+	output.PrintKeyValue(rows)
+
+	if len(result.Results) == 0 {
+		return nil
+	}
+	fmt.Println()
+	table := make([][]string, len(result.Results))
+	for i, c := range result.Results {
+		var objects []string
+		for _, o := range append(append([]api.IDName{}, c.Campaigns...), c.AdSets...) {
+			objects = append(objects, o.ID)
+		}
+		cpa := "-"
+		if c.CPA > 0 {
+			cpa = fmt.Sprintf("%.2f", c.CPA)
+		}
+		leader := ""
+		if c.Leader {
+			leader = output.Green("★ leader")
+		}
+		table[i] = []string{
+			c.Name,
+			strconv.Itoa(c.TreatmentPercentage) + "%",
+			output.Truncate(strings.Join(objects, ","), 40),
+			fmt.Sprintf("%.2f", c.Spend),
+			strconv.FormatFloat(c.Conversions, 'f', -1, 64),
+			cpa,
+			leader,
+		}
+	}
+	output.PrintTable([]string{"CELL", "SPLIT", "OBJECTS", "SPEND", "CONVERSIONS", "CPA", ""}, table)
+	return nil
+}
+
+// addCellResults sums the spend and conversions of the cell's objects, whose
+// insights are fetched concurrently.
+func addCellResults(cell *experimentCell, timeRange string) error {
+	params := url.Values{}
+	params.Set("fields", monitor.InsightFields)
+	params.Set("time_range", timeRange)
+
+	var ids []string
+	for _, o := range cell.Campaigns {
+		ids = append(ids, o.ID)
+	}
+	for _, o := range cell.AdSets {
+		ids = append(ids, o.ID)
+	}
+	items := make([]api.FanOutItem, len(ids))
+	for i, id := range ids {
+		items[i] = api.FanOutItem{Path: "/" + id + "/insights"}
+	}
+	for _, r := range client.NewFanOut().Run(items, params) {
+		if r.Err != nil {
+			return r.Err
+		}
+		for _, row := range r.Items {
+			m, err := monitor.Compute(row, experimentConversion)
+			if err != nil {
+				return fmt.Errorf("parsing insights: %w", err)
+			}
+			cell.Spend += m["spend"]
+			cell.Conversions += m["conversions"]
+		}
+	}
+	if cell.Conversions > 0 {
+		cell.CPA = cell.Spend / cell.Conversions
+	}
+	return nil
+}
+
+// markExperimentLeader flags the cell with the lowest cost per conversion.
+func markExperimentLeader(cells []experimentCell) {
+	best := -1
+	for i, c := range cells {
+		if c.CPA > 0 && (best < 0 || c.CPA < cells[best].CPA) {
+			best = i
+		}
+	}
+	if best >= 0 {
+		cells[best].Leader = true
+	}
+}
+
+// experimentStatus derives a study status from its times.
+func experimentStatus(s api.AdStudy) string {
+	if s.CanceledTime != "" {
+		return "CANCELED"
+	}
+	now := time.Now()
+	if start, err := time.Parse("2006-01-02T15:04:05-0700", s.StartTime); err == nil && now.Before(start) {
+		return "SCHEDULED"
+	}
+	if end, err := time.Parse("2006-01-02T15:04:05-0700", s.EndTime); err == nil && now.After(end) {
+		return "COMPLETED"
+	}
+	return "ACTIVE"
+}
+
+func runExperimentsCreate(cmd *cobra.Command, args []string) error {
+	body, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	if body == nil {
+		if body, err = experimentBody(); err != nil {
+			return err
+		}
+	}
+
+	resp, err := client.Post("/"+experimentBusiness+"/ad_studies", body)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, result)
+	}
+	fmt.Printf("✓ Study created: %s\n", result.ID)
+	return nil
+}
+
+// experimentSplitPercentages parses --split, or splits 100% evenly over n
+// cells (the remainder goes to the first cells).
+func experimentSplitPercentages(v string, n int) ([]int, error) {
+	split := make([]int, n)
+	if v == "" {
+		for i := range split {
+			split[i] = 100 / n
+			if i < 100%n {
+				split[i]++
+			}
+		}
+		return split, nil
+	}
+	parts := strings.Split(v, ",")
+	if len(parts) != n {
+		return nil, usageError("--split has %d values for %d cells", len(parts), n)
+	}
+	total := 0
+	for i, p := range parts {
+		pct, err := strconv.Atoi(strings.TrimSpace(p))
+		if err != nil || pct <= 0 {
+			return nil, usageError("invalid --split value %q", p)
+		}
+		split[i] = pct
+		total += pct
+	}
+	if total != 100 {
+		return nil, usageError("--split must add up to 100 (got %d)", total)
+	}
+	return split, nil
+}
+
+// parseTimeFlag parses a YYYY-MM-DD date or RFC 3339 time into unix seconds.
+func parseTimeFlag(v, flag string) (int64, error) {
+	if t, err := time.ParseInLocation("2006-01-02", v, time.Local); err == nil {
+		return t.Unix(), nil
+	}
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t.Unix(), nil
+	}
+	return 0, usageError("invalid --%s %q — expected YYYY-MM-DD or an RFC 3339 time", flag, v)
+}
+
+// experimentBody returns the body of experiments create built from its flags.
+func experimentBody() (url.Values, error) {
+	if len(experimentCells) < 2 {
+		return nil, fmt.Errorf("a split test needs at least 2 --cell flags")
+	}
+	edge := "campaigns"
+	switch experimentCellType {
+	case "campaign":
+	case "adset":
+		edge = "adsets"
+	default:
+		return nil, usageError("invalid --cell-type %q — use campaign or adset", experimentCellType)
+	}
+
+	split, err := experimentSplitPercentages(experimentSplit, len(experimentCells))
+	if err != nil {
+		return nil, err
+	}
+	cells := make([]map[string]any, len(experimentCells))
+	for i, c := range experimentCells {
+		name, ids, ok := strings.Cut(c, "=")
+		if !ok || strings.TrimSpace(name) == "" || strings.TrimSpace(ids) == "" {
+			return nil, usageError("invalid --cell %q — expected \"<name>=<id>,<id>\"", c)
+		}
+		var objects []string
+		for _, id := range strings.Split(ids, ",") {
+			if id = strings.TrimSpace(id); id != "" {
+				objects = append(objects, id)
+			}
+		}
+		cells[i] = map[string]any{
+			"name":                 strings.TrimSpace(name),
+			"treatment_percentage": split[i],
+			edge:                   objects,
+		}
+	}
+
+	start, err := parseTimeFlag(experimentStart, "start")
+	if err != nil {
+		return nil, err
+	}
+	end, err := parseTimeFlag(experimentEnd, "end")
+	if err != nil {
+		return nil, err
+	}
+	if end <= start {
+		return nil, usageError("--end must be after --start")
+	}
+
+	cellsJSON, _ := json.Marshal(cells)
+	body := url.Values{}
+	body.Set("name", experimentName)
+	body.Set("type", "SPLIT_TEST")
+	body.Set("start_time", strconv.FormatInt(start, 10))
+	body.Set("end_time", strconv.FormatInt(end, 10))
+	body.Set("cells", string(cellsJSON))
+	if experimentDescription != "" {
+		body.Set("description", experimentDescription)
+	}
+	return body, nil
+}
@@ -0,0 +1,257 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/spec"
+)
+
+var (
+	exportCampaign string
+	exportFile     string
+	exportNoIDs    bool
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Dump campaigns, ad sets, ads and creatives to a YAML spec",
+	Long: `Walk the campaigns of an account (or one campaign) with their ad sets, ads,
+creatives and targeting, and write them in the spec format consumed by
+meta-ads apply / plan.
+
+With IDs (the default) the file is a backup that apply can update in place.
+With --no-ids the IDs and account are left out, so applying the file creates
+copies — e.g. to migrate a campaign to another account.
+
+Examples:
+  meta-ads export --account act_123 -o dump.yaml
+  meta-ads export --campaign 120210000000001 --no-ids -o template.yaml
+  meta-ads apply -f template.yaml --account act_456`,
+	Args: cobra.NoArgs,
+	RunE: runExport,
+}
+
+func init() {
+	exportCmd.Flags().StringVar(&exportCampaign, "campaign", "", "Export only this campaign ID")
+	_ = exportCmd.RegisterFlagCompletionFunc("campaign", completeObjectFlag("campaign"))
+	exportCmd.Flags().StringVarP(&exportFile, "output", "o", "", "Output file path (stdout if omitted)")
+	exportCmd.Flags().BoolVar(&exportNoIDs, "no-ids", false, "Leave out IDs and the account, so apply creates copies")
+	rootCmd.AddCommand(exportCmd)
+}
+
+const (
+	exportCampaignFields = "id,name,objective,status,daily_budget,lifetime_budget,spend_cap,bid_strategy,special_ad_categories"
+	exportAdSetFields    = "id,name,status,daily_budget,lifetime_budget,bid_amount,billing_event,optimization_goal,start_time,end_time,targeting,promoted_object"
+	exportAdFields       = "id,name,status,creative{id}"
+	exportCreativeFields = "id,name,object_story_spec,asset_feed_spec,url_tags"
+)
+
+// exportedCampaign, exportedAdSet, exportedAd and exportedCreative are the API
+// shapes read by export.
+type exportedCampaign struct {
+	ID                  string         `json:"id"`
+	Name                string         `json:"name"`
+	Objective           string         `json:"objective"`
+	Status              string         `json:"status"`
+	DailyBudget         api.FlexString `json:"daily_budget"`
+	LifetimeBudget      api.FlexString `json:"lifetime_budget"`
+	SpendCap            api.FlexString `json:"spend_cap"`
+	BidStrategy         string         `json:"bid_strategy"`
+	SpecialAdCategories []string       `json:"special_ad_categories"`
+}
+
+type exportedAdSet struct {
+	ID               string         `json:"id"`
+	Name             string         `json:"name"`
+	Status           string         `json:"status"`
+	DailyBudget      api.FlexString `json:"daily_budget"`
+	LifetimeBudget   api.FlexString `json:"lifetime_budget"`
+	BidAmount        api.FlexString `json:"bid_amount"`
+	BillingEvent     string         `json:"billing_event"`
+	OptimizationGoal string         `json:"optimization_goal"`
+	StartTime        string         `json:"start_time"`
+	EndTime          string         `json:"end_time"`
+	Targeting        map[string]any `json:"targeting"`
+	PromotedObject   map[string]any `json:"promoted_object"`
+}
+
+type exportedAd struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Status   string `json:"status"`
+	Creative *struct {
+		ID string `json:"id"`
+	} `json:"creative"`
+}
+
+type exportedCreative struct {
+	ID              string         `json:"id"`
+	Name            string         `json:"name"`
+	ObjectStorySpec map[string]any `json:"object_story_spec"`
+	AssetFeedSpec   map[string]any `json:"asset_feed_spec"`
+	URLTags         string         `json:"url_tags"`
+}
+
+func runExport(cmd *cobra.Command, args []string) error {
+	s := &spec.Spec{}
+
+	var campaigns []exportedCampaign
+	if exportCampaign != "" {
+		var c exportedCampaign
+		if err := getObject(exportCampaign, exportCampaignFields, &c); err != nil {
+			return err
+		}
+		campaigns = []exportedCampaign{c}
+	} else {
+		account, err := resolveAccount()
+		if err != nil {
+			return err
+		}
+		s.Account = account
+		if err := getAllInto("/"+account+"/campaigns", exportCampaignFields, &campaigns); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range campaigns {
+		sc := &spec.Campaign{
+			ID:                  c.ID,
+			Name:                c.Name,
+			Objective:           c.Objective,
+			Status:              c.Status,
+			DailyBudget:         cents(c.DailyBudget),
+			LifetimeBudget:      cents(c.LifetimeBudget),
+			SpendCap:            cents(c.SpendCap),
+			BidStrategy:         c.BidStrategy,
+			SpecialAdCategories: c.SpecialAdCategories,
+		}
+
+		var adsets []exportedAdSet
+		if err := getAllInto("/"+c.ID+"/adsets", exportAdSetFields, &adsets); err != nil {
+			return fmt.Errorf("ad sets of campaign %s: %w", c.ID, err)
+		}
+		for _, as := range adsets {
+			sas := &spec.AdSet{
+				ID:               as.ID,
+				Name:             as.Name,
+				Status:           as.Status,
+				DailyBudget:      cents(as.DailyBudget),
+				LifetimeBudget:   cents(as.LifetimeBudget),
+				BidAmount:        cents(as.BidAmount),
+				BillingEvent:     as.BillingEvent,
+				OptimizationGoal: as.OptimizationGoal,
+				StartTime:        as.StartTime,
+				EndTime:          as.EndTime,
+				Targeting:        as.Targeting,
+				PromotedObject:   as.PromotedObject,
+			}
+
+			var ads []exportedAd
+			if err := getAllInto("/"+as.ID+"/ads", exportAdFields, &ads); err != nil {
+				return fmt.Errorf("ads of ad set %s: %w", as.ID, err)
+			}
+			for _, ad := range ads {
+				sad := &spec.Ad{ID: ad.ID, Name: ad.Name, Status: ad.Status}
+				if ad.Creative != nil && ad.Creative.ID != "" {
+					var cr exportedCreative
+					if err := getObject(ad.Creative.ID, exportCreativeFields, &cr); err != nil {
+						return fmt.Errorf("creative of ad %s: %w", ad.ID, err)
+					}
+					sad.Creative = &spec.Creative{ID: cr.ID, Name: cr.Name, ObjectStorySpec: cr.ObjectStorySpec}
+					if cr.AssetFeedSpec != nil || cr.URLTags != "" {
+						sad.Creative.Params = map[string]any{}
+						if cr.AssetFeedSpec != nil {
+							sad.Creative.Params["asset_feed_spec"] = cr.AssetFeedSpec
+						}
+						if cr.URLTags != "" {
+							sad.Creative.Params["url_tags"] = cr.URLTags
+						}
+					}
+				}
+				sas.Ads = append(sas.Ads, sad)
+			}
+			sc.AdSets = append(sc.AdSets, sas)
+		}
+		s.Campaigns = append(s.Campaigns, sc)
+	}
+
+	if exportNoIDs {
+		stripIDs(s)
+	}
+
+	var w io.Writer = os.Stdout
+	if exportFile != "" {
+		f, err := os.Create(exportFile)
+		if err != nil {
+			return fmt.Errorf("creating output file: %w", err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := s.Encode(w); err != nil {
+		return err
+	}
+	if exportFile != "" {
+		fmt.Fprintf(os.Stderr, "✓ Exported %d campaign(s) to %s\n", len(s.Campaigns), exportFile)
+	}
+	return nil
+}
+
+// getObject fetches one object with fields into v.
+func getObject(id, fields string, v any) error {
+	params := url.Values{}
+	params.Set("fields", fields)
+	body, err := client.Get("/"+id, params)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("parsing %s: %w", id, err)
+	}
+	return nil
+}
+
+// getAllInto fetches every item of a list edge with fields into the slice
+// pointed to by v.
+func getAllInto[T any](path, fields string, v *[]T) error {
+	params := url.Values{}
+	params.Set("fields", fields)
+	return client.GetEach(path, params, func(raw json.RawMessage) error {
+		var item T
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+		*v = append(*v, item)
+		return nil
+	})
+}
+
+// cents parses a money amount returned by the API (0 when unset).
+func cents(v api.FlexString) int64 {
+	n, _ := strconv.ParseInt(v.String(), 10, 64)
+	return n
+}
+
+// stripIDs removes the account and every object ID from s.
+func stripIDs(s *spec.Spec) {
+	s.Account = ""
+	for _, c := range s.Campaigns {
+		c.ID = ""
+		for _, as := range c.AdSets {
+			as.ID = ""
+			for _, ad := range as.Ads {
+				ad.ID = ""
+				if ad.Creative != nil {
+					ad.Creative.ID = ""
+				}
+			}
+		}
+	}
+}
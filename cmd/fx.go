@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/fx"
+)
+
+// currencyConverter converts the money fields of insight rows into a single
+// reporting currency.
+type currencyConverter struct {
+	to    string
+	rates *fx.Rates
+}
+
+// newCurrencyConverter returns the converter into the reporting currency
+// (flag > META_ADS_REPORTING_CURRENCY > config), with rates from the fx_rates
+// source (flag > META_ADS_FX_RATES > config > ecb). It returns nil when no
+// reporting currency is set.
+func newCurrencyConverter(currencyFlag, ratesFlag string) (*currencyConverter, error) {
+	loadConfig()
+	var c config.Config
+	if cfg != nil {
+		c = *cfg
+	}
+	to := currencyFlag
+	if to == "" {
+		to = preference("META_ADS_REPORTING_CURRENCY", c.ReportingCurrency)
+	}
+	if to == "" {
+		return nil, nil
+	}
+	source := ratesFlag
+	if source == "" {
+		source = preference("META_ADS_FX_RATES", c.FXRates)
+	}
+	rates, err := fx.NewSource(source).Rates()
+	if err != nil {
+		return nil, err
+	}
+	return &currencyConverter{to: strings.ToUpper(to), rates: rates}, nil
+}
+
+// isMoneyField reports whether the insight field k holds amounts in the
+// account currency. Ratios like purchase_roas are not money.
+func isMoneyField(k string) bool {
+	switch k {
+	case "spend", "cpc", "cpm", "cpp", "action_values", "conversion_values":
+		return true
+	}
+	return strings.HasPrefix(k, "cost_per_")
+}
+
+// convertRow converts the money fields of an insight row from its
+// account_currency and annotates it with the rate used: account_currency
+// becomes the reporting currency, and original_currency, fx_rate and
+// fx_rate_date are added.
+func (c *currencyConverter) convertRow(raw json.RawMessage) (json.RawMessage, error) {
+	var row map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &row); err != nil {
+		return nil, fmt.Errorf("parsing insight: %w", err)
+	}
+	var from string
+	if err := json.Unmarshal(row["account_currency"], &from); err != nil || from == "" {
+		return nil, fmt.Errorf("insight row has no account_currency to convert from")
+	}
+	rate, err := c.rates.Rate(from, c.to)
+	if err != nil {
+		return nil, err
+	}
+
+	for k, v := range row {
+		if !isMoneyField(k) {
+			continue
+		}
+		var s string
+		if json.Unmarshal(v, &s) == nil {
+			row[k], _ = json.Marshal(convertAmount(s, rate))
+			continue
+		}
+		// Lists like action_values: [{"action_type": ..., "value": "12.5"}].
+		var list []map[string]json.RawMessage
+		if json.Unmarshal(v, &list) != nil {
+			continue
+		}
+		for _, item := range list {
+			if json.Unmarshal(item["value"], &s) == nil {
+				item["value"], _ = json.Marshal(convertAmount(s, rate))
+			}
+		}
+		row[k], _ = json.Marshal(list)
+	}
+	row["account_currency"], _ = json.Marshal(c.to)
+	row["original_currency"], _ = json.Marshal(strings.ToUpper(from))
+	row["fx_rate"], _ = json.Marshal(rate)
+	row["fx_rate_date"], _ = json.Marshal(c.rates.Date)
+	return json.Marshal(row)
+}
+
+// convertAmount multiplies the decimal amount s by rate, keeping the
+// precision of s (at least 2 decimals). Non-numeric values are kept.
+func convertAmount(s string, rate float64) string {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return s
+	}
+	decimals := 2
+	if i := strings.IndexByte(s, '.'); i >= 0 && len(s)-i-1 > decimals {
+		decimals = len(s) - i - 1
+	}
+	return strconv.FormatFloat(v*rate, 'f', decimals, 64)
+}
+
+// note describes the conversion for table output.
+func (c *currencyConverter) note() string {
+	return fmt.Sprintf("Amounts converted to %s at %s rates of %s.", c.to, c.rates.Source, c.rates.Date)
+}
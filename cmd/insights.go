@@ -1,12 +1,16 @@
 package cmd
 
 import (
+	"cmp"
 	"encoding/json"
 	"fmt"
 	"net/url"
+	"os"
 	"strings"
 
 	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/config"
 	"github.com/the20100/meta-ads-cli/internal/output"
 )
 
@@ -19,6 +23,12 @@ var (
 	insightFields     string
 	insightBreakdowns string
 	insightLimit      int
+
+	insightAttributionWindows string
+
+	insightAccounts []string
+	insightCurrency string
+	insightRates    string
 )
 
 var insightsCmd = &cobra.Command{
@@ -27,12 +37,18 @@ var insightsCmd = &cobra.Command{
 }
 
 var insightsGetCmd = &cobra.Command{
-	Use:   "get [object_id]",
+	Use:   "get [object_id...]",
 	Short: "Get insights for an account, campaign, ad set, or ad",
 	Long: `Get performance insights from the Meta Ads API.
 
 By default, uses the account specified by --account.
-Pass an explicit object ID (campaign, ad set, or ad) to get insights for that object.
+Pass explicit object IDs (campaigns, ad sets, or ads; - reads them from stdin,
+one per line) to get insights for those objects.
+
+Several objects, or several --accounts, are fetched 8 at a time, with fewer
+requests at once on an account whose rate-limit usage passes 75%. An object
+that fails is reported on stderr and the rows of the others are still printed;
+the command then exits with status 6.
 
 Examples:
   # Account-level insights
@@ -44,10 +60,30 @@ Examples:
   # Insights for a specific campaign
   meta-ads insights get 23851234567890 --since 2026-01-01 --until 2026-01-31
 
+  # Insights for every active campaign, fetched concurrently
+  meta-ads campaigns list -a act_123 --status ACTIVE -q | \
+    meta-ads insights get - --level campaign --since 2026-01-01 --until 2026-01-31
+
   # With custom fields and breakdowns
   meta-ads insights get --account act_123 --level ad --fields impressions,clicks,spend,ctr,cpc \
-    --breakdowns age,gender --since 2026-01-01 --until 2026-01-31`,
-	Args: cobra.MaximumNArgs(1),
+    --breakdowns age,gender --since 2026-01-01 --until 2026-01-31
+
+  # Conversions counted with 7-day click / 1-day view attribution
+  meta-ads insights get --fields spend,actions --attribution-windows 7d_click,1d_view \
+    --since 2026-01-01 --until 2026-01-31
+
+  # Several accounts at once, with amounts converted to euros at ECB rates
+  meta-ads insights get --accounts shop,brand --currency EUR --since 2026-01-01 --until 2026-01-31
+
+Account-level runs use the account's insight_fields and attribution_windows
+overrides (see: meta-ads config account) unless --fields / --attribution-windows
+are given. With --accounts, the rows of every account are returned together.
+
+--currency (or the reporting_currency preference) converts spend, cpc, cpm,
+cost_per_* and action values to one currency, using the ECB daily reference
+rates or the rates file given with --rates. Converted rows carry
+original_currency, fx_rate and fx_rate_date.`,
+	Args: cobra.ArbitraryArgs,
 	RunE: runInsightsGet,
 }
 
@@ -58,6 +94,10 @@ func init() {
 	insightsGetCmd.Flags().StringVar(&insightFields, "fields", defaultInsightFields, "Comma-separated insight fields")
 	insightsGetCmd.Flags().StringVar(&insightBreakdowns, "breakdowns", "", "Comma-separated breakdowns (e.g. age,gender,country)")
 	insightsGetCmd.Flags().IntVar(&insightLimit, "limit", 50, "Number of results per page")
+	insightsGetCmd.Flags().StringVar(&insightAttributionWindows, "attribution-windows", "", "Comma-separated attribution windows (e.g. 7d_click,1d_view)")
+	insightsGetCmd.Flags().StringSliceVar(&insightAccounts, "accounts", nil, "Comma-separated ad accounts (IDs or aliases) to get insights for together")
+	insightsGetCmd.Flags().StringVar(&insightCurrency, "currency", "", "Convert amounts to this currency, e.g. EUR (default: the reporting_currency preference)")
+	insightsGetCmd.Flags().StringVar(&insightRates, "rates", "", "Exchange rates for --currency: ecb or a JSON rates file (default: the fx_rates preference, else ecb)")
 	_ = insightsGetCmd.MarkFlagRequired("since")
 	_ = insightsGetCmd.MarkFlagRequired("until")
 
@@ -65,32 +105,71 @@ func init() {
 	rootCmd.AddCommand(insightsCmd)
 }
 
-func runInsightsGet(cmd *cobra.Command, args []string) error {
-	// Resolve the object ID: explicit arg or account
-	var objectID string
-	if len(args) == 1 {
-		objectID = args[0]
-	} else {
+func runInsightsGet(cmd *cobra.Command, args []string) (err error) {
+	// Resolve the object IDs: explicit args, --accounts, or account
+	var objectIDs []string
+	var overrides config.AccountConfig
+	switch {
+	case len(args) > 0 && len(insightAccounts) > 0:
+		return fmt.Errorf("pass either object IDs or --accounts, not both")
+	case len(args) > 0:
+		if objectIDs, err = insightObjectIDs(args); err != nil {
+			return err
+		}
+	case len(insightAccounts) > 0:
+		for _, a := range insightAccounts {
+			objectIDs = append(objectIDs, accountID(strings.TrimSpace(a)))
+		}
+		if len(objectIDs) == 1 {
+			overrides = accountOverrides(objectIDs[0])
+		}
+	default:
 		account, err := resolveAccount()
 		if err != nil {
 			return err
 		}
-		objectID = account
+		objectIDs = []string{account}
+		overrides = accountOverrides(account)
+	}
+
+	conv, err := newCurrencyConverter(insightCurrency, insightRates)
+	if err != nil {
+		return err
 	}
 
 	fields := insightFields
+	if !cmd.Flags().Changed("fields") && overrides.InsightFields != "" {
+		fields = overrides.InsightFields
+	}
 	if fields == "" {
 		fields = defaultInsightFields
 	}
 
+	windows := insightAttributionWindows
+	if !cmd.Flags().Changed("attribution-windows") {
+		windows = overrides.AttributionWindows
+	}
+	attribution, err := config.ParseAttributionWindows(windows)
+	if err != nil {
+		return err
+	}
+
 	// Add level-specific name fields for readable output
 	nameFields := levelNameFields(insightLevel)
+	if len(objectIDs) > 1 && insightLevel != "account" {
+		nameFields = "account_id," + nameFields
+	}
 	if nameFields != "" {
 		fields = nameFields + "," + fields
 	}
 
 	params := url.Values{}
 	params.Set("fields", fields)
+	if output.IsTable(cmd) || conv != nil {
+		// Fetched to label spend in the table and to convert amounts from;
+		// not shown as a column.
+		params.Set("fields", fields+",account_currency")
+	}
 	params.Set("level", insightLevel)
 	params.Set("time_range", fmt.Sprintf(`{"since":"%s","until":"%s"}`, insightSince, insightUntil))
 	params.Set("limit", fmt.Sprintf("%d", insightLimit))
@@ -98,17 +177,55 @@ func runInsightsGet(cmd *cobra.Command, args []string) error {
 	if insightBreakdowns != "" {
 		params.Set("breakdowns", insightBreakdowns)
 	}
+	if len(attribution) > 0 {
+		b, _ := json.Marshal(attribution)
+		params.Set("action_attribution_windows", string(b))
+	}
 
-	items, err := client.GetAll("/"+objectID+"/insights", params)
-	if err != nil {
-		return err
+	stream := output.IsStream(cmd)
+	items := []json.RawMessage{}
+	add := func(objectID string, raw json.RawMessage) error {
+		if conv != nil {
+			var err error
+			if raw, err = conv.convertRow(raw); err != nil {
+				return fmt.Errorf("%s: %w", objectID, err)
+			}
+		}
+		if stream {
+			return output.PrintItem(cmd, raw)
+		}
+		items = append(items, raw)
+		return nil
+	}
+	if len(objectIDs) == 1 {
+		objectID := objectIDs[0]
+		err := client.GetEach("/"+objectID+"/insights", params, func(raw json.RawMessage) error {
+			return add(objectID, raw)
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		var partial error
+		if partial, err = fanOutInsights(objectIDs, len(insightAccounts) > 0, params, add); err != nil {
+			return err
+		}
+		// Reported once the rows of the other objects are printed.
+		defer func() {
+			if err == nil {
+				err = partial
+			}
+		}()
+	}
+	if stream {
+		return nil
 	}
 
-	if output.IsJSON(cmd) {
+	if !output.IsTable(cmd) {
 		// Output as parsed array
 		result := make([]json.RawMessage, len(items))
 		copy(result, items)
-		return output.PrintJSON(result, prettyFlag)
+		return output.Print(cmd, result)
 	}
 
 	// Build table from dynamic fields
@@ -150,12 +267,21 @@ func runInsightsGet(cmd *cobra.Command, args []string) error {
 				} else {
 					row[j] = string(v)
 				}
+				if f == "spend" {
+					var currency string
+					if json.Unmarshal(item["account_currency"], &currency) == nil && currency != "" {
+						row[j] += " " + currency
+					}
+				}
 			}
 		}
 		rows = append(rows, row)
 	}
 
 	output.PrintTable(headers, rows)
+	if conv != nil {
+		fmt.Println(conv.note())
+	}
 	return nil
 }
 
@@ -172,3 +298,75 @@ func levelNameFields(level string) string {
 		return "account_id,account_name"
 	}
 }
+
+// insightObjectIDs returns the object IDs given as arguments, where "-"
+// stands for the IDs read from stdin, one per line (e.g. from list -q).
+func insightObjectIDs(args []string) ([]string, error) {
+	var ids []string
+	for _, arg := range args {
+		if arg != "-" {
+			ids = append(ids, arg)
+			continue
+		}
+		data, err := readArgValue("-")
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if id := strings.TrimSpace(line); id != "" {
+				ids = append(ids, id)
+			}
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("no object ID on stdin")
+	}
+	return ids, nil
+}
+
+// fanOutInsights fetches the insights of several objects (ad accounts when
+// accounts is set) concurrently with an api.FanOut, and passes their rows to
+// add in the order of objectIDs. Objects that fail are reported on stderr and
+// counted in the partial error; when they all fail, the first error is
+// returned as err instead.
+func fanOutInsights(objectIDs []string, accounts bool, params url.Values, add func(objectID string, raw json.RawMessage) error) (partial, err error) {
+	// Objects are paced on the usage of the account given with -a, if any.
+	account, _ := resolveAccount()
+	items := make([]api.FanOutItem, len(objectIDs))
+	for i, id := range objectIDs {
+		items[i] = api.FanOutItem{Path: "/" + id + "/insights", Account: account}
+		if accounts {
+			items[i].Account = id
+		}
+	}
+
+	fan := client.NewFanOut()
+	fan.Progress = func(done, total int) {
+		progressLine.Update("Fetching insights: %d of %d objects…", done, total)
+	}
+	results := fan.Run(items, params)
+	progressLine.Clear()
+
+	var firstErr error
+	failed := 0
+	for i, r := range results {
+		if r.Err != nil {
+			fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("warning: %s: %v", objectIDs[i], r.Err)))
+			firstErr = cmp.Or(firstErr, r.Err)
+			failed++
+			continue
+		}
+		for _, raw := range r.Items {
+			if err := add(objectIDs[i], raw); err != nil {
+				return nil, err
+			}
+		}
+	}
+	switch failed {
+	case 0:
+		return nil, nil
+	case len(results):
+		return nil, firstErr
+	}
+	return partialFailure("insights of %d of %d objects failed", failed, len(results)), nil
+}
@@ -0,0 +1,173 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+// defaultMaxFrequency is the frequency flagged when neither --threshold nor
+// the account's max_frequency is set.
+const defaultMaxFrequency = 3.0
+
+var (
+	frequencyLevel      string
+	frequencyDatePreset string
+	frequencySince      string
+	frequencyUntil      string
+	frequencyThreshold  float64
+)
+
+// frequencyRow is the reach and frequency of one object.
+type frequencyRow struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Reach       float64 `json:"reach"`
+	Frequency   float64 `json:"frequency"`
+	Impressions float64 `json:"impressions"`
+	CPM         float64 `json:"cpm"`
+	Spend       float64 `json:"spend"`
+	OverLimit   bool    `json:"over_threshold"`
+}
+
+// frequencyReport is the output of insights frequency.
+type frequencyReport struct {
+	Threshold float64        `json:"threshold"`
+	Rows      []frequencyRow `json:"rows"`
+}
+
+var insightsFrequencyCmd = &cobra.Command{
+	Use:   "frequency [object_id]",
+	Short: "Report reach and frequency and flag objects shown too often",
+	Long: `Report the reach, frequency and CPM of each campaign, ad set or ad, highest
+frequency first, and flag the ones above --threshold — an early warning of
+creative fatigue.
+
+The threshold defaults to the account's max_frequency (see: meta-ads config
+account), else 3.
+
+Examples:
+  meta-ads insights frequency --level campaign --since 2026-01-01 --until 2026-01-31
+  meta-ads insights frequency --level ad --date-preset last_7d --threshold 2.5
+  meta-ads insights frequency 120210000000000 --level adset --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runInsightsFrequency,
+}
+
+func init() {
+	f := insightsFrequencyCmd.Flags()
+	f.StringVar(&frequencyLevel, "level", "campaign", "Level: campaign, adset, ad")
+	f.StringVar(&frequencyDatePreset, "date-preset", "last_7d", "Period (e.g. last_7d, last_30d, this_month); ignored with --since/--until")
+	f.StringVar(&frequencySince, "since", "", "Start date YYYY-MM-DD")
+	f.StringVar(&frequencyUntil, "until", "", "End date YYYY-MM-DD")
+	f.Float64Var(&frequencyThreshold, "threshold", 0, "Flag frequencies above this (default: the account's max_frequency, else 3)")
+	insightsFrequencyCmd.MarkFlagsRequiredTogether("since", "until")
+	_ = insightsFrequencyCmd.RegisterFlagCompletionFunc("level", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return []string{"campaign", "adset", "ad"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	insightsFrequencyCmd.ValidArgsFunction = completeObjects("campaign", "adset")
+	insightsCmd.AddCommand(insightsFrequencyCmd)
+}
+
+func runInsightsFrequency(cmd *cobra.Command, args []string) error {
+	if frequencyLevel != "campaign" && frequencyLevel != "adset" && frequencyLevel != "ad" {
+		return usageError("invalid --level %q: use campaign, adset or ad", frequencyLevel)
+	}
+	account, err := resolveAccount()
+	objectID := account
+	if len(args) == 1 {
+		objectID = args[0]
+	} else if err != nil {
+		return err
+	}
+
+	threshold := frequencyThreshold
+	if threshold <= 0 && account != "" {
+		threshold, _ = strconv.ParseFloat(accountOverrides(account).MaxFrequency, 64)
+	}
+	if threshold <= 0 {
+		threshold = defaultMaxFrequency
+	}
+
+	params := url.Values{}
+	params.Set("fields", levelNameFields(frequencyLevel)+",reach,frequency,impressions,cpm,spend,account_currency")
+	params.Set("level", frequencyLevel)
+	if frequencySince != "" {
+		params.Set("time_range", fmt.Sprintf(`{"since":"%s","until":"%s"}`, frequencySince, frequencyUntil))
+	} else {
+		params.Set("date_preset", frequencyDatePreset)
+	}
+
+	report := frequencyReport{Threshold: threshold, Rows: []frequencyRow{}}
+	var currency string
+	err = client.GetEach("/"+objectID+"/insights", params, func(raw json.RawMessage) error {
+		var r map[string]api.FlexString
+		if err := json.Unmarshal(raw, &r); err != nil {
+			return fmt.Errorf("parsing insight: %w", err)
+		}
+		num := func(k string) float64 {
+			v, _ := strconv.ParseFloat(r[k].String(), 64)
+			return v
+		}
+		row := frequencyRow{
+			ID:          r[frequencyLevel+"_id"].String(),
+			Name:        r[frequencyLevel+"_name"].String(),
+			Reach:       num("reach"),
+			Frequency:   num("frequency"),
+			Impressions: num("impressions"),
+			CPM:         num("cpm"),
+			Spend:       num("spend"),
+		}
+		row.OverLimit = row.Frequency > threshold
+		currency = r["account_currency"].String()
+		report.Rows = append(report.Rows, row)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	sort.SliceStable(report.Rows, func(i, j int) bool { return report.Rows[i].Frequency > report.Rows[j].Frequency })
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, report)
+	}
+	if len(report.Rows) == 0 {
+		fmt.Println("No insights found for the specified period.")
+		return nil
+	}
+	money := func(v float64) string {
+		s := strconv.FormatFloat(v, 'f', 2, 64)
+		if currency != "" {
+			s += " " + currency
+		}
+		return s
+	}
+	over := 0
+	rows := make([][]string, len(report.Rows))
+	for i, r := range report.Rows {
+		flag := ""
+		if r.OverLimit {
+			flag = output.Warn("▲ over " + strconv.FormatFloat(threshold, 'f', -1, 64))
+			over++
+		}
+		rows[i] = []string{
+			output.Truncate(orDash(r.Name), 40),
+			r.ID,
+			strconv.FormatFloat(r.Reach, 'f', 0, 64),
+			strconv.FormatFloat(r.Frequency, 'f', 2, 64),
+			strconv.FormatFloat(r.Impressions, 'f', 0, 64),
+			money(r.CPM),
+			money(r.Spend),
+			flag,
+		}
+	}
+	output.PrintTable([]string{"NAME", "ID", "REACH", "FREQUENCY", "IMPRESSIONS", "CPM", "SPEND", "FLAG"}, rows)
+	fmt.Printf("\n%d of %d %s(s) above a frequency of %s.\n", over, len(report.Rows), frequencyLevel, strconv.FormatFloat(threshold, 'f', -1, 64))
+	return nil
+}
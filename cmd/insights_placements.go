@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/monitor"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	placementsDatePreset string
+	placementsSince      string
+	placementsUntil      string
+	placementsConversion string
+	placementsOutlier    float64
+)
+
+// placementPerformance is the performance of one platform position.
+type placementPerformance struct {
+	Platform    string   `json:"publisher_platform"`
+	Position    string   `json:"platform_position"`
+	Spend       float64  `json:"spend"`
+	Impressions float64  `json:"impressions"`
+	Clicks      float64  `json:"clicks"`
+	CTR         float64  `json:"ctr"`
+	CPM         float64  `json:"cpm"`
+	Conversions float64  `json:"conversions"`
+	CPA         *float64 `json:"cpa,omitempty"`
+	ROAS        *float64 `json:"roas,omitempty"`
+	SpendShare  float64  `json:"spend_share"`
+	// Flag is "high_cpa", "low_cpa" or "no_conversions" for outliers.
+	Flag string `json:"flag,omitempty"`
+}
+
+var insightsPlacementsCmd = &cobra.Command{
+	Use:   "placements [object_id]",
+	Short: "Compare performance across placements and flag outlier CPAs",
+	Long: `Break insights down by publisher platform and position, one row per placement,
+sorted by spend.
+
+Placements are flagged against the CPA of the whole object: high_cpa when their
+CPA is more than --outlier times it, low_cpa when it is less than the CPA
+divided by --outlier, and no_conversions when they spent at least one overall
+CPA without converting.
+
+Examples:
+  meta-ads insights placements -a act_123456789
+  meta-ads insights placements 120210000000000 --date-preset last_7d
+  meta-ads insights placements --since 2026-01-01 --until 2026-01-31 --conversion lead --json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runInsightsPlacements,
+}
+
+func init() {
+	f := insightsPlacementsCmd.Flags()
+	f.StringVar(&placementsDatePreset, "date-preset", "last_30d", "Period (e.g. last_7d, last_30d, this_month); ignored with --since/--until")
+	f.StringVar(&placementsSince, "since", "", "Start date YYYY-MM-DD")
+	f.StringVar(&placementsUntil, "until", "", "End date YYYY-MM-DD")
+	f.StringVar(&placementsConversion, "conversion", monitor.DefaultConversion, "Action type counted as a conversion")
+	f.Float64Var(&placementsOutlier, "outlier", 1.5, "Flag placements whose CPA differs from the overall CPA by this factor")
+	insightsPlacementsCmd.MarkFlagsRequiredTogether("since", "until")
+	insightsPlacementsCmd.ValidArgsFunction = completeObjects("campaign", "adset", "ad")
+	insightsCmd.AddCommand(insightsPlacementsCmd)
+}
+
+func runInsightsPlacements(cmd *cobra.Command, args []string) error {
+	if placementsOutlier <= 1 {
+		return usageError("--outlier must be greater than 1")
+	}
+	var objectID string
+	if len(args) == 1 {
+		objectID = args[0]
+	} else {
+		account, err := resolveAccount()
+		if err != nil {
+			return err
+		}
+		objectID = account
+	}
+
+	params := url.Values{}
+	params.Set("fields", "account_currency,"+monitor.InsightFields)
+	params.Set("breakdowns", "publisher_platform,platform_position")
+	if placementsSince != "" {
+		params.Set("time_range", fmt.Sprintf(`{"since":"%s","until":"%s"}`, placementsSince, placementsUntil))
+	} else {
+		params.Set("date_preset", placementsDatePreset)
+	}
+
+	var rows []placementPerformance
+	var currency string
+	var totalSpend, totalConversions float64
+	err := client.GetEach("/"+objectID+"/insights", params, func(raw json.RawMessage) error {
+		var row struct {
+			Platform        string `json:"publisher_platform"`
+			Position        string `json:"platform_position"`
+			AccountCurrency string `json:"account_currency"`
+		}
+		if err := json.Unmarshal(raw, &row); err != nil {
+			return fmt.Errorf("parsing insight: %w", err)
+		}
+		m, err := monitor.Compute(raw, placementsConversion)
+		if err != nil {
+			return fmt.Errorf("parsing insight: %w", err)
+		}
+		p := placementPerformance{
+			Platform:    row.Platform,
+			Position:    row.Position,
+			Spend:       m["spend"],
+			Impressions: m["impressions"],
+			Clicks:      m["clicks"],
+			CTR:         m["ctr"],
+			CPM:         m["cpm"],
+			Conversions: m["conversions"],
+		}
+		if v, ok := m["cpa"]; ok {
+			p.CPA = &v
+		}
+		if v, ok := m["roas"]; ok {
+			p.ROAS = &v
+		}
+		currency = row.AccountCurrency
+		totalSpend += p.Spend
+		totalConversions += p.Conversions
+		rows = append(rows, p)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	overallCPA := 0.0
+	if totalConversions > 0 {
+		overallCPA = totalSpend / totalConversions
+	}
+	for i := range rows {
+		p := &rows[i]
+		if totalSpend > 0 {
+			p.SpendShare = p.Spend / totalSpend
+		}
+		switch {
+		case overallCPA == 0 || p.Spend == 0:
+		case p.CPA == nil:
+			if p.Spend >= overallCPA {
+				p.Flag = "no_conversions"
+			}
+		case *p.CPA > overallCPA*placementsOutlier:
+			p.Flag = "high_cpa"
+		case *p.CPA < overallCPA/placementsOutlier:
+			p.Flag = "low_cpa"
+		}
+	}
+	sort.SliceStable(rows, func(i, j int) bool { return rows[i].Spend > rows[j].Spend })
+
+	if rows == nil {
+		rows = []placementPerformance{}
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, rows)
+	}
+	if len(rows) == 0 {
+		fmt.Println("No insights found for the specified period.")
+		return nil
+	}
+
+	money := func(v float64) string {
+		s := strconv.FormatFloat(v, 'f', 2, 64)
+		if currency != "" {
+			s += " " + currency
+		}
+		return s
+	}
+	table := make([][]string, len(rows))
+	for i, p := range rows {
+		cpa, roas := "-", "-"
+		if p.CPA != nil {
+			cpa = money(*p.CPA)
+		}
+		if p.ROAS != nil {
+			roas = strconv.FormatFloat(*p.ROAS, 'f', 2, 64)
+		}
+		flag := ""
+		switch p.Flag {
+		case "high_cpa":
+			flag = output.Warn("▲ high CPA")
+		case "low_cpa":
+			flag = "▼ low CPA"
+		case "no_conversions":
+			flag = output.Warn("no conversions")
+		}
+		table[i] = []string{
+			p.Platform,
+			p.Position,
+			money(p.Spend),
+			fmt.Sprintf("%.0f%%", p.SpendShare*100),
+			strconv.FormatFloat(p.Impressions, 'f', 0, 64),
+			fmt.Sprintf("%.2f%%", p.CTR),
+			money(p.CPM),
+			strconv.FormatFloat(p.Conversions, 'f', -1, 64),
+			cpa,
+			roas,
+			flag,
+		}
+	}
+	output.PrintTable([]string{"PLATFORM", "POSITION", "SPEND", "SHARE", "IMPRESSIONS", "CTR", "CPM", "CONV", "CPA", "ROAS", "FLAG"}, table)
+	if overallCPA > 0 {
+		fmt.Printf("\nOverall CPA: %s (%s conversions: %s)\n", money(overallCPA), placementsConversion,
+			strconv.FormatFloat(totalConversions, 'f', -1, 64))
+	}
+	return nil
+}
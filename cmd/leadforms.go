@@ -0,0 +1,286 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	leadformsPage string
+	leadsForm     string
+	leadsPage     string
+	leadsSince    string
+	leadsOut      string
+)
+
+const leadFormFields = "id,name,status,locale,leads_count,created_time"
+
+const leadFields = "id,created_time,form_id,ad_id,ad_name,adset_id,adset_name,campaign_id,campaign_name,platform,is_organic,field_data"
+
+var leadformsCmd = &cobra.Command{
+	Use:   "leadforms",
+	Short: "Manage lead generation (instant) forms",
+}
+
+var leadformsListCmd = &cobra.Command{
+	Use:   "list --page <page_id>",
+	Short: "List lead forms of a page",
+	Args:  cobra.NoArgs,
+	RunE:  runLeadformsList,
+}
+
+var leadformsGetCmd = &cobra.Command{
+	Use:   "get <form_id>",
+	Short: "Show a lead form with its questions",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runLeadformsGet,
+}
+
+var leadsCmd = &cobra.Command{
+	Use:   "leads",
+	Short: "Retrieve leads submitted through lead forms",
+}
+
+var leadsDownloadCmd = &cobra.Command{
+	Use:   "download --form <form_id>",
+	Short: "Download the leads of a form as CSV",
+	Long: `Download every lead of a form as CSV: one row per lead, with the lead,
+ad, ad set and campaign IDs followed by one column per form answer. Answers
+with several values are joined with "; ".
+
+Lead retrieval needs a page access token with leads_retrieval: it is fetched
+from the form's page with your token, which must have pages_manage_ads and
+leads_retrieval.
+
+Examples:
+  meta-ads leads download --form 123456789 --out leads.csv
+  meta-ads leads download --form 123456789 --since 2026-03-01 > leads.csv`,
+	Args: cobra.NoArgs,
+	RunE: runLeadsDownload,
+}
+
+func init() {
+	leadformsListCmd.Flags().StringVar(&leadformsPage, "page", "", "Page ID (required)")
+	_ = leadformsListCmd.MarkFlagRequired("page")
+	addCountFlag(leadformsListCmd)
+	addPagingFlags(leadformsListCmd)
+	leadformsCmd.AddCommand(leadformsListCmd, leadformsGetCmd)
+	rootCmd.AddCommand(leadformsCmd)
+
+	leadsDownloadCmd.Flags().StringVar(&leadsForm, "form", "", "Lead form ID (required)")
+	leadsDownloadCmd.Flags().StringVar(&leadsPage, "page", "", "Page of the form (default: looked up from the form)")
+	leadsDownloadCmd.Flags().StringVar(&leadsSince, "since", "", "Only leads created after this date (YYYY-MM-DD or RFC 3339)")
+	leadsDownloadCmd.Flags().StringVar(&leadsOut, "out", "", "Output CSV file (default: stdout)")
+	_ = leadsDownloadCmd.MarkFlagRequired("form")
+	leadsCmd.AddCommand(leadsDownloadCmd)
+	rootCmd.AddCommand(leadsCmd)
+}
+
+// usePageToken switches client to the access token of pageID, which lead
+// endpoints require. Tokens that can't read it (e.g. system users with direct
+// page access) are kept as they are.
+func usePageToken(pageID string) error {
+	var page struct {
+		AccessToken string `json:"access_token"`
+	}
+	if err := getObject(pageID, "access_token", &page); err != nil || page.AccessToken == "" {
+		return nil
+	}
+	_, appSecret, err := resolveToken()
+	if err != nil {
+		return err
+	}
+	client, err = newClient(page.AccessToken, appSecret)
+	return err
+}
+
+// formPage returns the ID of the page a lead form belongs to.
+func formPage(formID string) (string, error) {
+	var form struct {
+		Page struct {
+			ID string `json:"id"`
+		} `json:"page"`
+	}
+	if err := getObject(formID, "page{id}", &form); err != nil {
+		return "", fmt.Errorf("looking up the page of form %s: %w", formID, err)
+	}
+	return form.Page.ID, nil
+}
+
+func runLeadformsList(cmd *cobra.Command, args []string) error {
+	if err := usePageToken(leadformsPage); err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("fields", leadFormFields)
+
+	forms, streamed, err := listAll[api.LeadForm](cmd, "/"+leadformsPage+"/leadgen_forms", params, "lead form", nil)
+	if err != nil || streamed {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, forms)
+	}
+	if len(forms) == 0 {
+		fmt.Println("No lead forms found.")
+		return nil
+	}
+	rows := make([][]string, len(forms))
+	for i, f := range forms {
+		rows[i] = []string{
+			f.ID,
+			output.Truncate(f.Name, 40),
+			output.Status(f.Status),
+			strconv.Itoa(f.LeadsCount),
+			f.Locale,
+			output.FormatTime(f.CreatedTime),
+		}
+	}
+	output.PrintTable([]string{"ID", "NAME", "STATUS", "LEADS", "LOCALE", "CREATED"}, rows)
+	return nil
+}
+
+func runLeadformsGet(cmd *cobra.Command, args []string) error {
+	pageID, err := formPage(args[0])
+	if err != nil {
+		return err
+	}
+	if err := usePageToken(pageID); err != nil {
+		return err
+	}
+	var form api.LeadForm
+	if err := getObject(args[0], leadFormFields+",questions", &form); err != nil {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, form)
+	}
+	output.PrintKeyValue([][]string{
+		{"ID", form.ID},
+		{"Name", form.Name},
+		{"Status", output.Status(form.Status)},
+		{"Page", pageID},
+		{"Leads", strconv.Itoa(form.LeadsCount)},
+		{"Locale", form.Locale},
+		{"Created", output.FormatTime(form.CreatedTime)},
+	})
+	if len(form.Questions) > 0 {
+		fmt.Println()
+		rows := make([][]string, len(form.Questions))
+		for i, q := range form.Questions {
+			rows[i] = []string{q.Key, q.Type, output.Truncate(q.Label, 50)}
+		}
+		output.PrintTable([]string{"KEY", "TYPE", "LABEL"}, rows)
+	}
+	return nil
+}
+
+func runLeadsDownload(cmd *cobra.Command, args []string) error {
+	pageID := leadsPage
+	if pageID == "" {
+		var err error
+		if pageID, err = formPage(leadsForm); err != nil {
+			return err
+		}
+	}
+	if err := usePageToken(pageID); err != nil {
+		return err
+	}
+
+	params := url.Values{}
+	params.Set("fields", leadFields)
+	if leadsSince != "" {
+		since, err := parseTimeFlag(leadsSince, "since")
+		if err != nil {
+			return err
+		}
+		params.Set("filtering", fmt.Sprintf(`[{"field":"time_created","operator":"GREATER_THAN","value":%d}]`, since))
+	}
+
+	var leads []api.Lead
+	err := client.GetEach("/"+leadsForm+"/leads", params, func(raw json.RawMessage) error {
+		var l api.Lead
+		if err := json.Unmarshal(raw, &l); err != nil {
+			return fmt.Errorf("parsing lead: %w", err)
+		}
+		leads = append(leads, l)
+		if len(leads)%500 == 0 {
+			progress("%d leads…", len(leads))
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	header, records := flattenLeads(leads)
+	if !output.IsTable(cmd) && leadsOut == "" {
+		rows := make([]map[string]string, len(records))
+		for i, rec := range records {
+			rows[i] = make(map[string]string, len(header))
+			for j, h := range header {
+				rows[i][h] = rec[j]
+			}
+		}
+		return output.Print(cmd, rows)
+	}
+
+	var w io.Writer = os.Stdout
+	if leadsOut != "" {
+		// Leads hold personal data: keep the file private to the user.
+		f, err := os.OpenFile(leadsOut, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return fmt.Errorf("creating %s: %w", leadsOut, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	cw := csv.NewWriter(w)
+	_ = cw.Write(header)
+	_ = cw.WriteAll(records)
+	if err := cw.Error(); err != nil {
+		return fmt.Errorf("writing CSV: %w", err)
+	}
+	if leadsOut != "" {
+		fmt.Printf("✓ %d leads written to %s\n", len(leads), leadsOut)
+	}
+	return nil
+}
+
+// flattenLeads turns leads into CSV records: fixed lead columns followed by
+// one column per answer name, in order of first appearance.
+func flattenLeads(leads []api.Lead) ([]string, [][]string) {
+	header := []string{"id", "created_time", "form_id", "ad_id", "ad_name", "adset_id", "adset_name", "campaign_id", "campaign_name", "platform", "is_organic"}
+	column := map[string]int{}
+	for _, l := range leads {
+		for _, f := range l.FieldData {
+			if _, ok := column[f.Name]; !ok {
+				column[f.Name] = len(header)
+				header = append(header, f.Name)
+			}
+		}
+	}
+
+	records := make([][]string, len(leads))
+	for i, l := range leads {
+		rec := make([]string, len(header))
+		copy(rec, []string{l.ID, l.CreatedTime, l.FormID, l.AdID, l.AdName, l.AdSetID, l.AdSetName, l.CampaignID, l.CampaignName, l.Platform, strconv.FormatBool(l.IsOrganic)})
+		for _, f := range l.FieldData {
+			rec[column[f.Name]] = strings.Join(f.Values, "; ")
+		}
+		records[i] = rec
+	}
+	return header, records
+}
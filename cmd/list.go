@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+// listAll fetches every item of a list endpoint, decoding each one as T.
+// Items for which keep returns false are dropped (keep may be nil); keep may
+// also fill in fields of the item that Meta doesn't return.
+//
+// With --output ndjson or --quiet the items are printed as soon as their page
+// arrives and are not collected: streamed is true and the caller has nothing left to print.
+//
+// --limit (see addLimitFlag) stops paging once that many items are kept.
+//
+// With --count (see addCountFlag) only the total is fetched and printed, and
+// streamed is true as well. With --after or --max-pages (see addPagingFlags)
+// the JSON output is printed here, as a listPage carrying the next cursor,
+// and streamed is true too; other formats get the cursor on stderr.
+func listAll[T any](cmd *cobra.Command, path string, params url.Values, what string, keep func(*T) bool) (items []T, streamed bool, err error) {
+	if count, _ := cmd.Flags().GetBool("count"); count {
+		if keep != nil {
+			return nil, true, usageError("--count can't be combined with filters applied locally, such as --name-contains")
+		}
+		return nil, true, printCount(cmd, path, params)
+	}
+	params, maxPages, manual, err := pagingParams(cmd, params)
+	if err != nil {
+		return nil, false, err
+	}
+	limit, _ := cmd.Flags().GetInt("limit")
+	kept := 0
+	stream := output.IsStream(cmd)
+	next, err := client.GetPages(path, params, maxPages, func(raw json.RawMessage) error {
+		var item T
+		if err := json.Unmarshal(raw, &item); err != nil {
+			return fmt.Errorf("parsing %s: %w", what, err)
+		}
+		if keep != nil && !keep(&item) {
+			return nil
+		}
+		if stream {
+			if err := output.PrintItem(cmd, item); err != nil {
+				return err
+			}
+		} else {
+			items = append(items, item)
+		}
+		if kept++; kept == limit {
+			return api.StopPaging
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, stream, err
+	}
+	if items == nil {
+		items = []T{}
+	}
+	if manual && !stream && !output.IsTable(cmd) {
+		return nil, true, output.Print(cmd, listPage[T]{Data: items, NextCursor: next})
+	}
+	if next != "" {
+		fmt.Fprintf(os.Stderr, "More results: run again with --after %s\n", next)
+	}
+	return items, stream, nil
+}
+
+// listPage is the JSON output of listAll when paginating manually.
+type listPage[T any] struct {
+	Data []T `json:"data"`
+	// NextCursor is the --after of the next page, empty on the last one.
+	NextCursor string `json:"next_cursor,omitempty"`
+}
+
+// addPagingFlags adds --page-size, --after and --max-pages to a list command
+// using listAll, for callers such as agents to paginate incrementally.
+func addPagingFlags(cmd *cobra.Command) {
+	cmd.Flags().Int("page-size", 0, "Items per API page (default 100)")
+	cmd.Flags().String("after", "", "Start after this cursor, the next_cursor of a previous page")
+	cmd.Flags().Int("max-pages", 0, "Fetch at most this many pages and print the cursor of the next one (0 = all)")
+}
+
+// addLimitFlag adds --limit to a list command, which listAll honors; what is
+// the plural of its items.
+func addLimitFlag(cmd *cobra.Command, what string) {
+	cmd.Flags().Int("limit", 0, fmt.Sprintf("Max number of %s to return, across pages (0 = all)", what))
+}
+
+// pagingParams returns params with the paging flags of cmd applied, the
+// maximum number of pages, and whether pagination is manual (--after or
+// --max-pages), which makes the JSON output carry the next cursor.
+func pagingParams(cmd *cobra.Command, params url.Values) (url.Values, int, bool, error) {
+	size, _ := cmd.Flags().GetInt("page-size")
+	after, _ := cmd.Flags().GetString("after")
+	maxPages, _ := cmd.Flags().GetInt("max-pages")
+	limit, _ := cmd.Flags().GetInt("limit")
+	if size < 0 || maxPages < 0 || limit < 0 {
+		return nil, 0, false, usageError("--page-size, --max-pages and --limit must be positive")
+	}
+	if limit > 0 && (after != "" || maxPages > 0) {
+		// Stopping mid-page would leave no cursor to resume from.
+		return nil, 0, false, usageError("--limit can't be combined with --after or --max-pages")
+	}
+	p := url.Values{}
+	for k, v := range params {
+		p[k] = v
+	}
+	if size > 0 {
+		p.Set("limit", strconv.Itoa(size))
+	} else if limit > 0 {
+		p.Set("limit", strconv.Itoa(min(limit, 100)))
+	}
+	if after != "" {
+		p.Set("after", after)
+	}
+	return p, maxPages, after != "" || maxPages > 0, nil
+}
+
+// addCountFlag adds --count to a list command using listAll.
+func addCountFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("count", false, "Print only the total number of items, without fetching them")
+}
+
+// printCount prints the total number of items of a list endpoint: just the
+// number in a table or with --quiet, {"total_count": N} otherwise.
+func printCount(cmd *cobra.Command, path string, params url.Values) error {
+	n, err := client.Count(path, params)
+	if err != nil {
+		return err
+	}
+	if !output.IsTable(cmd) && output.FormatOf(cmd) != output.FormatQuiet {
+		return output.Print(cmd, map[string]int{"total_count": n})
+	}
+	fmt.Println(n)
+	return nil
+}
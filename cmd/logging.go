@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	logLevelFlag string
+	logFileFlag  string
+	traceDirFlag string
+)
+
+// logger receives the structured (JSON lines) logs of the client and of
+// long-running commands. It discards everything unless --log-level or
+// --log-file is given; user-facing output never goes through it.
+var logger = slog.New(slog.NewJSONHandler(io.Discard, nil))
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&logLevelFlag, "log-level", "", "Write structured JSON logs at this level: debug, info, warn, error (default info with --log-file)")
+	rootCmd.PersistentFlags().StringVar(&logFileFlag, "log-file", "", "Append the structured logs to this file instead of stderr")
+	rootCmd.PersistentFlags().StringVar(&traceDirFlag, "trace-dir", "", "Write every HTTP exchange with the Graph API (credentials removed) to numbered files in this directory")
+	_ = rootCmd.RegisterFlagCompletionFunc("log-level", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return []string{"debug", "info", "warn", "error"}, cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// startLogging sets up logger from --log-level / --log-file or
+// META_ADS_LOG_LEVEL / META_ADS_LOG_FILE.
+func startLogging(command string) error {
+	level := logLevelFlag
+	if level == "" {
+		level = os.Getenv("META_ADS_LOG_LEVEL")
+	}
+	path := logFileFlag
+	if path == "" {
+		path = os.Getenv("META_ADS_LOG_FILE")
+	}
+	if level == "" && path == "" {
+		return nil
+	}
+
+	var l slog.Level
+	switch strings.ToLower(level) {
+	case "debug":
+		l = slog.LevelDebug
+	case "", "info":
+		l = slog.LevelInfo
+	case "warn", "warning":
+		l = slog.LevelWarn
+	case "error":
+		l = slog.LevelError
+	default:
+		return usageError("invalid log level %q — use debug, info, warn or error", level)
+	}
+
+	var w io.Writer = os.Stderr
+	if path != "" {
+		// Left open until the process exits.
+		f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+		if err != nil {
+			return fmt.Errorf("opening log file: %w", err)
+		}
+		w = f
+	}
+	logger = slog.New(slog.NewJSONHandler(w, &slog.HandlerOptions{Level: l})).With("command", command, "pid", os.Getpid())
+	return nil
+}
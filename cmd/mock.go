@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"os"
+
+	"github.com/the20100/meta-ads-cli/internal/api"
+)
+
+var (
+	mockFlag   string
+	recordFlag string
+	replayFlag string
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&mockFlag, "mock", "", "Answer API calls from the JSON fixtures in this directory instead of Meta (offline mode)")
+	rootCmd.PersistentFlags().StringVar(&recordFlag, "record", "", "Save every API exchange to this cassette file, to --replay later")
+	rootCmd.PersistentFlags().StringVar(&replayFlag, "replay", "", "Answer API calls from a cassette saved with --record instead of Meta")
+}
+
+// mockDir returns the fixture directory of the mock mode, from --mock or
+// META_ADS_MOCK_DIR, or "" when the API is called for real.
+func mockDir() string {
+	if mockFlag != "" {
+		return mockFlag
+	}
+	return os.Getenv("META_ADS_MOCK_DIR")
+}
+
+// offline reports whether API calls are answered locally (--mock or
+// --replay), in which case no token is needed.
+func offline() bool {
+	return mockDir() != "" || replayFlag != ""
+}
+
+// applyOfflineModes sets up --mock, --record and --replay on c.
+func applyOfflineModes(c *api.Client) error {
+	modes := 0
+	for _, v := range []string{mockDir(), recordFlag, replayFlag} {
+		if v != "" {
+			modes++
+		}
+	}
+	if modes > 1 {
+		return usageError("--mock, --record and --replay cannot be used together")
+	}
+	switch {
+	case mockDir() != "":
+		return c.SetMockDir(mockDir())
+	case recordFlag != "":
+		return c.SetRecord(recordFlag)
+	case replayFlag != "":
+		return c.SetReplay(replayFlag)
+	}
+	return nil
+}
@@ -0,0 +1,296 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/monitor"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	monitorRules    string
+	monitorInterval time.Duration
+	monitorOnce     bool
+	monitorDryRun   bool
+	monitorLog      string
+)
+
+var monitorCmd = &cobra.Command{
+	Use:   "monitor --rules <file>",
+	Short: "Evaluate local rules against insights and act on matches",
+	Long: `Evaluate local rules against insights every --interval, execute their
+actions, and log every decision — for conditions Meta's automated rules can't
+express (see also: meta-ads rules).
+
+Rules file:
+
+  account: act_123456789          # optional, defaults to --account
+  rules:
+    - name: High CPA
+      level: adset                # campaign, adset (default) or ad
+      date_preset: last_3d        # insights window (default last_3d)
+      when: ["cpa > 40", "spend > 100"]   # all must match
+      action: pause               # pause, unpause or notify
+    - name: Big spender
+      level: campaign
+      date_preset: today
+      when: ["spend > 500"]
+      action: notify
+      webhook: https://hooks.slack.com/services/...   # else printed to stderr
+      cooldown: 6h                # between notifications per object (default 24h)
+
+Metrics: spend, impressions, clicks, reach, frequency, ctr, cpc, cpm,
+conversions, cpa (spend / conversions), roas. Conversions count the
+"purchase" action unless a rule sets conversion: <action_type>.
+
+Only active objects are evaluated (paused ones for unpause rules), and only
+those with delivery in the window. Every decision is appended as a JSON line to
+--log when given.`,
+	Args: cobra.NoArgs,
+	RunE: runMonitor,
+}
+
+func init() {
+	monitorCmd.Flags().StringVar(&monitorRules, "rules", "", "Rules file (YAML, required)")
+	monitorCmd.Flags().DurationVar(&monitorInterval, "interval", 15*time.Minute, "Time between evaluations")
+	monitorCmd.Flags().BoolVar(&monitorOnce, "once", false, "Evaluate once and exit")
+	monitorCmd.Flags().BoolVar(&monitorDryRun, "dry-run", false, "Log decisions without executing actions")
+	monitorCmd.Flags().StringVar(&monitorLog, "log", "", "Append every decision as a JSON line to this file")
+	_ = monitorCmd.MarkFlagRequired("rules")
+	rootCmd.AddCommand(monitorCmd)
+}
+
+// monitorDecision is the outcome of one rule for one object.
+type monitorDecision struct {
+	Time    string             `json:"time"`
+	Rule    string             `json:"rule"`
+	Level   string             `json:"level"`
+	ID      string             `json:"id"`
+	Name    string             `json:"name"`
+	Metrics map[string]float64 `json:"metrics"`
+	Matched bool               `json:"matched"`
+	Action  string             `json:"action,omitempty"`
+	// Result is done, dry-run, cooldown or failed for matched objects.
+	Result string `json:"result,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func runMonitor(cmd *cobra.Command, args []string) error {
+	rules, err := monitor.Load(monitorRules)
+	if err != nil {
+		return err
+	}
+	account := ""
+	if rules.Account != "" {
+		account = accountID(rules.Account)
+	} else if account, err = resolveAccount(); err != nil {
+		return err
+	}
+	if monitorInterval < time.Minute && !monitorOnce {
+		return usageError("--interval must be at least 1m")
+	}
+
+	var logFile io.Writer
+	if monitorLog != "" {
+		f, err := os.OpenFile(monitorLog, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+		if err != nil {
+			return fmt.Errorf("opening log: %w", err)
+		}
+		defer f.Close()
+		logFile = f
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	logger.Info("monitor started", "account", account, "rules", len(rules.Rules), "interval", monitorInterval.String(), "dry_run", monitorDryRun)
+	// notified holds the last notification time per rule and object.
+	notified := map[string]time.Time{}
+	for {
+		for _, r := range rules.Rules {
+			decisions, err := evaluateMonitorRule(account, r, notified)
+			if err != nil {
+				fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("rule %q: %v", r.Name, err)))
+				logger.Warn("rule evaluation failed", "rule", r.Name, "error", err.Error())
+				continue
+			}
+			matched := 0
+			for _, d := range decisions {
+				if d.Matched {
+					logMonitorDecision(d)
+				}
+				if logFile != nil {
+					b, _ := json.Marshal(d)
+					fmt.Fprintln(logFile, string(b))
+				}
+				if !output.IsTable(cmd) {
+					if err := output.PrintLine(d); err != nil {
+						return err
+					}
+					continue
+				}
+				if d.Matched {
+					matched++
+					printMonitorDecision(d)
+				}
+			}
+			if output.IsTable(cmd) {
+				fmt.Printf("%s  %s: %d evaluated, %d matched\n", time.Now().Format("15:04:05"), r.Name, len(decisions), matched)
+			}
+			logger.Debug("rule evaluated", "rule", r.Name, "evaluated", len(decisions))
+		}
+
+		if monitorOnce {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			logger.Info("monitor stopped")
+			return nil
+		case <-time.After(monitorInterval):
+		}
+	}
+}
+
+// logMonitorDecision logs a matched decision: error when its action failed.
+func logMonitorDecision(d monitorDecision) {
+	attrs := []any{"rule", d.Rule, "level", d.Level, "id", d.ID, "name", d.Name, "action", d.Action, "result", d.Result}
+	if d.Error != "" {
+		logger.Error("monitor action failed", append(attrs, "error", d.Error)...)
+		return
+	}
+	logger.Info("monitor rule matched", attrs...)
+}
+
+// evaluateMonitorRule fetches the insights of the objects r applies to and
+// executes its action on every match.
+func evaluateMonitorRule(account string, r *monitor.Rule, notified map[string]time.Time) ([]monitorDecision, error) {
+	status := "ACTIVE"
+	if r.Action == monitor.ActionUnpause {
+		status = "PAUSED"
+	}
+	params := url.Values{}
+	params.Set("level", r.Level)
+	params.Set("date_preset", r.DatePreset)
+	params.Set("fields", levelNameFields(r.Level)+","+monitor.InsightFields)
+	params.Set("filtering", fmt.Sprintf(`[{"field":"%s.effective_status","operator":"IN","value":["%s"]}]`, r.Level, status))
+
+	rows, err := client.GetAll("/"+account+"/insights", params)
+	if err != nil {
+		return nil, err
+	}
+
+	decisions := make([]monitorDecision, 0, len(rows))
+	for _, row := range rows {
+		var ids map[string]any
+		_ = json.Unmarshal(row, &ids)
+		d := monitorDecision{
+			Time:  time.Now().UTC().Format(time.RFC3339),
+			Rule:  r.Name,
+			Level: r.Level,
+			ID:    fmt.Sprint(ids[r.Level+"_id"]),
+			Name:  fmt.Sprint(ids[r.Level+"_name"]),
+		}
+		d.Metrics, err = monitor.Compute(row, r.Conversion)
+		if err != nil {
+			return nil, fmt.Errorf("parsing insights: %w", err)
+		}
+		d.Matched = r.Evaluate(d.Metrics)
+		if d.Matched {
+			d.Action = r.Action
+			executeMonitorAction(r, &d, notified)
+		}
+		decisions = append(decisions, d)
+	}
+	return decisions, nil
+}
+
+// executeMonitorAction runs the action of r on the object of d and records the result.
+func executeMonitorAction(r *monitor.Rule, d *monitorDecision, notified map[string]time.Time) {
+	if monitorDryRun {
+		d.Result = "dry-run"
+		return
+	}
+
+	var err error
+	switch r.Action {
+	case monitor.ActionPause, monitor.ActionUnpause:
+		body := url.Values{}
+		body.Set("status", "PAUSED")
+		if r.Action == monitor.ActionUnpause {
+			body.Set("status", "ACTIVE")
+		}
+		_, err = client.Post("/"+d.ID, body)
+	case monitor.ActionNotify:
+		key := r.Name + "/" + d.ID
+		if last, ok := notified[key]; ok && time.Since(last) < r.CooldownDuration() {
+			d.Result = "cooldown"
+			return
+		}
+		err = sendMonitorNotification(r, d)
+		if err == nil {
+			notified[key] = time.Now()
+		}
+	}
+	if err != nil {
+		d.Result = "failed"
+		d.Error = err.Error()
+		return
+	}
+	d.Result = "done"
+}
+
+// sendMonitorNotification posts d to the rule's webhook, or prints it to stderr.
+func sendMonitorNotification(r *monitor.Rule, d *monitorDecision) error {
+	text := fmt.Sprintf("[meta-ads monitor] %s: %s %s (%s) matched %s", r.Name, d.Level, d.Name, d.ID, monitorConditions(r, d.Metrics))
+	if r.Webhook == "" {
+		fmt.Fprintln(os.Stderr, text)
+		return nil
+	}
+	payload, _ := json.Marshal(map[string]string{"text": text})
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	resp, err := httpClient.Post(r.Webhook, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook: HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// monitorConditions renders the conditions of r with the observed values,
+// e.g. "cpa > 40 (52.10)".
+func monitorConditions(r *monitor.Rule, metrics map[string]float64) string {
+	parts := make([]string, len(r.Conditions()))
+	for i, c := range r.Conditions() {
+		parts[i] = fmt.Sprintf("%s (%.2f)", c, metrics[c.Metric])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// printMonitorDecision prints a matched decision for the terminal.
+func printMonitorDecision(d monitorDecision) {
+	result := d.Result
+	switch d.Result {
+	case "done":
+		result = output.Green(d.Action)
+	case "failed":
+		result = output.Red(d.Action+" failed") + ": " + d.Error
+	default:
+		result = output.Yellow(d.Action + " (" + d.Result + ")")
+	}
+	fmt.Printf("%s  %s  %s %s (%s) → %s\n", time.Now().Format("15:04:05"), d.Rule, d.Level, output.Truncate(d.Name, 40), d.ID, result)
+}
@@ -0,0 +1,413 @@
+package cmd
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/capi"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+// offlineBatchMax is the maximum number of events Meta accepts per upload request.
+const offlineBatchMax = 2000
+
+var (
+	offlineBusiness    string
+	offlineName        string
+	offlineDescription string
+
+	offlineFile      string
+	offlineUploadTag string
+	offlineBatchSize int
+)
+
+var offlineEventsCmd = &cobra.Command{
+	Use:   "offline-events",
+	Short: "Manage offline event sets and upload offline conversions",
+}
+
+var offlineEventsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List offline event sets for an ad account or business",
+	RunE:  runOfflineEventsList,
+}
+
+var offlineEventsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an offline event set in a business",
+	RunE:  runOfflineEventsCreate,
+}
+
+var offlineEventsUploadCmd = &cobra.Command{
+	Use:   "upload <event_set_id>",
+	Short: "Upload offline conversions from a CSV file",
+	Long: `Upload offline conversions from a CSV file to an offline event set.
+
+The CSV must have a header row. Recognized columns:
+  event_name   Purchase, Lead, ... (required)
+  event_time   Unix timestamp, RFC 3339, or "YYYY-MM-DD HH:MM:SS" (required)
+  value, currency, order_id
+  email, phone, fn (first_name), ln (last_name), ct (city), st (state),
+  zip, country, extern_id (external_id), gen, doby, madid, lead_id
+
+Match keys are normalized and SHA-256 hashed locally before upload
+(values that are already SHA-256 digests are sent as-is).
+
+Examples:
+  meta-ads offline-events upload 123456789 --file store_sales.csv
+  meta-ads offline-events upload 123456789 --file store_sales.csv --upload-tag "2026-02 POS export"`,
+	Args: cobra.ExactArgs(1),
+	RunE: runOfflineEventsUpload,
+}
+
+func init() {
+	offlineEventsListCmd.Flags().StringVar(&offlineBusiness, "business", "", "List the sets owned by this business instead of the ad account")
+
+	offlineEventsCreateCmd.Flags().StringVar(&offlineBusiness, "business", "", "Business ID that will own the set (required)")
+	offlineEventsCreateCmd.Flags().StringVar(&offlineName, "name", "", "Event set name (required)")
+	offlineEventsCreateCmd.Flags().StringVar(&offlineDescription, "description", "", "Event set description")
+	_ = offlineEventsCreateCmd.MarkFlagRequired("business")
+	_ = offlineEventsCreateCmd.MarkFlagRequired("name")
+	addFromJSONFlag(offlineEventsCreateCmd, jsonBody{
+		fields:   []string{"name", "description", "auto_assign_to_new_accounts_only", "enable_auto_assign_to_accounts"},
+		required: []string{"name"},
+		flags:    []string{"name", "description"},
+	})
+
+	offlineEventsUploadCmd.Flags().StringVar(&offlineFile, "file", "", "CSV file to upload, or - for stdin (required)")
+	offlineEventsUploadCmd.Flags().StringVar(&offlineUploadTag, "upload-tag", "", "Tag identifying this upload (defaults to file name and date)")
+	offlineEventsUploadCmd.Flags().IntVar(&offlineBatchSize, "batch-size", offlineBatchMax, "Events per request (max 2000)")
+	_ = offlineEventsUploadCmd.MarkFlagRequired("file")
+
+	addCountFlag(offlineEventsListCmd)
+	addPagingFlags(offlineEventsListCmd)
+	addLimitFlag(offlineEventsListCmd, "event sets")
+
+	offlineEventsCmd.AddCommand(offlineEventsListCmd, offlineEventsCreateCmd, offlineEventsUploadCmd)
+	rootCmd.AddCommand(offlineEventsCmd)
+}
+
+func runOfflineEventsList(cmd *cobra.Command, args []string) error {
+	parent := offlineBusiness
+	if parent == "" {
+		account, err := resolveAccount()
+		if err != nil {
+			return err
+		}
+		parent = account
+	}
+
+	params := url.Values{}
+	params.Set("fields", "id,name,description,valid_entries,matched_entries,event_time_min,event_time_max")
+
+	sets, streamed, err := listAll[api.OfflineEventSet](cmd, "/"+parent+"/offline_conversion_data_sets", params, "offline event set", nil)
+	if err != nil || streamed {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, sets)
+	}
+
+	headers := []string{"ID", "NAME", "VALID", "MATCHED", "FIRST EVENT", "LAST EVENT"}
+	rows := make([][]string, len(sets))
+	for i, s := range sets {
+		rows[i] = []string{
+			s.ID,
+			output.Truncate(s.Name, 40),
+			strconv.Itoa(s.ValidEntries),
+			strconv.Itoa(s.MatchedEntries),
+			formatUnixTime(s.EventTimeMin.String()),
+			formatUnixTime(s.EventTimeMax.String()),
+		}
+	}
+	output.PrintTable(headers, rows)
+	return nil
+}
+
+func runOfflineEventsCreate(cmd *cobra.Command, args []string) error {
+	body, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	if body == nil {
+		body = url.Values{}
+		body.Set("name", offlineName)
+		if offlineDescription != "" {
+			body.Set("description", offlineDescription)
+		}
+	}
+
+	resp, err := client.Post("/"+offlineBusiness+"/offline_conversion_data_sets", body)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, result)
+	}
+	fmt.Printf("✓ Offline event set created: %s\n", result.ID)
+	fmt.Println("  Assign it to ad accounts in Business Settings → Data sources → Offline event sets.")
+	return nil
+}
+
+// offlineMatchKeys maps CSV column names to offline match_keys and the capi key
+// whose normalization rules apply.
+var offlineMatchKeys = map[string]struct{ matchKey, capiKey string }{
+	"email":       {"email", "em"},
+	"phone":       {"phone", "ph"},
+	"fn":          {"fn", "fn"},
+	"first_name":  {"fn", "fn"},
+	"ln":          {"ln", "ln"},
+	"last_name":   {"ln", "ln"},
+	"ct":          {"ct", "ct"},
+	"city":        {"ct", "ct"},
+	"st":          {"st", "st"},
+	"state":       {"st", "st"},
+	"zip":         {"zip", "zp"},
+	"country":     {"country", "country"},
+	"extern_id":   {"extern_id", "external_id"},
+	"external_id": {"extern_id", "external_id"},
+	"gen":         {"gen", "gen"},
+	"doby":        {"doby", "doby"},
+}
+
+// offlineEvent is a single event in an offline conversions upload.
+type offlineEvent struct {
+	MatchKeys map[string]any `json:"match_keys"`
+	EventName string         `json:"event_name"`
+	EventTime int64          `json:"event_time"`
+	Value     json.Number    `json:"value,omitempty"`
+	Currency  string         `json:"currency,omitempty"`
+	OrderID   string         `json:"order_id,omitempty"`
+}
+
+// offlineUploadResult summarises an offline conversions upload.
+type offlineUploadResult struct {
+	EventSetID    string   `json:"event_set_id"`
+	UploadTag     string   `json:"upload_tag"`
+	Rows          int      `json:"rows"`
+	Skipped       int      `json:"skipped"`
+	Batches       int      `json:"batches"`
+	NumProcessed  int      `json:"num_processed_entries"`
+	FailedBatches int      `json:"failed_batches"`
+	Errors        []string `json:"errors,omitempty"`
+}
+
+func runOfflineEventsUpload(cmd *cobra.Command, args []string) error {
+	setID := args[0]
+	if offlineBatchSize <= 0 || offlineBatchSize > offlineBatchMax {
+		return usageError("--batch-size must be between 1 and %d", offlineBatchMax)
+	}
+
+	records, err := readCSVFile(offlineFile)
+	if err != nil {
+		return err
+	}
+	if len(records) < 2 {
+		return fmt.Errorf("%s: no data rows", offlineFile)
+	}
+
+	header := records[0]
+	for i := range header {
+		header[i] = strings.ToLower(strings.TrimSpace(header[i]))
+	}
+
+	result := offlineUploadResult{EventSetID: setID, UploadTag: offlineUploadTag}
+	if result.UploadTag == "" {
+		result.UploadTag = fmt.Sprintf("%s %s", offlineFile, time.Now().Format("2006-01-02 15:04"))
+	}
+
+	var events []offlineEvent
+	for n, rec := range records[1:] {
+		line := n + 2
+		result.Rows++
+		ev, err := parseOfflineRow(header, rec)
+		if err != nil {
+			result.Skipped++
+			result.Errors = append(result.Errors, fmt.Sprintf("line %d: %v", line, err))
+			continue
+		}
+		events = append(events, ev)
+	}
+
+	for start := 0; start < len(events); start += offlineBatchSize {
+		end := start + offlineBatchSize
+		if end > len(events) {
+			end = len(events)
+		}
+		result.Batches++
+		progress("Uploading events %d–%d of %d...", start+1, end, len(events))
+
+		data, err := json.Marshal(events[start:end])
+		if err != nil {
+			return fmt.Errorf("encoding events: %w", err)
+		}
+		body := url.Values{}
+		body.Set("upload_tag", result.UploadTag)
+		body.Set("data", string(data))
+
+		resp, err := client.Post("/"+setID+"/events", body)
+		if err != nil {
+			result.FailedBatches++
+			result.Errors = append(result.Errors, fmt.Sprintf("batch %d: %v", result.Batches, err))
+			continue
+		}
+		var r struct {
+			NumProcessedEntries int `json:"num_processed_entries"`
+		}
+		if err := json.Unmarshal(resp, &r); err == nil {
+			result.NumProcessed += r.NumProcessedEntries
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		if err := output.Print(cmd, result); err != nil {
+			return err
+		}
+	} else {
+		fmt.Printf("✓ Uploaded to offline event set %s (tag: %s)\n", setID, result.UploadTag)
+		rows := [][]string{
+			{"Rows", strconv.Itoa(result.Rows)},
+			{"Skipped", strconv.Itoa(result.Skipped)},
+			{"Batches", strconv.Itoa(result.Batches)},
+			{"Failed Batches", strconv.Itoa(result.FailedBatches)},
+			{"Processed", strconv.Itoa(result.NumProcessed)},
+		}
+		output.PrintKeyValue(rows)
+		for _, e := range result.Errors {
+			fmt.Fprintf(os.Stderr, "  %s\n", e)
+		}
+	}
+
+	if result.FailedBatches > 0 {
+		return partialFailure("%d of %d batches failed", result.FailedBatches, result.Batches)
+	}
+	return nil
+}
+
+// parseOfflineRow converts one CSV record into an offline event, hashing match keys.
+func parseOfflineRow(header, rec []string) (offlineEvent, error) {
+	ev := offlineEvent{MatchKeys: map[string]any{}}
+	for i, col := range header {
+		if i >= len(rec) {
+			break
+		}
+		v := strings.TrimSpace(rec[i])
+		if v == "" {
+			continue
+		}
+		switch col {
+		case "event_name":
+			ev.EventName = v
+		case "event_time":
+			t, err := parseEventTime(v)
+			if err != nil {
+				return ev, err
+			}
+			ev.EventTime = t
+		case "value":
+			n, err := capi.ParseNumber(v)
+			if err != nil {
+				return ev, fmt.Errorf("invalid value %q", v)
+			}
+			ev.Value = n
+		case "currency":
+			ev.Currency = strings.ToUpper(v)
+		case "order_id":
+			ev.OrderID = v
+		case "lead_id":
+			// Sent in clear, per the offline match key spec.
+			ev.MatchKeys["lead_id"] = v
+		case "madid":
+			ev.MatchKeys["madid"] = strings.ToLower(v)
+		default:
+			k, ok := offlineMatchKeys[col]
+			if !ok {
+				continue
+			}
+			h := capi.Hash(k.capiKey, v)
+			if h == "" {
+				continue
+			}
+			if k.matchKey == "email" || k.matchKey == "phone" {
+				ev.MatchKeys[k.matchKey] = []string{h}
+			} else {
+				ev.MatchKeys[k.matchKey] = h
+			}
+		}
+	}
+	if ev.EventName == "" {
+		return ev, fmt.Errorf("missing event_name")
+	}
+	if ev.EventTime == 0 {
+		return ev, fmt.Errorf("missing event_time")
+	}
+	if len(ev.MatchKeys) == 0 {
+		return ev, fmt.Errorf("no match keys")
+	}
+	if ev.Value != "" && ev.Currency == "" {
+		return ev, fmt.Errorf("value without currency")
+	}
+	return ev, nil
+}
+
+// parseEventTime accepts a Unix timestamp, RFC 3339, or "YYYY-MM-DD HH:MM:SS" (UTC).
+func parseEventTime(v string) (int64, error) {
+	if n, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return n, nil
+	}
+	for _, layout := range []string{time.RFC3339, "2006-01-02 15:04:05", "2006-01-02T15:04:05", "2006-01-02"} {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t.Unix(), nil
+		}
+	}
+	return 0, fmt.Errorf("invalid event_time %q", v)
+}
+
+// readCSVFile reads all records of a CSV file; "-" reads stdin.
+func readCSVFile(path string) ([][]string, error) {
+	var r io.Reader
+	if path == "-" {
+		r = os.Stdin
+	} else {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, fmt.Errorf("opening %s: %w", path, err)
+		}
+		defer f.Close()
+		r = f
+	}
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	return records, nil
+}
+
+// formatUnixTime renders a Unix timestamp string as "YYYY-MM-DD HH:MM" (UTC).
+func formatUnixTime(ts string) string {
+	n, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil {
+		return "-"
+	}
+	return output.FormatUnixTime(n)
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var pagesBusiness string
+
+const pageFields = "id,name,category,instagram_business_account{id,username}"
+
+var pagesCmd = &cobra.Command{
+	Use:   "pages",
+	Short: "Find the Facebook Pages you can advertise with",
+}
+
+var pagesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List pages you manage and pages owned by your businesses",
+	Long: `List the pages you manage (/me/accounts) and the pages owned by your
+Business Managers, with their connected Instagram account — the IDs needed
+for page_id and instagram_actor_id in creative specs.
+
+Use --business to only look at one business's owned pages; --after,
+--max-pages and --limit page through them.`,
+	Args: cobra.NoArgs,
+	RunE: runPagesList,
+}
+
+func init() {
+	pagesListCmd.Flags().StringVar(&pagesBusiness, "business", "", "Only list pages owned by this business")
+	addCountFlag(pagesListCmd)
+	addPagingFlags(pagesListCmd)
+	addLimitFlag(pagesListCmd, "pages")
+	pagesCmd.AddCommand(pagesListCmd)
+	rootCmd.AddCommand(pagesCmd)
+}
+
+// pagesListingFlags are the listAll flags of pages list, which only page
+// through the one list of --business.
+var pagesListingFlags = []string{"page-size", "after", "max-pages", "limit", "count"}
+
+// listedPage is a page tagged with where it was found.
+type listedPage struct {
+	api.Page
+	Source string `json:"source"`
+}
+
+func runPagesList(cmd *cobra.Command, args []string) error {
+	params := url.Values{}
+	params.Set("fields", pageFields)
+
+	var pages []listedPage
+	seen := map[string]bool{}
+	add := func(path, source string) error {
+		items, err := client.GetAll(path, params)
+		if err != nil {
+			return err
+		}
+		for _, raw := range items {
+			var p api.Page
+			if err := json.Unmarshal(raw, &p); err != nil {
+				return fmt.Errorf("parsing page: %w", err)
+			}
+			if seen[p.ID] {
+				continue
+			}
+			seen[p.ID] = true
+			pages = append(pages, listedPage{Page: p, Source: source})
+		}
+		return nil
+	}
+
+	if pagesBusiness != "" {
+		owned, streamed, err := listAll[api.Page](cmd, "/"+pagesBusiness+"/owned_pages", params, "page", nil)
+		if err != nil {
+			return fmt.Errorf("fetching owned pages: %w", err)
+		}
+		if streamed {
+			return nil
+		}
+		for _, p := range owned {
+			pages = append(pages, listedPage{Page: p, Source: "business " + pagesBusiness})
+		}
+	} else {
+		for _, f := range pagesListingFlags {
+			if cmd.Flags().Changed(f) {
+				return usageError("--%s needs --business: without it, the pages of several lists are merged", f)
+			}
+		}
+		if err := add("/me/accounts", "me"); err != nil {
+			return fmt.Errorf("fetching your pages: %w", err)
+		}
+		// Business pages are a bonus: a missing business_management scope
+		// shouldn't hide the pages found above.
+		var businesses []api.Business
+		if err := getAllInto("/me/businesses", "id,name", &businesses); err != nil {
+			fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("skipping business pages: %v", err)))
+		}
+		for _, b := range businesses {
+			if err := add("/"+b.ID+"/owned_pages", b.Name); err != nil {
+				fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("skipping pages of %s: %v", b.Name, err)))
+			}
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, pages)
+	}
+	if len(pages) == 0 {
+		fmt.Println("No pages found.")
+		return nil
+	}
+
+	headers := []string{"ID", "NAME", "CATEGORY", "INSTAGRAM", "SOURCE"}
+	rows := make([][]string, len(pages))
+	for i, p := range pages {
+		instagram := "-"
+		if p.Instagram != nil {
+			instagram = p.Instagram.ID
+			if p.Instagram.Username != "" {
+				instagram = "@" + p.Instagram.Username + " (" + p.Instagram.ID + ")"
+			}
+		}
+		rows[i] = []string{
+			p.ID,
+			output.Truncate(p.Name, 40),
+			p.Category,
+			instagram,
+			output.Truncate(p.Source, 30),
+		}
+	}
+	output.PrintTable(headers, rows)
+	return nil
+}
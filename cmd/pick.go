@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/url"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+	"github.com/the20100/meta-ads-cli/internal/picker"
+)
+
+// noInteractiveFlag disables the pickers opened for omitted IDs.
+var noInteractiveFlag bool
+
+// namedObject is a campaign, ad set or ad offered for selection.
+type namedObject struct {
+	ID              string `json:"id"`
+	Name            string `json:"name"`
+	EffectiveStatus string `json:"effective_status"`
+}
+
+// listNamedObjects returns the account's objects of kind (campaign, adset or ad).
+func listNamedObjects(account, kind string) ([]namedObject, error) {
+	var objects []namedObject
+	if err := getAllInto("/"+account+"/"+kind+"s", "id,name,effective_status", &objects); err != nil {
+		return nil, err
+	}
+	return objects, nil
+}
+
+// objectArg returns the ID given as the first argument. When it's omitted in
+// an interactive terminal, the user picks one of the account's objects of
+// kind (campaign, adset or ad) instead.
+func objectArg(args []string, kind string) (string, error) {
+	if len(args) > 0 {
+		return args[0], nil
+	}
+	if noInteractiveFlag || !isatty.IsTerminal(os.Stdin.Fd()) || !isatty.IsTerminal(os.Stderr.Fd()) {
+		return "", usageError("missing <%s_id> argument", kind)
+	}
+
+	account, err := resolveAccount()
+	if err != nil {
+		return "", err
+	}
+	objects, err := listNamedObjects(account, kind)
+	if err != nil {
+		return "", err
+	}
+
+	items := make([]picker.Item, len(objects))
+	for i, o := range objects {
+		items[i] = picker.Item{ID: o.ID, Label: fmt.Sprintf("%s  %s  %s", o.Name, o.ID, o.EffectiveStatus)}
+	}
+	it, err := picker.Pick("Select a "+kind, items, os.Stdin, os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	return it.ID, nil
+}
+
+// namePatternFlag maps commands to the flag holding their name pattern:
+// "name", or "match-name" where --name already sets a new name.
+var namePatternFlag = map[*cobra.Command]string{}
+
+// addNameFlags lets cmd select its object by name instead of ID, with a
+// pattern flag and --name-contains, plus --all-matches when it can act on
+// several objects.
+func addNameFlags(cmd *cobra.Command, patternFlag string, multi bool) {
+	namePatternFlag[cmd] = patternFlag
+	cmd.Flags().String(patternFlag, "", "Select by exact name instead of ID; * matches any text (case-insensitive)")
+	cmd.Flags().String("name-contains", "", "Select by part of the name instead of ID (case-insensitive)")
+	if multi {
+		cmd.Flags().Bool("all-matches", false, "Act on every object the name selects instead of failing when several match")
+	}
+}
+
+// objectIDs returns the IDs cmd acts on: the ID argument, the objects its
+// name flags select (see addNameFlags), or one picked interactively (see
+// objectArg). Several objects are only returned with --all-matches.
+func objectIDs(cmd *cobra.Command, args []string, kind string) ([]string, error) {
+	patternFlag := namePatternFlag[cmd]
+	pattern, _ := cmd.Flags().GetString(patternFlag)
+	contains, _ := cmd.Flags().GetString("name-contains")
+	if pattern == "" && contains == "" {
+		id, err := objectArg(args, kind)
+		if err != nil {
+			return nil, err
+		}
+		return []string{id}, nil
+	}
+	if len(args) > 0 {
+		return nil, usageError("pass either a <%s_id> or --%s/--name-contains, not both", kind, patternFlag)
+	}
+	if pattern != "" && contains != "" {
+		return nil, usageError("--%s and --name-contains cannot be used together", patternFlag)
+	}
+
+	selector := pattern
+	match := globPattern(pattern).MatchString
+	if contains != "" {
+		selector = contains
+		lower := strings.ToLower(contains)
+		match = func(name string) bool { return strings.Contains(strings.ToLower(name), lower) }
+	}
+
+	account, err := resolveAccount()
+	if err != nil {
+		return nil, err
+	}
+	objects, err := listNamedObjects(account, kind)
+	if err != nil {
+		return nil, err
+	}
+	var matches []namedObject
+	for _, o := range objects {
+		if match(o.Name) {
+			matches = append(matches, o)
+		}
+	}
+
+	all, _ := cmd.Flags().GetBool("all-matches")
+	switch {
+	case len(matches) == 0:
+		return nil, fmt.Errorf("no %s in %s has a name matching %q", kind, account, selector)
+	case len(matches) > 1 && !all:
+		var b strings.Builder
+		for i, o := range matches {
+			if i == 10 {
+				fmt.Fprintf(&b, "\n  … %d more", len(matches)-10)
+				break
+			}
+			fmt.Fprintf(&b, "\n  %s  %s (%s)", o.ID, o.Name, o.EffectiveStatus)
+		}
+		hint := "narrow down the name or pass the ID"
+		if cmd.Flags().Lookup("all-matches") != nil {
+			hint += ", or pass --all-matches to act on all of them"
+		}
+		return nil, fmt.Errorf("%d %ss match %q — %s:%s", len(matches), kind, selector, hint, b.String())
+	}
+
+	ids := make([]string, len(matches))
+	for i, o := range matches {
+		ids[i] = o.ID
+	}
+	return ids, nil
+}
+
+// globPattern compiles a name pattern where * matches any text and ? any
+// single character, ignoring case.
+func globPattern(pattern string) *regexp.Regexp {
+	quoted := regexp.QuoteMeta(pattern)
+	quoted = strings.NewReplacer(`\*`, ".*", `\?`, ".").Replace(quoted)
+	return regexp.MustCompile("(?is)^" + quoted + "$")
+}
+
+// printResponses prints the API responses of a command run on one or more objects.
+func printResponses(cmd *cobra.Command, responses []json.RawMessage) error {
+	if len(responses) == 1 {
+		return output.Print(cmd, responses[0])
+	}
+	return output.Print(cmd, responses)
+}
+
+// postObjects sends body to each object of ids through the batch API and
+// prints done (a format taking the ID) for each one updated. Failures are
+// reported per object; the returned error counts them.
+func postObjects(cmd *cobra.Command, ids []string, body url.Values, done string) error {
+	items := make([]api.BulkItem, len(ids))
+	for i, id := range ids {
+		items[i] = api.BulkItem{Method: "POST", Path: "/" + id, Params: maps.Clone(body)}
+	}
+	results := client.NewBulkExecutor().Run(items)
+	if len(results) == 1 && results[0].Err != nil {
+		return results[0].Err
+	}
+
+	var responses []json.RawMessage
+	failed := 0
+	for i, r := range results {
+		if r.Err != nil {
+			failed++
+			fmt.Fprintf(os.Stderr, "%s %s: %v\n", output.Red("✗"), ids[i], r.Err)
+			continue
+		}
+		if output.IsTable(cmd) {
+			fmt.Printf("✓ "+done+"\n", ids[i])
+		}
+		responses = append(responses, r.Response)
+	}
+	if !output.IsTable(cmd) && len(responses) > 0 {
+		if err := printResponses(cmd, responses); err != nil {
+			return err
+		}
+	}
+	if failed > 0 {
+		return partialFailure("%d of %d update(s) failed", failed, len(ids))
+	}
+	return nil
+}
@@ -2,14 +2,33 @@ package cmd
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/the20100/meta-ads-cli/internal/api"
 	"github.com/the20100/meta-ads-cli/internal/output"
 )
 
+var (
+	pixelCreateName string
+
+	pixelStatsSince       string
+	pixelStatsUntil       string
+	pixelStatsBy          string
+	pixelStatsGranularity string
+
+	pixelEventsHours int
+
+	pixelShareBusiness string
+	pixelShareOwner    string
+)
+
 var pixelsCmd = &cobra.Command{
 	Use:   "pixels",
 	Short: "Manage Meta pixels",
@@ -21,8 +40,119 @@ var pixelsListCmd = &cobra.Command{
 	RunE:  runPixelsList,
 }
 
+var pixelsGetCmd = &cobra.Command{
+	Use:   "get <pixel_id>",
+	Short: "Get details for a pixel (ownership, matching, cookies, sharing)",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPixelsGet,
+}
+
+var pixelsAEMCmd = &cobra.Command{
+	Use:   "aem <pixel_id>",
+	Short: "List Aggregated Event Measurement web events and priorities per domain",
+	Long: `List the web events configured for Aggregated Event Measurement on a
+pixel, grouped by domain and ordered by priority (1 = highest).
+
+Use it to diagnose "event not configured" errors on iOS-targeted campaigns:
+the optimization event of the ad set must appear in the list for its domain.
+
+Examples:
+  meta-ads pixels aem 123456789
+  meta-ads pixels aem 123456789 --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPixelsAEM,
+}
+
+var pixelsCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a new pixel on an ad account",
+	Long: `Create a pixel on the ad account and print its base code snippet
+together with Conversions API setup hints.
+
+Examples:
+  meta-ads pixels create --account act_123 --name "Shop pixel"
+  meta-ads pixels create --name "Shop pixel" --json | jq -r .id`,
+	RunE: runPixelsCreate,
+}
+
+var pixelsStatsCmd = &cobra.Command{
+	Use:   "stats <pixel_id>",
+	Short: "Show pixel fire counts over time",
+	Long: `Show how many events a pixel received over time, from the pixel /stats edge.
+
+Counts are grouped by --by (event name by default) and summed per day or hour.
+
+Examples:
+  meta-ads pixels stats 123456789 --since 2026-01-01 --until 2026-01-07
+  meta-ads pixels stats 123456789 --since 2026-01-01 --until 2026-01-02 --granularity hour
+  meta-ads pixels stats 123456789 --since 2026-01-01 --until 2026-01-07 --by host`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPixelsStats,
+}
+
+var pixelsEventsCmd = &cobra.Command{
+	Use:   "events <pixel_id>",
+	Short: "Show recently received events, match keys, and diagnostics for a pixel",
+	Long: `Show what a pixel has received recently: event counts with the last time
+each event was seen, which customer information parameters (match keys) arrived
+with them, and the diagnostics warnings Meta reports for the pixel.
+
+Examples:
+  meta-ads pixels events 123456789
+  meta-ads pixels events 123456789 --hours 72`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPixelsEvents,
+}
+
+var pixelsShareCmd = &cobra.Command{
+	Use:   "share <pixel_id>",
+	Short: "Share a pixel with another ad account or business",
+	Long: `Share a pixel with another ad account (--account) or business (--business).
+The target account must be passed explicitly; META_ADS_ACCOUNT is not used here.
+
+The pixel's owning business is looked up automatically; pass --owner-business
+to skip the lookup.
+
+Examples:
+  meta-ads pixels share 123456789 --account act_987654321
+  meta-ads pixels share 123456789 --business 5550001112223
+  meta-ads pixels unshare 123456789 --account act_987654321`,
+	Args: cobra.ExactArgs(1),
+	RunE: runPixelsShare,
+}
+
+var pixelsUnshareCmd = &cobra.Command{
+	Use:   "unshare <pixel_id>",
+	Short: "Stop sharing a pixel with an ad account or business",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runPixelsUnshare,
+}
+
 func init() {
-	pixelsCmd.AddCommand(pixelsListCmd)
+	for _, c := range []*cobra.Command{pixelsShareCmd, pixelsUnshareCmd} {
+		c.Flags().StringVar(&pixelShareBusiness, "business", "", "Target business ID")
+		c.Flags().StringVar(&pixelShareOwner, "owner-business", "", "Business that owns the pixel (looked up if omitted)")
+	}
+
+	pixelsEventsCmd.Flags().IntVar(&pixelEventsHours, "hours", 24, "Look-back window in hours")
+
+	pixelsStatsCmd.Flags().StringVar(&pixelStatsSince, "since", "", "Start date YYYY-MM-DD (required)")
+	pixelsStatsCmd.Flags().StringVar(&pixelStatsUntil, "until", "", "End date YYYY-MM-DD (required)")
+	pixelsStatsCmd.Flags().StringVar(&pixelStatsBy, "by", "event", "Aggregation: event, host, url, browser_type, device_os, device_type, custom_data_field")
+	pixelsStatsCmd.Flags().StringVar(&pixelStatsGranularity, "granularity", "day", "Time bucket: day or hour")
+	_ = pixelsStatsCmd.MarkFlagRequired("since")
+	_ = pixelsStatsCmd.MarkFlagRequired("until")
+
+	addColumnsFlag(pixelsListCmd, pixelColumns)
+	addCountFlag(pixelsListCmd)
+	addPagingFlags(pixelsListCmd)
+	addLimitFlag(pixelsListCmd, "pixels")
+
+	pixelsCreateCmd.Flags().StringVar(&pixelCreateName, "name", "", "Pixel name (required)")
+	_ = pixelsCreateCmd.MarkFlagRequired("name")
+	addFromJSONFlag(pixelsCreateCmd, jsonBody{fields: []string{"name"}, required: []string{"name"}, flags: []string{"name"}})
+
+	pixelsCmd.AddCommand(pixelsListCmd, pixelsGetCmd, pixelsAEMCmd, pixelsCreateCmd, pixelsStatsCmd, pixelsEventsCmd, pixelsShareCmd, pixelsUnshareCmd)
 	rootCmd.AddCommand(pixelsCmd)
 }
 
@@ -32,41 +162,570 @@ func runPixelsList(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	fields := "id,name,last_fired_time,creation_time,is_unavailable"
+	fields, err := pixelColumns.fields("id,name,last_fired_time,creation_time,is_unavailable")
+	if err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("fields", fields)
+
+	pixels, streamed, err := listAll[api.Pixel](cmd, "/"+account+"/adspixels", params, "pixel", nil)
+	if err != nil || streamed {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, pixels)
+	}
+	return pixelColumns.print(pixels)
+}
+
+// pixelColumns are the columns selectable with pixels list --columns.
+var pixelColumns = columnSet[api.Pixel]{
+	columns: []tableColumn[api.Pixel]{
+		{name: "id", header: "ID", value: func(p api.Pixel) string { return p.ID }},
+		{name: "name", header: "NAME", value: func(p api.Pixel) string { return output.Truncate(p.Name, 40) }},
+		{name: "last_fired_time", header: "LAST FIRED", value: func(p api.Pixel) string { return output.FormatTime(p.LastFiredTime) }},
+		{name: "creation_time", header: "CREATED", value: func(p api.Pixel) string { return output.FormatTime(p.CreationTime) }},
+		{name: "is_unavailable", header: "UNAVAILABLE", value: func(p api.Pixel) string {
+			if p.IsUnavailable {
+				return "yes"
+			}
+			return "no"
+		}},
+	},
+	defaults: []string{"id", "name", "last_fired_time", "creation_time", "is_unavailable"},
+}
+
+func runPixelsGet(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	fields := "id,name,creation_time,last_fired_time,is_unavailable,owner_business{id,name},owner_ad_account{id,name},data_use_setting,enable_automatic_matching,automatic_matching_fields,first_party_cookie_status"
 	params := url.Values{}
 	params.Set("fields", fields)
 
-	items, err := client.GetAll("/"+account+"/adspixels", params)
+	body, err := client.Get("/"+id, params)
 	if err != nil {
 		return err
 	}
 
-	pixels := make([]api.Pixel, 0, len(items))
+	var p api.Pixel
+	if err := json.Unmarshal(body, &p); err != nil {
+		return fmt.Errorf("parsing pixel: %w", err)
+	}
+
+	// Sharing edges require the owning business; skip them when it is unknown
+	// or the token lacks access.
+	if p.OwnerBusiness != nil && p.OwnerBusiness.ID != "" {
+		shareParams := url.Values{}
+		shareParams.Set("business", p.OwnerBusiness.ID)
+		shareParams.Set("fields", "id,name")
+		if items, err := client.GetAll("/"+id+"/shared_accounts", shareParams); err == nil {
+			for _, raw := range items {
+				var a api.Account
+				if json.Unmarshal(raw, &a) == nil {
+					p.SharedAccounts = append(p.SharedAccounts, a)
+				}
+			}
+		}
+		if items, err := client.GetAll("/"+id+"/shared_agencies", shareParams); err == nil {
+			for _, raw := range items {
+				var b api.Business
+				if json.Unmarshal(raw, &b) == nil {
+					p.SharedAgencies = append(p.SharedAgencies, b)
+				}
+			}
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, p)
+	}
+
+	owner := ""
+	if p.OwnerBusiness != nil {
+		owner = fmt.Sprintf("%s (%s)", p.OwnerBusiness.Name, p.OwnerBusiness.ID)
+	}
+	ownerAccount := ""
+	if p.OwnerAdAccount != nil {
+		ownerAccount = fmt.Sprintf("%s (%s)", p.OwnerAdAccount.Name, p.OwnerAdAccount.ID)
+	}
+	autoMatching := "off"
+	if p.EnableAutomaticMatching {
+		autoMatching = "on"
+		if len(p.AutomaticMatchingFields) > 0 {
+			autoMatching += " (" + strings.Join(p.AutomaticMatchingFields, ", ") + ")"
+		}
+	}
+	unavailable := "no"
+	if p.IsUnavailable {
+		unavailable = "yes"
+	}
+
+	rows := [][]string{
+		{"ID", p.ID},
+		{"Name", p.Name},
+		{"Owner Business", owner},
+		{"Owner Ad Account", ownerAccount},
+		{"Created", output.FormatTime(p.CreationTime)},
+		{"Last Fired", output.FormatTime(p.LastFiredTime)},
+		{"Unavailable", unavailable},
+		{"Data Use Setting", p.DataUseSetting},
+		{"Automatic Matching", autoMatching},
+		{"First-Party Cookies", p.FirstPartyCookieStatus},
+	}
+	output.PrintKeyValue(rows)
+
+	if len(p.SharedAccounts) > 0 || len(p.SharedAgencies) > 0 {
+		fmt.Println()
+		fmt.Println("SHARED WITH")
+		fmt.Println(strings.Repeat("─", 60))
+		for _, a := range p.SharedAccounts {
+			fmt.Printf("  ad account  %s (%s)\n", a.Name, a.ID)
+		}
+		for _, b := range p.SharedAgencies {
+			fmt.Printf("  business    %s (%s)\n", b.Name, b.ID)
+		}
+	}
+	return nil
+}
+
+func runPixelsCreate(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+
+	body, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	if body == nil {
+		body = url.Values{}
+		body.Set("name", pixelCreateName)
+	}
+
+	resp, err := client.Post("/"+account+"/adspixels", body)
+	if err != nil {
+		return err
+	}
+
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, map[string]string{
+			"id":        result.ID,
+			"name":      body.Get("name"),
+			"base_code": pixelBaseCode(result.ID),
+		})
+	}
+
+	fmt.Printf("✓ Pixel created: %s\n", result.ID)
+	fmt.Println()
+	fmt.Println("BASE CODE (paste into the <head> of every page)")
+	fmt.Println(strings.Repeat("─", 60))
+	fmt.Println(pixelBaseCode(result.ID))
+	fmt.Println()
+	fmt.Println("CONVERSIONS API")
+	fmt.Println(strings.Repeat("─", 60))
+	fmt.Printf("  Endpoint:   POST %s\n", client.URL("/"+result.ID+"/events"))
+	fmt.Println("  Token:      generate a system user token with ads_management in Events Manager")
+	fmt.Println("  Dedupe:     send the same event_id from the browser (fbq eventID) and the server")
+	fmt.Println("  User data:  hash em/ph/fn/ln with SHA-256 after lowercasing and trimming")
+	return nil
+}
+
+// pixelBaseCode returns the standard Meta Pixel base code for a pixel ID.
+func pixelBaseCode(pixelID string) string {
+	return `<!-- Meta Pixel Code -->
+<script>
+!function(f,b,e,v,n,t,s)
+{if(f.fbq)return;n=f.fbq=function(){n.callMethod?
+n.callMethod.apply(n,arguments):n.queue.push(arguments)};
+if(!f._fbq)f._fbq=n;n.push=n;n.loaded=!0;n.version='2.0';
+n.queue=[];t=b.createElement(e);t.async=!0;
+t.src=v;s=b.getElementsByTagName(e)[0];
+s.parentNode.insertBefore(t,s)}(window, document,'script',
+'https://connect.facebook.net/en_US/fbevents.js');
+fbq('init', '` + pixelID + `');
+fbq('track', 'PageView');
+</script>
+<noscript><img height="1" width="1" style="display:none"
+src="https://www.facebook.com/tr?id=` + pixelID + `&ev=PageView&noscript=1"
+/></noscript>
+<!-- End Meta Pixel Code -->`
+}
+
+// pixelStatRow is one (time bucket, value) count in the pixels stats output.
+type pixelStatRow struct {
+	Time  string `json:"time"`
+	Value string `json:"value"`
+	Count int    `json:"count"`
+}
+
+func runPixelsStats(cmd *cobra.Command, args []string) error {
+	pixelID := args[0]
+
+	var bucketLen int
+	switch pixelStatsGranularity {
+	case "day":
+		bucketLen = len("2006-01-02")
+	case "hour":
+		bucketLen = len("2006-01-02T15")
+	default:
+		return usageError("invalid --granularity %q — use day or hour", pixelStatsGranularity)
+	}
+
+	since, err := time.Parse("2006-01-02", pixelStatsSince)
+	if err != nil {
+		return usageError("invalid --since date: %w", err)
+	}
+	until, err := time.Parse("2006-01-02", pixelStatsUntil)
+	if err != nil {
+		return usageError("invalid --until date: %w", err)
+	}
+
+	// Include the whole --until day.
+	buckets, err := fetchPixelStats(pixelID, pixelStatsBy, since, until.AddDate(0, 0, 1))
+	if err != nil {
+		return err
+	}
+
+	totals := map[[2]string]int{}
+	for _, b := range buckets {
+		bucket := b.StartTime
+		if len(bucket) > bucketLen {
+			bucket = bucket[:bucketLen]
+		}
+		for _, d := range b.Data {
+			totals[[2]string{bucket, d.Value}] += d.Count
+		}
+	}
+
+	stats := make([]pixelStatRow, 0, len(totals))
+	for k, count := range totals {
+		stats = append(stats, pixelStatRow{Time: k[0], Value: k[1], Count: count})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Time != stats[j].Time {
+			return stats[i].Time < stats[j].Time
+		}
+		return stats[i].Value < stats[j].Value
+	})
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, stats)
+	}
+
+	if len(stats) == 0 {
+		fmt.Println("No pixel activity found for the specified period.")
+		return nil
+	}
+
+	headers := []string{"TIME", strings.ToUpper(pixelStatsBy), "COUNT"}
+	rows := make([][]string, len(stats))
+	for i, st := range stats {
+		rows[i] = []string{
+			strings.Replace(st.Time, "T", " ", 1),
+			output.Truncate(st.Value, 60),
+			fmt.Sprintf("%d", st.Count),
+		}
+	}
+	output.PrintTable(headers, rows)
+	return nil
+}
+
+// fetchPixelStats returns the /stats buckets of a pixel for [start, end).
+func fetchPixelStats(pixelID, aggregation string, start, end time.Time) ([]api.PixelStatsBucket, error) {
+	params := url.Values{}
+	params.Set("aggregation", aggregation)
+	params.Set("start_time", fmt.Sprintf("%d", start.Unix()))
+	params.Set("end_time", fmt.Sprintf("%d", end.Unix()))
+
+	items, err := client.GetAll("/"+pixelID+"/stats", params)
+	if err != nil {
+		return nil, err
+	}
+
+	buckets := make([]api.PixelStatsBucket, 0, len(items))
+	for _, raw := range items {
+		var b api.PixelStatsBucket
+		if err := json.Unmarshal(raw, &b); err != nil {
+			return nil, fmt.Errorf("parsing pixel stats: %w", err)
+		}
+		buckets = append(buckets, b)
+	}
+	return buckets, nil
+}
+
+// pixelEventSummary aggregates one event (or match key) over the look-back window.
+type pixelEventSummary struct {
+	Name     string `json:"name"`
+	Count    int    `json:"count"`
+	LastSeen string `json:"last_seen,omitempty"`
+}
+
+// pixelEventsReport is the JSON shape of pixels events.
+type pixelEventsReport struct {
+	PixelID     string              `json:"pixel_id"`
+	Hours       int                 `json:"hours"`
+	Events      []pixelEventSummary `json:"events"`
+	MatchKeys   []pixelEventSummary `json:"match_keys"`
+	Diagnostics []api.DACheck       `json:"diagnostics"`
+}
+
+func runPixelsEvents(cmd *cobra.Command, args []string) error {
+	pixelID := args[0]
+	end := time.Now()
+	start := end.Add(-time.Duration(pixelEventsHours) * time.Hour)
+
+	eventBuckets, err := fetchPixelStats(pixelID, "event", start, end)
+	if err != nil {
+		return err
+	}
+	report := pixelEventsReport{
+		PixelID: pixelID,
+		Hours:   pixelEventsHours,
+		Events:  summarizePixelBuckets(eventBuckets),
+	}
+
+	// Match keys and diagnostics are not available for every pixel; warn and continue.
+	if keyBuckets, err := fetchPixelStats(pixelID, "match_keys", start, end); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: could not fetch match keys: %v\n", err)
+	} else {
+		report.MatchKeys = summarizePixelBuckets(keyBuckets)
+	}
+
+	params := url.Values{}
+	params.Set("fields", "key,title,description,result,user_message,action_uri")
+	if items, err := client.GetAll("/"+pixelID+"/da_checks", params); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: could not fetch diagnostics: %v\n", err)
+	} else {
+		for _, raw := range items {
+			var c api.DACheck
+			if err := json.Unmarshal(raw, &c); err != nil {
+				return fmt.Errorf("parsing diagnostic: %w", err)
+			}
+			report.Diagnostics = append(report.Diagnostics, c)
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, report)
+	}
+
+	fmt.Printf("RECEIVED EVENTS (last %dh)\n", pixelEventsHours)
+	fmt.Println(strings.Repeat("─", 60))
+	if len(report.Events) == 0 {
+		fmt.Println("  No events received — check that the pixel is installed and firing.")
+	} else {
+		rows := make([][]string, len(report.Events))
+		for i, e := range report.Events {
+			rows[i] = []string{e.Name, fmt.Sprintf("%d", e.Count), output.FormatTime(e.LastSeen)}
+		}
+		output.PrintTable([]string{"EVENT", "COUNT", "LAST SEEN"}, rows)
+	}
+
+	if len(report.MatchKeys) > 0 {
+		fmt.Println()
+		fmt.Println("MATCH KEYS (customer information parameters received)")
+		fmt.Println(strings.Repeat("─", 60))
+		rows := make([][]string, len(report.MatchKeys))
+		for i, k := range report.MatchKeys {
+			rows[i] = []string{k.Name, fmt.Sprintf("%d", k.Count)}
+		}
+		output.PrintTable([]string{"KEY", "COUNT"}, rows)
+	}
+
+	if len(report.Diagnostics) > 0 {
+		fmt.Println()
+		fmt.Println("DIAGNOSTICS")
+		fmt.Println(strings.Repeat("─", 60))
+		rows := make([][]string, len(report.Diagnostics))
+		for i, c := range report.Diagnostics {
+			msg := c.UserMessage
+			if msg == "" {
+				msg = c.Description
+			}
+			rows[i] = []string{c.Result, output.Truncate(c.Title, 40), output.Truncate(msg, 70)}
+		}
+		output.PrintTable([]string{"RESULT", "CHECK", "MESSAGE"}, rows)
+	}
+	return nil
+}
+
+// summarizePixelBuckets totals counts per value across buckets, most frequent first.
+func summarizePixelBuckets(buckets []api.PixelStatsBucket) []pixelEventSummary {
+	byName := map[string]*pixelEventSummary{}
+	for _, b := range buckets {
+		for _, d := range b.Data {
+			e, ok := byName[d.Value]
+			if !ok {
+				e = &pixelEventSummary{Name: d.Value}
+				byName[d.Value] = e
+			}
+			e.Count += d.Count
+			if d.Count > 0 && b.StartTime > e.LastSeen {
+				e.LastSeen = b.StartTime
+			}
+		}
+	}
+	summaries := make([]pixelEventSummary, 0, len(byName))
+	for _, e := range byName {
+		summaries = append(summaries, *e)
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Count != summaries[j].Count {
+			return summaries[i].Count > summaries[j].Count
+		}
+		return summaries[i].Name < summaries[j].Name
+	})
+	return summaries
+}
+
+// pixelShareTarget validates the share/unshare flags and returns the edge and
+// parameters identifying the target account or business.
+func pixelShareTarget(cmd *cobra.Command, pixelID string) (string, url.Values, error) {
+	hasAccount := cmd.Flags().Changed("account")
+	if hasAccount == (pixelShareBusiness != "") {
+		return "", nil, usageError("specify exactly one of --account or --business")
+	}
+
+	owner := pixelShareOwner
+	if owner == "" {
+		params := url.Values{}
+		params.Set("fields", "owner_business{id}")
+		body, err := client.Get("/"+pixelID, params)
+		if err != nil {
+			return "", nil, fmt.Errorf("looking up pixel owner: %w", err)
+		}
+		var p struct {
+			OwnerBusiness *struct {
+				ID string `json:"id"`
+			} `json:"owner_business"`
+		}
+		if err := json.Unmarshal(body, &p); err != nil {
+			return "", nil, fmt.Errorf("parsing pixel: %w", err)
+		}
+		if p.OwnerBusiness == nil || p.OwnerBusiness.ID == "" {
+			return "", nil, fmt.Errorf("pixel %s has no owner business — pass --owner-business", pixelID)
+		}
+		owner = p.OwnerBusiness.ID
+	}
+
+	params := url.Values{}
+	params.Set("business", owner)
+	if hasAccount {
+		params.Set("account_id", api.StripActPrefix(accountID(accountFlag)))
+		return "shared_accounts", params, nil
+	}
+	params.Set("agency_id", pixelShareBusiness)
+	return "shared_agencies", params, nil
+}
+
+// pixelShareLabel describes the share target for confirmation messages.
+func pixelShareLabel(cmd *cobra.Command) string {
+	if cmd.Flags().Changed("account") {
+		return "ad account " + accountID(accountFlag)
+	}
+	return "business " + pixelShareBusiness
+}
+
+func runPixelsShare(cmd *cobra.Command, args []string) error {
+	pixelID := args[0]
+	edge, params, err := pixelShareTarget(cmd, pixelID)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Post("/"+pixelID+"/"+edge, params)
+	if err != nil {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, json.RawMessage(resp))
+	}
+	fmt.Printf("✓ Pixel %s shared with %s\n", pixelID, pixelShareLabel(cmd))
+	return nil
+}
+
+func runPixelsUnshare(cmd *cobra.Command, args []string) error {
+	pixelID := args[0]
+	edge, params, err := pixelShareTarget(cmd, pixelID)
+	if err != nil {
+		return err
+	}
+
+	if err := confirm(fmt.Sprintf("Stop sharing pixel %s with %s", objectLabel(pixelID), pixelShareLabel(cmd))); err != nil {
+		return err
+	}
+
+	resp, err := client.Delete("/"+pixelID+"/"+edge, params)
+	if err != nil {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, json.RawMessage(resp))
+	}
+	fmt.Printf("✓ Pixel %s no longer shared with %s\n", pixelID, pixelShareLabel(cmd))
+	return nil
+}
+
+func runPixelsAEM(cmd *cobra.Command, args []string) error {
+	pixelID := args[0]
+
+	items, err := client.GetAll("/"+pixelID+"/aem_conversion_configs", nil)
+	if err != nil {
+		var metaErr *api.MetaError
+		if errors.As(err, &metaErr) && metaErr.Code == 100 {
+			return fmt.Errorf("%w\nAEM configuration is not available for this pixel — it may not have a verified domain, or AEM is managed in Events Manager", err)
+		}
+		return err
+	}
+
+	configs := make([]api.AEMEventConfig, 0, len(items))
 	for _, raw := range items {
-		var p api.Pixel
-		if err := json.Unmarshal(raw, &p); err != nil {
-			return fmt.Errorf("parsing pixel: %w", err)
+		var c api.AEMEventConfig
+		if err := json.Unmarshal(raw, &c); err != nil {
+			return fmt.Errorf("parsing AEM configuration: %w", err)
 		}
-		pixels = append(pixels, p)
+		configs = append(configs, c)
+	}
+	sort.SliceStable(configs, func(i, j int) bool {
+		if configs[i].Domain != configs[j].Domain {
+			return configs[i].Domain < configs[j].Domain
+		}
+		return configs[i].Priority < configs[j].Priority
+	})
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, configs)
 	}
 
-	if output.IsJSON(cmd) {
-		return output.PrintJSON(pixels, prettyFlag)
+	if len(configs) == 0 {
+		fmt.Println("No AEM web events configured for this pixel.")
+		return nil
 	}
 
-	headers := []string{"ID", "NAME", "LAST FIRED", "CREATED", "UNAVAILABLE"}
-	rows := make([][]string, len(pixels))
-	for i, p := range pixels {
-		unavailable := "no"
-		if p.IsUnavailable {
-			unavailable = "yes"
+	headers := []string{"DOMAIN", "PRIORITY", "EVENT", "CUSTOM CONVERSION", "VALUE OPTIMIZATION"}
+	rows := make([][]string, len(configs))
+	for i, c := range configs {
+		valueOpt := "no"
+		if c.ValueOptimization {
+			valueOpt = "yes"
 		}
 		rows[i] = []string{
-			p.ID,
-			output.Truncate(p.Name, 40),
-			output.FormatTime(p.LastFiredTime),
-			output.FormatTime(p.CreationTime),
-			unavailable,
+			c.Domain,
+			fmt.Sprintf("%d", c.Priority),
+			c.EventName,
+			c.CustomConversionID,
+			valueOpt,
 		}
 	}
 	output.PrintTable(headers, rows)
@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	placementPlatforms     []string
+	placementPositions     []string
+	placementDevices       []string
+	placementAdvantagePlus bool
+)
+
+// placementPlatform is a publisher platform with the targeting key and
+// values of its positions.
+type placementPlatform struct {
+	Platform  string
+	Key       string
+	Positions []string
+}
+
+var placementPlatformPositions = []placementPlatform{
+	{"facebook", "facebook_positions", []string{"feed", "right_hand_column", "marketplace", "video_feeds", "story", "search", "instream_video", "facebook_reels", "facebook_reels_overlay", "profile_feed", "notification"}},
+	{"instagram", "instagram_positions", []string{"stream", "story", "explore", "explore_home", "reels", "profile_feed", "ig_search", "profile_reels"}},
+	{"audience_network", "audience_network_positions", []string{"classic", "rewarded_video"}},
+	{"messenger", "messenger_positions", []string{"messenger_home", "sponsored_messages", "story"}},
+	{"threads", "threads_positions", []string{"threads_stream"}},
+}
+
+// placementAliases maps cross-platform position names to the positions they
+// stand for on each platform.
+var placementAliases = map[string]map[string]string{
+	"feed":   {"facebook": "feed", "instagram": "stream", "threads": "threads_stream"},
+	"story":  {"facebook": "story", "instagram": "story", "messenger": "story"},
+	"reels":  {"facebook": "facebook_reels", "instagram": "reels"},
+	"search": {"facebook": "search", "instagram": "ig_search"},
+	"inbox":  {"messenger": "messenger_home"},
+}
+
+var adsetsSetPlacementsCmd = &cobra.Command{
+	Use:   "set-placements [adset_id]",
+	Short: "Choose the platforms and positions an ad set delivers on",
+	Long: `Set manual placements of an ad set, or go back to Advantage+ (automatic)
+placements with --advantage-plus.
+
+--positions takes cross-platform names, applied to every chosen platform that
+has them: feed, story, reels, search, inbox; or Meta's names of one platform,
+optionally prefixed (instagram:explore, facebook:marketplace). Without
+--positions, the ad set delivers on every position of the platforms.
+
+The rest of the targeting is kept.
+
+Examples:
+  meta-ads adsets set-placements 120210000000001 --platforms facebook,instagram --positions feed,story
+  meta-ads adsets set-placements 120210000000001 --platforms instagram --positions reels,instagram:explore
+  meta-ads adsets set-placements 120210000000001 --advantage-plus`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runAdsetsSetPlacements,
+}
+
+func init() {
+	adsetsSetPlacementsCmd.Flags().StringSliceVar(&placementPlatforms, "platforms", nil, "Publisher platforms: facebook, instagram, audience_network, messenger, threads")
+	adsetsSetPlacementsCmd.Flags().StringSliceVar(&placementPositions, "positions", nil, "Positions, e.g. feed,story,reels or instagram:explore (default all)")
+	adsetsSetPlacementsCmd.Flags().StringSliceVar(&placementDevices, "devices", nil, "Device platforms: mobile, desktop (default both)")
+	adsetsSetPlacementsCmd.Flags().BoolVar(&placementAdvantagePlus, "advantage-plus", false, "Reset to Advantage+ (automatic) placements")
+	adsetsSetPlacementsCmd.MarkFlagsMutuallyExclusive("advantage-plus", "platforms")
+	adsetsSetPlacementsCmd.MarkFlagsMutuallyExclusive("advantage-plus", "positions")
+	adsetsSetPlacementsCmd.MarkFlagsMutuallyExclusive("advantage-plus", "devices")
+	_ = adsetsSetPlacementsCmd.RegisterFlagCompletionFunc("platforms", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		var platforms []string
+		for _, p := range placementPlatformPositions {
+			platforms = append(platforms, p.Platform)
+		}
+		return platforms, cobra.ShellCompDirectiveNoFileComp
+	})
+	addFromJSONFlag(adsetsSetPlacementsCmd, jsonBody{
+		fields:   placementKeys(),
+		required: []string{"publisher_platforms"},
+		flags:    []string{"platforms", "positions", "devices", "advantage-plus"},
+	})
+	adsetsSetPlacementsCmd.ValidArgsFunction = completeObjects("adset")
+	addNameFlags(adsetsSetPlacementsCmd, "name", true)
+	adsetsCmd.AddCommand(adsetsSetPlacementsCmd)
+}
+
+// placementKeys are the targeting keys Advantage+ placements leave unset.
+func placementKeys() []string {
+	keys := []string{"publisher_platforms", "device_platforms"}
+	for _, p := range placementPlatformPositions {
+		keys = append(keys, p.Key)
+	}
+	return keys
+}
+
+// placementTargeting returns the targeting keys selecting platforms,
+// positions and devices.
+func placementTargeting(platforms, positions, devices []string) (map[string][]string, error) {
+	if len(platforms) == 0 {
+		return nil, fmt.Errorf("give --platforms, or --advantage-plus for automatic placements")
+	}
+	t := map[string][]string{}
+	for _, name := range platforms {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !slices.ContainsFunc(placementPlatformPositions, func(p placementPlatform) bool { return p.Platform == name }) {
+			return nil, usageError("unknown platform %q: use facebook, instagram, audience_network, messenger or threads", name)
+		}
+		if !slices.Contains(t["publisher_platforms"], name) {
+			t["publisher_platforms"] = append(t["publisher_platforms"], name)
+		}
+	}
+
+	for _, pos := range positions {
+		pos = strings.ToLower(strings.TrimSpace(pos))
+		platform, position, qualified := strings.Cut(pos, ":")
+		if !qualified {
+			platform, position = "", pos
+		}
+		matched := false
+		for _, p := range placementPlatformPositions {
+			if !slices.Contains(t["publisher_platforms"], p.Platform) || (platform != "" && platform != p.Platform) {
+				continue
+			}
+			value := position
+			if alias, ok := placementAliases[position]; ok && platform == "" {
+				if value, ok = alias[p.Platform]; !ok {
+					continue
+				}
+			}
+			if !slices.Contains(p.Positions, value) {
+				continue
+			}
+			if !slices.Contains(t[p.Key], value) {
+				t[p.Key] = append(t[p.Key], value)
+			}
+			matched = true
+		}
+		if !matched {
+			return nil, fmt.Errorf("position %q isn't available on %s", pos, strings.Join(t["publisher_platforms"], ", "))
+		}
+	}
+	// A platform without any of the chosen positions can't deliver.
+	if len(positions) > 0 {
+		for _, p := range placementPlatformPositions {
+			if slices.Contains(t["publisher_platforms"], p.Platform) && len(t[p.Key]) == 0 {
+				return nil, fmt.Errorf("none of --positions is available on %s — drop it from --platforms or add one of: %s",
+					p.Platform, strings.Join(p.Positions, ", "))
+			}
+		}
+	}
+
+	for _, d := range devices {
+		d = strings.ToLower(strings.TrimSpace(d))
+		if d != "mobile" && d != "desktop" {
+			return nil, usageError("unknown device %q: use mobile or desktop", d)
+		}
+		t["device_platforms"] = append(t["device_platforms"], d)
+	}
+	return t, nil
+}
+
+// placementLines describes the placements of targeting, one line per platform.
+func placementLines(targeting map[string]json.RawMessage) []string {
+	var platforms []string
+	if json.Unmarshal(targeting["publisher_platforms"], &platforms) != nil || len(platforms) == 0 {
+		return []string{"Advantage+ placements (automatic)"}
+	}
+	var lines []string
+	for _, p := range placementPlatformPositions {
+		if !slices.Contains(platforms, p.Platform) {
+			continue
+		}
+		var positions []string
+		_ = json.Unmarshal(targeting[p.Key], &positions)
+		desc := "all positions"
+		if len(positions) > 0 {
+			desc = strings.Join(positions, ", ")
+		}
+		lines = append(lines, fmt.Sprintf("%-17s %s", p.Platform+":", desc))
+	}
+	var devices []string
+	if json.Unmarshal(targeting["device_platforms"], &devices) == nil && len(devices) > 0 {
+		lines = append(lines, fmt.Sprintf("%-17s %s", "devices:", strings.Join(devices, ", ")))
+	}
+	return lines
+}
+
+func runAdsetsSetPlacements(cmd *cobra.Command, args []string) error {
+	body, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	if body == nil && !placementAdvantagePlus && len(placementPlatforms) == 0 {
+		return fmt.Errorf("give --platforms (and optionally --positions), --advantage-plus or --from-json")
+	}
+	var placements map[string][]string
+	switch {
+	case body != nil:
+		// The targeting keys as Meta names them, e.g.
+		// {"publisher_platforms": ["instagram"], "instagram_positions": ["reels"]}.
+		placements = map[string][]string{}
+		for k := range body {
+			var values []string
+			if err := json.Unmarshal([]byte(body.Get(k)), &values); err != nil {
+				return usageError("--from-json: %s must be an array of strings", k)
+			}
+			placements[k] = values
+		}
+	case !placementAdvantagePlus:
+		if placements, err = placementTargeting(placementPlatforms, placementPositions, placementDevices); err != nil {
+			return err
+		}
+	}
+	ids, err := objectIDs(cmd, args, "adset")
+	if err != nil {
+		return err
+	}
+
+	var responses []json.RawMessage
+	for _, id := range ids {
+		// Targeting is replaced as a whole, so start from the current one.
+		var cur struct {
+			Targeting map[string]json.RawMessage `json:"targeting"`
+		}
+		if err := getObject(id, "targeting", &cur); err != nil {
+			return err
+		}
+		targeting := cur.Targeting
+		if targeting == nil {
+			targeting = map[string]json.RawMessage{}
+		}
+		for _, k := range placementKeys() {
+			delete(targeting, k)
+		}
+		for k, v := range placements {
+			targeting[k], _ = json.Marshal(v)
+		}
+		data, err := json.Marshal(targeting)
+		if err != nil {
+			return err
+		}
+		body := url.Values{}
+		body.Set("targeting", string(data))
+		resp, err := client.Post("/"+id, body)
+		if err != nil {
+			return err
+		}
+		if output.IsTable(cmd) {
+			fmt.Printf("✓ Ad set %s placements updated\n", id)
+			for _, line := range placementLines(targeting) {
+				fmt.Printf("  %s\n", line)
+			}
+		}
+		responses = append(responses, resp)
+	}
+
+	if !output.IsTable(cmd) {
+		return printResponses(cmd, responses)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+	"github.com/the20100/meta-ads-cli/internal/spec"
+)
+
+var planFile string
+
+var planCmd = &cobra.Command{
+	Use:   "plan -f <file>",
+	Short: "Show what apply would change, without changing anything",
+	Long: `Compare a YAML spec (see: meta-ads apply --help) with the current state of
+the objects it references and print a field-level diff:
+
+  +  the object will be created
+  ~  the object will be updated (changed fields are listed)
+  =  the object already matches the spec
+
+Objects such as targeting are compared as a subset: keys the spec doesn't set
+are ignored. Nothing is created or modified.`,
+	Args: cobra.NoArgs,
+	RunE: runPlan,
+}
+
+func init() {
+	planCmd.Flags().StringVarP(&planFile, "file", "f", "", "Spec file (YAML), or - for stdin (required)")
+	_ = planCmd.MarkFlagRequired("file")
+	rootCmd.AddCommand(planCmd)
+}
+
+// Plan actions.
+const (
+	planCreate = "create"
+	planUpdate = "update"
+	planNoop   = "no-op"
+)
+
+// planStep is what apply would do to one object.
+type planStep struct {
+	Kind    string        `json:"kind"`
+	Name    string        `json:"name"`
+	ID      string        `json:"id,omitempty"`
+	Action  string        `json:"action"`
+	Changes []spec.Change `json:"changes,omitempty"`
+
+	depth int
+}
+
+func runPlan(cmd *cobra.Command, args []string) error {
+	s, err := spec.Load(planFile)
+	if err != nil {
+		return err
+	}
+
+	var steps []planStep
+	for _, c := range s.Campaigns {
+		step, err := planObject("campaign", c.Name, c.ID, campaignApplyFields(c), 0)
+		if err != nil {
+			return err
+		}
+		steps = append(steps, step)
+
+		for _, as := range c.AdSets {
+			step, err := planObject("adset", as.Name, as.ID, adsetApplyFields(as, c.ID), 1)
+			if err != nil {
+				return err
+			}
+			steps = append(steps, step)
+
+			for _, ad := range as.Ads {
+				if cr := ad.Creative; cr != nil && cr.ID == "" {
+					step, _ := planObject("creative", cr.Name, "", cr.Fields(), 2)
+					steps = append(steps, step)
+				}
+				step, err := planObject("ad", ad.Name, ad.ID, adApplyFields(ad, as.ID), 2)
+				if err != nil {
+					return err
+				}
+				if cr := ad.Creative; ad.ID != "" && cr != nil && cr.ID == "" {
+					step.Action = planUpdate
+					step.Changes = append(step.Changes, spec.Change{Field: "creative", To: "(new creative)"})
+				}
+				steps = append(steps, step)
+			}
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, steps)
+	}
+
+	counts := map[string]int{}
+	for _, st := range steps {
+		counts[st.Action]++
+		indent := strings.Repeat("    ", st.depth)
+		label := fmt.Sprintf("%s %q", st.Kind, st.Name)
+		if st.ID != "" {
+			label += " (" + st.ID + ")"
+		}
+		switch st.Action {
+		case planCreate:
+			fmt.Printf("%s%s %s\n", indent, output.Green("+"), label)
+		case planUpdate:
+			fmt.Printf("%s%s %s\n", indent, output.Yellow("~"), label)
+		default:
+			fmt.Printf("%s= %s\n", indent, label)
+		}
+		for _, ch := range st.Changes {
+			if st.Action == planCreate {
+				fmt.Printf("%s      %s: %s\n", indent, ch.Field, ch.To)
+			} else {
+				fmt.Printf("%s      %s: %s → %s\n", indent, ch.Field, orDash(ch.From), ch.To)
+			}
+		}
+	}
+	fmt.Printf("\nPlan: %d to create, %d to update, %d unchanged.\n", counts[planCreate], counts[planUpdate], counts[planNoop])
+	return nil
+}
+
+// planObject diffs fields against the remote object id, or lists them as a
+// creation when id is empty.
+func planObject(kind, name, id string, fields map[string]any, depth int) (planStep, error) {
+	step := planStep{Kind: kind, Name: name, ID: id, depth: depth}
+	if id == "" {
+		step.Action = planCreate
+		keys := make([]string, 0, len(fields))
+		for k := range fields {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			// Parent IDs aren't known until the parent is created.
+			if v, ok := fields[k].(string); ok && v == "" {
+				continue
+			}
+			step.Changes = append(step.Changes, spec.Change{Field: k, To: spec.Display(fields[k])})
+		}
+		return step, nil
+	}
+
+	keys := make([]string, 0, len(fields))
+	for k := range fields {
+		keys = append(keys, k)
+	}
+	params := url.Values{}
+	params.Set("fields", strings.Join(keys, ","))
+	body, err := client.Get("/"+id, params)
+	if err != nil {
+		return step, fmt.Errorf("%s %q (%s): %w", kind, name, id, err)
+	}
+	step.Changes, err = spec.Diff(fields, body)
+	if err != nil {
+		return step, fmt.Errorf("%s %q (%s): parsing response: %w", kind, name, id, err)
+	}
+	step.Action = planNoop
+	if len(step.Changes) > 0 {
+		step.Action = planUpdate
+	}
+	return step, nil
+}
+
+// orDash returns s, or "-" when it is empty.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	apiVersionFlag string
+	timeoutFlag    string
+)
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&apiVersionFlag, "api-version", "", "Graph API version, e.g. v23.0 (default "+api.DefaultVersion+")")
+	rootCmd.PersistentFlags().StringVar(&timeoutFlag, "http-timeout", "", "HTTP request timeout, e.g. 2m for large downloads and uploads (default 30s)")
+	rootCmd.PersistentFlags().StringVar(&timeoutFlag, "timeout", "", "HTTP request timeout")
+	_ = rootCmd.PersistentFlags().MarkDeprecated("timeout", "use --http-timeout")
+}
+
+// preference returns the value of a setting that wasn't given as a flag:
+// the env var when set, else the value from the config file.
+func preference(env, fromConfig string) string {
+	if v := os.Getenv(env); v != "" {
+		return v
+	}
+	return fromConfig
+}
+
+// applyOutputPreferences fills in the output format and pretty-printing from
+// META_ADS_OUTPUT / META_ADS_PRETTY or the config file, unless the command
+// line already chose a format (flag > env > config). With envOnly, the config
+// file isn't read, for the commands that don't load it.
+func applyOutputPreferences(envOnly bool) error {
+	var c config.Config
+	if !envOnly {
+		if loadConfig(); cfg != nil {
+			c = *cfg
+		}
+	}
+
+	flags := rootCmd.PersistentFlags()
+	formatChosen := flags.Changed("output") || flags.Changed("json") || flags.Changed("pretty") ||
+		flags.Changed("format") || flags.Changed("quiet")
+	if v := preference("META_ADS_OUTPUT", c.Output); v != "" && !formatChosen {
+		if _, err := output.ParseFormat(v); err != nil {
+			return fmt.Errorf("output preference: %w", err)
+		}
+		if err := flags.Set("output", v); err != nil {
+			return err
+		}
+	}
+
+	configPretty := ""
+	if c.Pretty {
+		configPretty = "true"
+	}
+	if v := preference("META_ADS_PRETTY", configPretty); v != "" {
+		pretty, err := strconv.ParseBool(v)
+		if err != nil {
+			return usageError("invalid META_ADS_PRETTY %q — use true or false", v)
+		}
+		output.SetPrettyDefault(pretty)
+	}
+	return nil
+}
+
+// applyNumberFormat sets how tables write numbers from --number-format, else
+// the locale, unless --raw-numbers leaves them as they are.
+func applyNumberFormat() error {
+	output.SetRawNumbers(rawNumbersFlag)
+	if numberFormatFlag == "" {
+		output.SetNumberFormat(output.LocaleNumberFormat())
+		return nil
+	}
+	style, err := output.ParseNumberFormat(numberFormatFlag)
+	if err != nil {
+		return err
+	}
+	output.SetNumberFormat(style)
+	return nil
+}
+
+// newClient creates the API client for token, logging to logger and tracing
+// to --trace-dir, with the Graph API version and HTTP settings from --api-version / --http-timeout, META_ADS_API_VERSION /
+// META_ADS_TIMEOUT / META_ADS_KEEP_ALIVE / META_ADS_MAX_IDLE_CONNS, or the
+// config file.
+func newClient(token, appSecret string) (*api.Client, error) {
+	var c config.Config
+	if cfg != nil {
+		c = *cfg
+	}
+
+	var opts api.HTTPOptions
+	timeout := timeoutFlag
+	if timeout == "" {
+		timeout = preference("META_ADS_TIMEOUT", c.Timeout)
+	}
+	if timeout != "" {
+		d, err := config.ParseTimeout(timeout)
+		if err != nil {
+			return nil, err
+		}
+		opts.Timeout = d
+	}
+	if v := preference("META_ADS_KEEP_ALIVE", c.KeepAlive); v != "" {
+		idle, enabled, err := config.ParseKeepAlive(v)
+		if err != nil {
+			return nil, err
+		}
+		opts.IdleTimeout, opts.DisableKeepAlives = idle, !enabled
+	}
+	configConns := ""
+	if c.MaxIdleConns > 0 {
+		configConns = strconv.Itoa(c.MaxIdleConns)
+	}
+	if v := preference("META_ADS_MAX_IDLE_CONNS", configConns); v != "" {
+		n, err := config.ParseMaxIdleConns(v)
+		if err != nil {
+			return nil, err
+		}
+		opts.MaxIdleConns = n
+	}
+	client := api.NewClient(token, appSecret, opts)
+	client.SetLogger(logger)
+	if err := client.SetTraceDir(traceDirFlag); err != nil {
+		return nil, err
+	}
+	if err := applyOfflineModes(client); err != nil {
+		return nil, err
+	}
+
+	version := apiVersionFlag
+	if version == "" {
+		version = preference("META_ADS_API_VERSION", c.APIVersion)
+	}
+	if version != "" {
+		client.SetVersion(version)
+	}
+	return client, nil
+}
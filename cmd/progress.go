@@ -0,0 +1,30 @@
+package cmd
+
+import (
+	"path"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+// progressLine is the progress line of the running command, drawn on stderr
+// in a terminal only; see output.Progress.
+var progressLine = output.NewProgress()
+
+// showPageProgress draws the progress of multi-page fetches, unless cmd
+// streams its results as they arrive, which would mix with the line.
+func showPageProgress(cmd *cobra.Command) {
+	if output.IsStream(cmd) {
+		return
+	}
+	client.OnPage(func(p api.PageProgress) {
+		if p.Done {
+			progressLine.Clear()
+			return
+		}
+		progressLine.Update("Fetching %s: %s so far, page %d…",
+			path.Base(p.Path), output.FormatNumber(strconv.Itoa(p.Items)), p.Pages+1)
+	})
+}
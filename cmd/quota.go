@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var quotaCmd = &cobra.Command{
+	Use:   "quota [act_id...]",
+	Short: "Show current rate-limit usage per account and use case",
+	Long: `Make a lightweight call against each ad account and report the rate-limit
+usage Meta returns in the X-Business-Use-Case-Usage, X-Ad-Account-Usage, and
+X-App-Usage headers, including the estimated time to regain access when throttled.
+
+Uses --account / META_ADS_ACCOUNT when no account IDs are given.
+
+Examples:
+  meta-ads quota
+  meta-ads quota act_123 act_456`,
+	RunE: runQuota,
+}
+
+func init() {
+	rootCmd.AddCommand(quotaCmd)
+}
+
+// accountQuota is the usage reported for one ad account.
+type accountQuota struct {
+	AccountID string `json:"account_id"`
+	*api.Usage
+}
+
+func runQuota(cmd *cobra.Command, args []string) error {
+	accounts := make([]string, 0, len(args))
+	for _, a := range args {
+		accounts = append(accounts, accountID(a))
+	}
+	if len(accounts) == 0 {
+		account, err := resolveAccount()
+		if err != nil {
+			return err
+		}
+		accounts = append(accounts, account)
+	}
+
+	quotas := make([]accountQuota, 0, len(accounts))
+	for _, account := range accounts {
+		params := url.Values{}
+		params.Set("fields", "id")
+		if _, err := client.Get("/"+account, params); err != nil {
+			return fmt.Errorf("%s: %w", account, err)
+		}
+		quotas = append(quotas, accountQuota{AccountID: account, Usage: client.LastUsage()})
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, quotas)
+	}
+
+	// X-Business-Use-Case-Usage and X-App-Usage report call count, CPU time
+	// and total time; X-Ad-Account-Usage reports a single utilization, shown
+	// in its own table rather than under those headers.
+	headers := []string{"ACCOUNT", "SOURCE", "USE CASE", "CALLS", "CPU TIME", "TOTAL TIME", "REGAIN ACCESS IN"}
+	var rows [][]string
+	accountHeaders := []string{"ACCOUNT", "UTILIZATION", "RESET IN", "ACCESS TIER"}
+	var accountRows [][]string
+	for _, q := range quotas {
+		if q.AdAccount != nil {
+			reset := "-"
+			if q.AdAccount.ResetTimeDuration > 0 {
+				reset = fmt.Sprintf("%ds", q.AdAccount.ResetTimeDuration)
+			}
+			accountRows = append(accountRows, []string{
+				q.AccountID,
+				fmt.Sprintf("%.1f%%", q.AdAccount.AccIDUtilPct),
+				reset,
+				orDash(q.AdAccount.AdsAPIAccessTier),
+			})
+		}
+		for _, b := range q.BusinessUseCases {
+			regain := "-"
+			if b.EstimatedTimeToRegainAccess > 0 {
+				regain = fmt.Sprintf("%d min", b.EstimatedTimeToRegainAccess)
+			}
+			rows = append(rows, []string{
+				q.AccountID, "business " + b.BusinessID, b.Type,
+				fmt.Sprintf("%d%%", b.CallCount),
+				fmt.Sprintf("%d%%", b.TotalCPUTime),
+				fmt.Sprintf("%d%%", b.TotalTime),
+				regain,
+			})
+		}
+		if q.App != nil {
+			rows = append(rows, []string{
+				q.AccountID, "app", "-",
+				fmt.Sprintf("%d%%", q.App.CallCount),
+				fmt.Sprintf("%d%%", q.App.TotalCPUTime),
+				fmt.Sprintf("%d%%", q.App.TotalTime),
+				"-",
+			})
+		}
+	}
+
+	if len(rows) == 0 && len(accountRows) == 0 {
+		fmt.Println("Meta returned no rate-limit usage headers — usage is negligible.")
+		return nil
+	}
+	if len(rows) > 0 {
+		output.PrintTable(headers, rows)
+	}
+	if len(accountRows) > 0 {
+		if len(rows) > 0 {
+			fmt.Println()
+		}
+		output.PrintTable(accountHeaders, accountRows)
+	}
+	return nil
+}
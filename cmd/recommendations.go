@@ -0,0 +1,180 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var recommendationsCampaign string
+
+var recommendationsCmd = &cobra.Command{
+	Use:   "recommendations",
+	Short: "Show Meta delivery recommendations",
+}
+
+var recommendationsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List delivery recommendations for campaigns, ad sets, and ads",
+	Long: `List the recommendations Meta attaches to campaigns, ad sets, and ads
+(creative fatigue, learning limited, audience too narrow, ...), grouped by severity.
+
+Examples:
+  meta-ads recommendations list --account act_123
+  meta-ads recommendations list --campaign 23851234567890`,
+	RunE: runRecommendationsList,
+}
+
+func init() {
+	recommendationsListCmd.Flags().StringVar(&recommendationsCampaign, "campaign", "", "Only show recommendations for this campaign and its ad sets and ads")
+	_ = recommendationsListCmd.RegisterFlagCompletionFunc("campaign", completeObjectFlag("campaign"))
+
+	recommendationsCmd.AddCommand(recommendationsListCmd)
+	rootCmd.AddCommand(recommendationsCmd)
+}
+
+// objectRecommendation is a recommendation tagged with the object it applies to.
+type objectRecommendation struct {
+	Level      string `json:"level"`
+	ObjectID   string `json:"object_id"`
+	ObjectName string `json:"object_name"`
+	api.Recommendation
+}
+
+func runRecommendationsList(cmd *cobra.Command, args []string) error {
+	var parent string
+	if recommendationsCampaign != "" {
+		parent = recommendationsCampaign
+	} else {
+		account, err := resolveAccount()
+		if err != nil {
+			return err
+		}
+		parent = account
+	}
+
+	var recs []objectRecommendation
+
+	if recommendationsCampaign != "" {
+		params := url.Values{}
+		params.Set("fields", "id,name,recommendations")
+		body, err := client.Get("/"+recommendationsCampaign, params)
+		if err != nil {
+			return err
+		}
+		found, err := collectRecommendations("campaign", []json.RawMessage{body})
+		if err != nil {
+			return err
+		}
+		recs = append(recs, found...)
+	}
+
+	for _, edge := range []struct{ path, level string }{
+		{"campaigns", "campaign"},
+		{"adsets", "adset"},
+		{"ads", "ad"},
+	} {
+		if recommendationsCampaign != "" && edge.level == "campaign" {
+			continue
+		}
+		params := url.Values{}
+		params.Set("fields", "id,name,recommendations")
+		items, err := client.GetAll("/"+parent+"/"+edge.path, params)
+		if err != nil {
+			return fmt.Errorf("fetching %s: %w", edge.path, err)
+		}
+		found, err := collectRecommendations(edge.level, items)
+		if err != nil {
+			return err
+		}
+		recs = append(recs, found...)
+	}
+
+	sort.SliceStable(recs, func(i, j int) bool {
+		return importanceRank(recs[i].Importance) < importanceRank(recs[j].Importance)
+	})
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, recs)
+	}
+
+	if len(recs) == 0 {
+		fmt.Println("No recommendations — Meta has nothing to flag.")
+		return nil
+	}
+
+	headers := []string{"LEVEL", "OBJECT ID", "NAME", "TITLE", "MESSAGE"}
+	for start := 0; start < len(recs); {
+		importance := recs[start].Importance
+		end := start
+		for end < len(recs) && recs[end].Importance == importance {
+			end++
+		}
+		if start > 0 {
+			fmt.Println()
+		}
+		label := importance
+		if label == "" {
+			label = "UNSPECIFIED"
+		}
+		fmt.Printf("%s (%d)\n", label, end-start)
+		fmt.Println(strings.Repeat("─", 60))
+		rows := make([][]string, 0, end-start)
+		for _, r := range recs[start:end] {
+			rows = append(rows, []string{
+				r.Level,
+				r.ObjectID,
+				output.Truncate(r.ObjectName, 30),
+				output.Truncate(r.Title, 40),
+				output.Truncate(r.Message, 70),
+			})
+		}
+		output.PrintTable(headers, rows)
+		start = end
+	}
+	return nil
+}
+
+// collectRecommendations extracts recommendations from raw objects carrying id, name, and recommendations.
+func collectRecommendations(level string, items []json.RawMessage) ([]objectRecommendation, error) {
+	var recs []objectRecommendation
+	for _, raw := range items {
+		var obj struct {
+			ID              string               `json:"id"`
+			Name            string               `json:"name"`
+			Recommendations []api.Recommendation `json:"recommendations"`
+		}
+		if err := json.Unmarshal(raw, &obj); err != nil {
+			return nil, fmt.Errorf("parsing %s: %w", level, err)
+		}
+		for _, r := range obj.Recommendations {
+			recs = append(recs, objectRecommendation{
+				Level:          level,
+				ObjectID:       obj.ID,
+				ObjectName:     obj.Name,
+				Recommendation: r,
+			})
+		}
+	}
+	return recs, nil
+}
+
+// importanceRank orders recommendation importance from most to least severe.
+func importanceRank(importance string) int {
+	switch strings.ToUpper(importance) {
+	case "HIGH":
+		return 0
+	case "MEDIUM":
+		return 1
+	case "LOW":
+		return 2
+	default:
+		return 3
+	}
+}
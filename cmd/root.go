@@ -4,15 +4,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/the20100/meta-ads-cli/internal/api"
 	"github.com/the20100/meta-ads-cli/internal/config"
 	"github.com/the20100/meta-ads-cli/internal/metaauth"
+	"github.com/the20100/meta-ads-cli/internal/output"
 )
 
 var (
@@ -20,6 +23,16 @@ var (
 	accountFlag string
 	jsonFlag    bool
 	prettyFlag  bool
+	outputFlag  string
+	noColorFlag bool
+	formatFlag  string
+	quietFlag   bool
+	tzFlag      string
+	configFlag  string
+	dryRunFlag  bool
+
+	numberFormatFlag string
+	rawNumbersFlag   bool
 
 	// Global API client, set in PersistentPreRunE
 	client *api.Client
@@ -34,6 +47,7 @@ var rootCmd = &cobra.Command{
 	Long: `meta-ads is a CLI tool for the Meta Ads API.
 
 It outputs JSON when piped (for agent use) and human-readable tables in a terminal.
+Use --output to pick a format explicitly (table, json, csv, yaml, ndjson, markdown).
 
 Token resolution order:
   1. META_TOKEN env var
@@ -51,28 +65,122 @@ Set META_ADS_ACCOUNT to avoid passing --account on every command.`,
 }
 
 func Execute() {
-	if err := rootCmd.Execute(); err != nil {
-		os.Exit(1)
+	tagUsageErrors(rootCmd)
+	// cobra reports an unknown command while looking it up, before any hook.
+	_, _, findErr := rootCmd.Find(os.Args[1:])
+	err := rootCmd.Execute()
+	if err != nil && findErr != nil && err.Error() == findErr.Error() {
+		err = withExitCode(exitUsage, err)
+	}
+	progressLine.Clear()
+	printStats()
+	if err != nil {
+		code := exitCode(err)
+		printErrorDetails(err)
+		printErrorLine(err, code)
+		os.Exit(code)
 	}
 }
 
 func init() {
 	rootCmd.PersistentFlags().StringVarP(&accountFlag, "account", "a", "", "Ad account ID (act_ prefix optional). Overrides META_ADS_ACCOUNT env var.")
+	_ = rootCmd.RegisterFlagCompletionFunc("account", completeAccountFlag)
 	rootCmd.PersistentFlags().BoolVar(&jsonFlag, "json", false, "Force JSON output")
 	rootCmd.PersistentFlags().BoolVar(&prettyFlag, "pretty", false, "Force pretty-printed JSON output (implies --json)")
+	rootCmd.PersistentFlags().StringVar(&outputFlag, "output", "", "Output format: table, json, csv, yaml, ndjson, markdown (default: table in a terminal, json when piped)")
+	rootCmd.PersistentFlags().BoolVar(&noColorFlag, "no-color", false, "Disable colored output (also disabled by NO_COLOR or when not a terminal)")
+	rootCmd.PersistentFlags().StringVar(&numberFormatFlag, "number-format", "", "Write numbers in tables as in this locale, e.g. en (1,234.5), de (1.234,5), fr (1 234,5) (default: from LC_ALL, LC_NUMERIC or LANG)")
+	rootCmd.PersistentFlags().BoolVar(&rawNumbersFlag, "raw-numbers", false, "Write numbers in tables as Meta returns them, without digit grouping")
+	rootCmd.PersistentFlags().BoolVarP(&quietFlag, "quiet", "q", false, "Print only IDs, one per line (list and create commands)")
+	rootCmd.PersistentFlags().StringVar(&formatFlag, "format", "", "Go template applied to each result, e.g. '{{.ID}} {{.Name}}'")
+	rootCmd.PersistentFlags().StringVar(&tzFlag, "tz", "", "Show timestamps in this timezone: local, account, utc, or an IANA name (default: as returned by Meta)")
+	rootCmd.PersistentFlags().StringVar(&configFlag, "config", "", "Config file path (overrides META_ADS_CONFIG and the default location)")
+	rootCmd.PersistentFlags().BoolVar(&dryRunFlag, "dry-run", false, "Print every change (POST/DELETE) instead of sending it; reads still run")
+	rootCmd.PersistentFlags().BoolVarP(&yesFlag, "yes", "y", false, "Don't ask for confirmation before activating, deleting, or raising budgets")
+	rootCmd.PersistentFlags().BoolVar(&noInteractiveFlag, "no-interactive", false, "Never prompt to pick an omitted ID; fail instead")
 	rootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
-		if isAuthCommand(cmd) {
+		if err := checkRequiredFlags(cmd); err != nil {
+			return err
+		}
+		if configFlag != "" {
+			config.SetPath(configFlag)
+		}
+		output.SetNoColor(noColorFlag)
+		if err := applyNumberFormat(); err != nil {
+			return err
+		}
+		if err := applyOutputPreferences(skipsConfig(cmd)); err != nil {
+			return err
+		}
+		if outputFlag != "" {
+			if _, err := output.ParseFormat(outputFlag); err != nil {
+				return err
+			}
+		}
+		if rootCmd.PersistentFlags().Changed("format") {
+			if outputFlag != "" {
+				return usageError("--format and --output cannot be used together")
+			}
+			if _, err := output.ParseTemplate(formatFlag); err != nil {
+				return err
+			}
+		}
+		output.Configure(cmd)
+		if err := startLogging(strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")); err != nil {
+			return err
+		}
+		if skipsConfig(cmd) {
 			return nil
 		}
 
-		token, appSecret, err := resolveToken()
-		if err != nil {
+		// --mock and --replay never need, nor send, a real token.
+		token, appSecret := "offline", ""
+		var err error
+		if !offline() {
+			if token, appSecret, err = resolveToken(); err != nil {
+				return err
+			}
+		}
+
+		if client, err = newClient(token, appSecret); err != nil {
 			return err
 		}
+		if dryRunFlag {
+			client.SetDryRun(os.Stderr)
+		}
+		showPageProgress(cmd)
+		// Fixture mutations never reached Meta: they'd be undone against live
+		// objects.
+		if !offline() {
+			startAuditLog(cmd)
+		}
+		return applyTimezone()
+	}
+}
 
-		client = api.NewClient(token, appSecret)
+// applyTimezone sets the zone timestamps are rendered in from --tz.
+// "account" uses the timezone of the resolved ad account.
+func applyTimezone() error {
+	name := tzFlag
+	if name == "" {
 		return nil
 	}
+	if strings.EqualFold(name, "account") {
+		account, err := resolveAccount()
+		if err != nil {
+			return fmt.Errorf("--tz account: %w", err)
+		}
+		name = lookupAccountSettings(account).TimezoneName
+		if name == "" {
+			return fmt.Errorf("--tz account: could not fetch the timezone of %s", account)
+		}
+	}
+	loc, err := output.ParseTimezone(name)
+	if err != nil {
+		return err
+	}
+	output.SetTimezone(loc)
+	return nil
 }
 
 var infoCmd = &cobra.Command{
@@ -89,7 +197,7 @@ func init() {
 
 func printInfo() {
 	configDir, _ := os.UserConfigDir()
-	ownConfig := filepath.Join(configDir, "meta-ads", "config.json")
+	ownConfig := config.Path()
 	sharedConfig := filepath.Join(configDir, "meta-auth", "config.json")
 
 	fmt.Println("meta-ads — Meta Ads CLI")
@@ -104,6 +212,7 @@ func printInfo() {
 	fmt.Println("    macOS:    ~/Library/Application Support/meta-ads/config.json")
 	fmt.Println("    Linux:    ~/.config/meta-ads/config.json")
 	fmt.Println("    Windows:  %AppData%\\meta-ads\\config.json")
+	fmt.Println("    override: --config <path> or META_ADS_CONFIG")
 	fmt.Printf("  own config:    %s\n", ownConfig)
 	fmt.Printf("  shared config: %s\n", sharedConfig)
 	fmt.Println()
@@ -115,6 +224,9 @@ func printInfo() {
 		tokenSource = "META_TOKEN env var"
 	} else if tok, name := readTokenFromFile(ownConfig); tok != "" {
 		tokenSource = "own config"
+		if tok == "(encrypted)" {
+			tokenSource = "own config (encrypted)"
+		}
 		userName = name
 	} else if tok, name := readTokenFromFile(sharedConfig); tok != "" {
 		tokenSource = "meta-auth shared config"
@@ -131,6 +243,7 @@ func printInfo() {
 	fmt.Printf("    META_TOKEN       = %s\n", maskOrEmpty(os.Getenv("META_TOKEN")))
 	fmt.Printf("    META_ADS_ACCOUNT = %s\n", maskOrEmpty(os.Getenv("META_ADS_ACCOUNT")))
 	fmt.Printf("    META_APP_SECRET  = %s\n", maskOrEmpty(os.Getenv("META_APP_SECRET")))
+	fmt.Println("    (all recognized variables: meta-ads env)")
 	fmt.Println()
 	fmt.Println("  token resolution order:")
 	fmt.Println("    1. META_TOKEN env var")
@@ -144,10 +257,14 @@ func readTokenFromFile(path string) (token, userName string) {
 		return "", ""
 	}
 	var cfg struct {
-		AccessToken string `json:"access_token"`
-		UserName    string `json:"user_name"`
+		AccessToken string          `json:"access_token"`
+		UserName    string          `json:"user_name"`
+		Encrypted   json.RawMessage `json:"encrypted"`
 	}
 	if json.Unmarshal(data, &cfg) == nil {
+		if cfg.AccessToken == "" && len(cfg.Encrypted) > 0 {
+			return "(encrypted)", cfg.UserName
+		}
 		return cfg.AccessToken, cfg.UserName
 	}
 	return "", ""
@@ -172,7 +289,7 @@ func printExpiryFromFile(ownPath, sharedPath string) {
 			exp := time.Unix(cfg.TokenExpiresAt, 0)
 			days := int(time.Until(exp).Hours() / 24)
 			if days < 0 {
-				fmt.Printf("  expires:      EXPIRED on %s\n", exp.Format("2006-01-02"))
+				fmt.Printf("  expires:      %s\n", output.Red("EXPIRED on "+exp.Format("2006-01-02")))
 			} else {
 				fmt.Printf("  expires:      %s (%d days left)\n", exp.Format("2006-01-02"), days)
 			}
@@ -201,34 +318,40 @@ func resolveEnv(names ...string) string {
 	return ""
 }
 
+// readArgValue returns the contents of a flag value that may reference a file:
+// "@path" reads the file, "-" reads stdin, anything else is returned as-is.
+func readArgValue(v string) ([]byte, error) {
+	switch {
+	case v == "-":
+		return io.ReadAll(os.Stdin)
+	case strings.HasPrefix(v, "@"):
+		data, err := os.ReadFile(v[1:])
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", v[1:], err)
+		}
+		return data, nil
+	default:
+		return []byte(v), nil
+	}
+}
+
 // resolveToken returns the best available token using the priority chain.
 // Returns (token, appSecret, error).
 func resolveToken() (string, string, error) {
 	// 1. META_TOKEN env var (universal override for all Meta CLIs; try all aliases)
-	if t := resolveEnv(
-		"META_TOKEN", "META_ACCESS_TOKEN", "META_API_TOKEN", "META_BEARER_TOKEN",
-		"TOKEN_META", "META_KEY", "META_API_KEY", "META_API", "API_KEY_META", "API_META",
-	); t != "" {
-		appSecret := resolveEnv(
-			"META_APP_SECRET", "META_SECRET", "META_SECRET_KEY", "META_API_SECRET",
-			"META_APP_SECRET_KEY", "SECRET_META", "API_SECRET_META", "SK_META", "META_SK",
-		)
+	if t := resolveEnv(tokenEnvVars...); t != "" {
+		appSecret := resolveEnv(appSecretEnvVars...)
 		return t, appSecret, nil
 	}
 
 	// 2. Own config
-	var err error
-	cfg, err = config.Load()
-	if err != nil {
-		return "", "", fmt.Errorf("failed to load config: %w", err)
+	if loadConfig(); configErr != nil {
+		return "", "", fmt.Errorf("failed to load config: %w", configErr)
 	}
 	if cfg.AccessToken != "" {
 		appSecret := cfg.AppSecret
 		if appSecret == "" {
-			appSecret = resolveEnv(
-				"META_APP_SECRET", "META_SECRET", "META_SECRET_KEY", "META_API_SECRET",
-				"META_APP_SECRET_KEY", "SECRET_META", "API_SECRET_META", "SK_META", "META_SK",
-			)
+			appSecret = resolveEnv(appSecretEnvVars...)
 		}
 		return cfg.AccessToken, appSecret, nil
 	}
@@ -240,14 +363,11 @@ func resolveToken() (string, string, error) {
 	}
 	if sharedToken != "" {
 		warnSharedExpiry()
-		appSecret := resolveEnv(
-			"META_APP_SECRET", "META_SECRET", "META_SECRET_KEY", "META_API_SECRET",
-			"META_APP_SECRET_KEY", "SECRET_META", "API_SECRET_META", "SK_META", "META_SK",
-		)
+		appSecret := resolveEnv(appSecretEnvVars...)
 		return sharedToken, appSecret, nil
 	}
 
-	return "", "", fmt.Errorf("not authenticated — run: meta-ads auth login\nor: meta-auth login  (shared auth)")
+	return "", "", withExitCode(exitAuth, fmt.Errorf("not authenticated — run: meta-ads auth login\nor: meta-auth login  (shared auth)"))
 }
 
 // warnSharedExpiry prints a stderr warning if the shared meta-auth token is expiring soon.
@@ -255,40 +375,82 @@ func warnSharedExpiry() {
 	days := metaauth.DaysUntilExpiry()
 	switch {
 	case metaauth.IsExpired():
-		fmt.Fprintf(os.Stderr, "warning: meta-auth token has expired — run: meta-auth refresh\n")
+		fmt.Fprintln(os.Stderr, output.Warn("warning: meta-auth token has expired — run: meta-auth refresh"))
 	case days >= 0 && days <= 7:
-		fmt.Fprintf(os.Stderr, "warning: meta-auth token expires in %d day(s) — run: meta-auth refresh\n", days)
+		fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("warning: meta-auth token expires in %d day(s) — run: meta-auth refresh", days)))
 	}
 }
 
 // isAuthCommand returns true if cmd is a child of the "auth" command.
 func isAuthCommand(cmd *cobra.Command) bool {
-	if cmd.Name() == "auth" {
-		return true
-	}
-	p := cmd.Parent()
-	for p != nil {
-		if p.Name() == "auth" {
+	return isUnder(cmd, "auth")
+}
+
+// isConfigCommand returns true if cmd is a child of the "config" command.
+func isConfigCommand(cmd *cobra.Command) bool {
+	return isUnder(cmd, "config")
+}
+
+// isCompletionCommand returns true if cmd generates completion scripts or
+// answers completion requests; the latter set up the client themselves,
+// quietly, only when they need the API.
+func isCompletionCommand(cmd *cobra.Command) bool {
+	return isUnder(cmd, "completion") || isUnder(cmd, cobra.ShellCompRequestCmd) || isUnder(cmd, cobra.ShellCompNoDescRequestCmd)
+}
+
+// skipsConfig reports whether cmd runs without an API client, and so without
+// loading the config file: auth and config commands manage it themselves.
+func skipsConfig(cmd *cobra.Command) bool {
+	return isAuthCommand(cmd) || isConfigCommand(cmd) || isUnder(cmd, "env") || isCompletionCommand(cmd) || cmd == webhooksListenCmd || isUnder(cmd, "audit")
+}
+
+// isUnder returns true if cmd or one of its parents is named name.
+func isUnder(cmd *cobra.Command, name string) bool {
+	for c := cmd; c != nil; c = c.Parent() {
+		if c.Name() == name {
 			return true
 		}
-		p = p.Parent()
 	}
 	return false
 }
 
+// configErr is the error of loading the config file into cfg, if it failed.
+var configErr error
+
+// loadConfig loads the config file into cfg, once per run: an encrypted
+// config asks for its passphrase at most once, and a load that failed isn't
+// retried (resolveToken reports its error).
+func loadConfig() {
+	if cfg == nil && configErr == nil {
+		cfg, configErr = config.Load()
+	}
+}
+
+// accountID resolves an account alias defined with `config alias add`,
+// or normalizes v as an ad account ID.
+func accountID(v string) string {
+	loadConfig()
+	if cfg != nil {
+		if id, ok := cfg.Aliases[v]; ok {
+			return api.NormalizeAccountID(id)
+		}
+	}
+	return api.NormalizeAccountID(v)
+}
+
 // resolveAccount returns the account ID to use for a command.
 // Priority: --account flag > META_ADS_ACCOUNT env var (+ aliases) > config default account.
+// Each value may be an account alias defined with `config alias add`.
 func resolveAccount() (string, error) {
 	if accountFlag != "" {
-		return api.NormalizeAccountID(accountFlag), nil
+		return accountID(accountFlag), nil
 	}
-	if env := resolveEnv(
-		"META_ADS_ACCOUNT", "META_ACCOUNT", "META_AD_ACCOUNT", "FACEBOOK_AD_ACCOUNT",
-	); env != "" {
-		return api.NormalizeAccountID(env), nil
+	if env := resolveEnv(accountEnvVars...); env != "" {
+		return accountID(env), nil
 	}
+	loadConfig()
 	if cfg != nil && cfg.DefaultAccount != "" {
-		return api.NormalizeAccountID(cfg.DefaultAccount), nil
+		return accountID(cfg.DefaultAccount), nil
 	}
-	return "", fmt.Errorf("no account specified — use --account, set META_ADS_ACCOUNT, or set a default with: meta-ads accounts list")
+	return "", fmt.Errorf("no account specified — use --account, set META_ADS_ACCOUNT, or set a default with: meta-ads config set default_account <id>")
 }
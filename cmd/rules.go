@@ -0,0 +1,409 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	ruleName           string
+	ruleStatus         string
+	ruleEvaluationSpec string
+	ruleExecutionSpec  string
+	ruleScheduleSpec   string
+	ruleWhen           []string
+	ruleEntityType     string
+	ruleTimePreset     string
+	ruleAction         string
+)
+
+const ruleFields = "id,name,status,evaluation_spec,execution_spec,schedule_spec,created_time,updated_time"
+
+var rulesCmd = &cobra.Command{
+	Use:   "rules",
+	Short: "Manage automated rules (adrules_library)",
+}
+
+var rulesListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List automated rules for an ad account",
+	Args:  cobra.NoArgs,
+	RunE:  runRulesList,
+}
+
+var rulesGetCmd = &cobra.Command{
+	Use:   "get <rule_id>",
+	Short: "Show an automated rule with its conditions and action",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRulesGet,
+}
+
+var rulesCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create an automated rule",
+	Long: `Create a server-side automated rule, either from shorthand flags or from
+raw specs (JSON, @file, or - for stdin).
+
+Shorthand: --when conditions are ANDed, with operators > < = !=.
+(Meta's rule filters have no >= or <=: use > or < with the next value.)
+The rule is evaluated on a schedule (every 30 minutes unless --schedule-spec).
+
+Examples:
+  # Pause ad sets whose frequency exceeds 4 over the last 7 days
+  meta-ads rules create --name "Cap frequency" --entity-type ADSET \
+    --time-preset LAST_7_DAYS --when "frequency>4" --action PAUSE
+
+  # Raw specs
+  meta-ads rules create --name "Notify on high CPA" \
+    --evaluation-spec @eval.json --execution-spec '{"execution_type":"NOTIFICATION"}'`,
+	Args: cobra.NoArgs,
+	RunE: runRulesCreate,
+}
+
+var rulesDeleteCmd = &cobra.Command{
+	Use:   "delete <rule_id>",
+	Short: "Delete an automated rule",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runRulesDelete,
+}
+
+func init() {
+	rulesCreateCmd.Flags().StringVar(&ruleName, "name", "", "Rule name (required)")
+	rulesCreateCmd.Flags().StringVar(&ruleStatus, "status", "ENABLED", "Initial status: ENABLED or DISABLED")
+	rulesCreateCmd.Flags().StringVar(&ruleEvaluationSpec, "evaluation-spec", "", "evaluation_spec JSON (or @file, -)")
+	rulesCreateCmd.Flags().StringVar(&ruleExecutionSpec, "execution-spec", "", "execution_spec JSON (or @file, -)")
+	rulesCreateCmd.Flags().StringVar(&ruleScheduleSpec, "schedule-spec", "", "schedule_spec JSON (or @file, -)")
+	rulesCreateCmd.Flags().StringArrayVar(&ruleWhen, "when", nil, `Condition such as "frequency>4" (repeatable)`)
+	rulesCreateCmd.Flags().StringVar(&ruleEntityType, "entity-type", "", "Objects evaluated: CAMPAIGN, ADSET, AD")
+	rulesCreateCmd.Flags().StringVar(&ruleTimePreset, "time-preset", "LAST_7_DAYS", "Metrics window for --when, e.g. TODAY, LAST_3_DAYS, LIFETIME")
+	rulesCreateCmd.Flags().StringVar(&ruleAction, "action", "", "Action: PAUSE, UNPAUSE, NOTIFICATION, ...")
+	_ = rulesCreateCmd.MarkFlagRequired("name")
+	addFromJSONFlag(rulesCreateCmd, jsonBody{
+		fields:   []string{"name", "status", "evaluation_spec", "execution_spec", "schedule_spec"},
+		required: []string{"name", "evaluation_spec", "execution_spec"},
+		flags: []string{"name", "status", "evaluation-spec", "execution-spec", "schedule-spec",
+			"when", "entity-type", "time-preset", "action"},
+	})
+
+	addCountFlag(rulesListCmd)
+	addPagingFlags(rulesListCmd)
+	rulesCmd.AddCommand(rulesListCmd, rulesGetCmd, rulesCreateCmd, rulesDeleteCmd)
+	rootCmd.AddCommand(rulesCmd)
+}
+
+func runRulesList(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+	params := url.Values{}
+	params.Set("fields", ruleFields)
+
+	rules, streamed, err := listAll[api.AdRule](cmd, "/"+account+"/adrules_library", params, "rule", nil)
+	if err != nil || streamed {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, rules)
+	}
+	if len(rules) == 0 {
+		fmt.Println("No automated rules found.")
+		return nil
+	}
+	rows := make([][]string, len(rules))
+	for i, r := range rules {
+		rows[i] = []string{
+			r.ID,
+			output.Truncate(r.Name, 35),
+			output.Status(r.Status),
+			ruleActionLabel(r.ExecutionSpec),
+			output.Truncate(strings.Join(ruleConditions(r.EvaluationSpec), " AND "), 60),
+		}
+	}
+	output.PrintTable([]string{"ID", "NAME", "STATUS", "ACTION", "CONDITIONS"}, rows)
+	return nil
+}
+
+func runRulesGet(cmd *cobra.Command, args []string) error {
+	params := url.Values{}
+	params.Set("fields", ruleFields)
+	body, err := client.Get("/"+args[0], params)
+	if err != nil {
+		return err
+	}
+	var r api.AdRule
+	if err := json.Unmarshal(body, &r); err != nil {
+		return fmt.Errorf("parsing rule: %w", err)
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, r)
+	}
+
+	evaluation := ""
+	if r.EvaluationSpec != nil {
+		evaluation = r.EvaluationSpec.EvaluationType
+	}
+	rows := [][]string{
+		{"ID", r.ID},
+		{"Name", r.Name},
+		{"Status", output.Status(r.Status)},
+		{"Evaluation", evaluation},
+		{"Action", ruleActionLabel(r.ExecutionSpec)},
+		{"Schedule", ruleSchedule(r.ScheduleSpec)},
+		{"Created", output.FormatTime(r.CreatedTime)},
+		{"Updated", output.FormatTime(r.UpdatedTime)},
+	}
+	output.PrintKeyValue(rows)
+
+	if conditions := ruleConditions(r.EvaluationSpec); len(conditions) > 0 {
+		fmt.Println()
+		fmt.Println("CONDITIONS")
+		fmt.Println(strings.Repeat("─", 60))
+		for _, c := range conditions {
+			fmt.Printf("  %s\n", c)
+		}
+	}
+	if r.ExecutionSpec != nil && len(r.ExecutionSpec.ExecutionOptions) > 0 {
+		fmt.Println()
+		fmt.Println("ACTION OPTIONS")
+		fmt.Println(strings.Repeat("─", 60))
+		for _, o := range r.ExecutionSpec.ExecutionOptions {
+			fmt.Printf("  %s\n", ruleFilterLabel(o))
+		}
+	}
+	return nil
+}
+
+func runRulesCreate(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+
+	body, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	if body == nil {
+		if body, err = ruleBody(); err != nil {
+			return err
+		}
+	}
+
+	resp, err := client.Post("/"+account+"/adrules_library", body)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, result)
+	}
+	fmt.Printf("✓ Rule created: %s\n", result.ID)
+	return nil
+}
+
+// ruleBody returns the body of rules create built from its flags.
+func ruleBody() (url.Values, error) {
+	body := url.Values{}
+	body.Set("name", ruleName)
+	body.Set("status", ruleStatus)
+
+	evaluation, err := ruleSpecValue("evaluation-spec", ruleEvaluationSpec)
+	if err != nil {
+		return nil, err
+	}
+	execution, err := ruleSpecValue("execution-spec", ruleExecutionSpec)
+	if err != nil {
+		return nil, err
+	}
+	schedule, err := ruleSpecValue("schedule-spec", ruleScheduleSpec)
+	if err != nil {
+		return nil, err
+	}
+
+	if evaluation == "" {
+		if ruleEntityType == "" || len(ruleWhen) == 0 {
+			return nil, fmt.Errorf("give --evaluation-spec, or --entity-type with at least one --when condition")
+		}
+		spec, err := buildEvaluationSpec(ruleEntityType, ruleTimePreset, ruleWhen)
+		if err != nil {
+			return nil, err
+		}
+		evaluation = spec
+	}
+	if execution == "" {
+		if ruleAction == "" {
+			return nil, fmt.Errorf("give --action or --execution-spec")
+		}
+		b, _ := json.Marshal(api.RuleExecutionSpec{ExecutionType: strings.ToUpper(ruleAction)})
+		execution = string(b)
+	}
+	if schedule == "" {
+		schedule = `{"schedule_type":"SEMI_HOURLY"}`
+	}
+	body.Set("evaluation_spec", evaluation)
+	body.Set("execution_spec", execution)
+	body.Set("schedule_spec", schedule)
+	return body, nil
+}
+
+func runRulesDelete(cmd *cobra.Command, args []string) error {
+	if err := confirm("Delete rule " + objectLabel(args[0])); err != nil {
+		return err
+	}
+	resp, err := client.Delete("/"+args[0], nil)
+	if err != nil {
+		return err
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, json.RawMessage(resp))
+	}
+	fmt.Printf("✓ Rule %s deleted\n", args[0])
+	return nil
+}
+
+// ruleSpecValue reads a spec flag (JSON, @file or -) and checks it is valid JSON.
+func ruleSpecValue(flag, v string) (string, error) {
+	if v == "" {
+		return "", nil
+	}
+	data, err := readArgValue(v)
+	if err != nil {
+		return "", err
+	}
+	if !json.Valid(data) {
+		return "", usageError("--%s is not valid JSON", flag)
+	}
+	return string(data), nil
+}
+
+// ruleOperators maps shorthand comparison operators to the rule filter
+// operators Meta supports.
+var ruleOperators = []struct{ symbol, name string }{
+	{"!=", "NOT_EQUAL"},
+	{">", "GREATER_THAN"},
+	{"<", "LESS_THAN"},
+	{"=", "EQUAL"},
+}
+
+var ruleConditionPattern = regexp.MustCompile(`^\s*([a-z_.]+)\s*([<>=!]+)\s*(.+?)\s*$`)
+
+// buildEvaluationSpec builds a SCHEDULE evaluation_spec from --when conditions.
+func buildEvaluationSpec(entityType, timePreset string, when []string) (string, error) {
+	spec := api.RuleEvaluationSpec{
+		EvaluationType: "SCHEDULE",
+		Filters: []api.RuleFilter{
+			{Field: "entity_type", Operator: "EQUAL", Value: json.RawMessage(fmt.Sprintf("%q", strings.ToUpper(entityType)))},
+			{Field: "time_preset", Operator: "EQUAL", Value: json.RawMessage(fmt.Sprintf("%q", strings.ToUpper(timePreset)))},
+		},
+	}
+	for _, w := range when {
+		m := ruleConditionPattern.FindStringSubmatch(w)
+		if m == nil {
+			return "", usageError("invalid --when %q — expected e.g. \"frequency>4\"", w)
+		}
+		operator := ""
+		for _, op := range ruleOperators {
+			if op.symbol == m[2] {
+				operator = op.name
+				break
+			}
+		}
+		if operator == "" {
+			return "", usageError("unsupported operator %q in --when %q — use >, <, = or !=", m[2], w)
+		}
+		value := json.RawMessage(m[3])
+		if !json.Valid(value) {
+			value = json.RawMessage(fmt.Sprintf("%q", m[3]))
+		}
+		spec.Filters = append(spec.Filters, api.RuleFilter{Field: m[1], Operator: operator, Value: value})
+	}
+	b, err := json.Marshal(spec)
+	return string(b), err
+}
+
+// ruleConditions renders the filters and trigger of an evaluation spec.
+func ruleConditions(spec *api.RuleEvaluationSpec) []string {
+	if spec == nil {
+		return nil
+	}
+	var out []string
+	for _, f := range spec.Filters {
+		out = append(out, ruleFilterLabel(f))
+	}
+	if spec.Trigger != nil {
+		out = append(out, "trigger: "+ruleFilterLabel(*spec.Trigger))
+	}
+	return out
+}
+
+// ruleFilterLabel renders a filter as e.g. "frequency > 4".
+func ruleFilterLabel(f api.RuleFilter) string {
+	operator := f.Operator
+	for _, op := range ruleOperators {
+		if op.name == f.Operator {
+			operator = op.symbol
+			break
+		}
+	}
+	label := f.Field
+	if operator != "" {
+		label += " " + operator
+	}
+	label += " " + ruleValue(f.Value)
+	if f.Type != "" {
+		label = f.Type + ": " + label
+	}
+	return label
+}
+
+// ruleValue renders a filter value: strings unquoted, lists comma-separated.
+func ruleValue(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	var list []any
+	if json.Unmarshal(raw, &list) == nil {
+		parts := make([]string, len(list))
+		for i, v := range list {
+			parts[i] = fmt.Sprint(v)
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	}
+	return string(raw)
+}
+
+// ruleActionLabel renders the action of an execution spec.
+func ruleActionLabel(spec *api.RuleExecutionSpec) string {
+	if spec == nil {
+		return ""
+	}
+	return spec.ExecutionType
+}
+
+// ruleSchedule renders a schedule spec by its schedule_type.
+func ruleSchedule(raw json.RawMessage) string {
+	var s struct {
+		ScheduleType string `json:"schedule_type"`
+	}
+	if len(raw) == 0 || json.Unmarshal(raw, &s) != nil {
+		return ""
+	}
+	return s.ScheduleType
+}
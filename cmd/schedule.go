@@ -0,0 +1,393 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	scheduleFrom           string
+	scheduleTo             string
+	scheduleHours          string
+	scheduleDays           string
+	scheduleLifetimeBudget int64
+	scheduleTimezone       string
+)
+
+// adsetScheduleBlock is one entry of an ad set's adset_schedule (dayparting).
+// Minutes count from midnight; days are 0 (Sunday) to 6.
+type adsetScheduleBlock struct {
+	StartMinute  int    `json:"start_minute"`
+	EndMinute    int    `json:"end_minute"`
+	Days         []int  `json:"days"`
+	TimezoneType string `json:"timezone_type,omitempty"`
+}
+
+// scheduleAdSet is the delivery schedule of an ad set.
+type scheduleAdSet struct {
+	ID             string               `json:"id"`
+	Name           string               `json:"name"`
+	AccountID      string               `json:"account_id"`
+	StartTime      string               `json:"start_time,omitempty"`
+	EndTime        string               `json:"end_time,omitempty"`
+	DailyBudget    api.FlexString       `json:"daily_budget,omitempty"`
+	LifetimeBudget api.FlexString       `json:"lifetime_budget,omitempty"`
+	PacingType     []string             `json:"pacing_type,omitempty"`
+	AdsetSchedule  []adsetScheduleBlock `json:"adset_schedule,omitempty"`
+	Campaign       struct {
+		ID             string         `json:"id"`
+		DailyBudget    api.FlexString `json:"daily_budget,omitempty"`
+		LifetimeBudget api.FlexString `json:"lifetime_budget,omitempty"`
+	} `json:"campaign"`
+}
+
+const scheduleFields = "id,name,account_id,start_time,end_time,daily_budget,lifetime_budget,pacing_type,adset_schedule,campaign{id,daily_budget,lifetime_budget}"
+
+var weekdays = []string{"sun", "mon", "tue", "wed", "thu", "fri", "sat"}
+
+var scheduleCmd = &cobra.Command{
+	Use:   "schedule",
+	Short: "Set when an ad set delivers: start/end times, lifetime budget and dayparting",
+}
+
+var scheduleSetCmd = &cobra.Command{
+	Use:   "set <adset_id>",
+	Short: "Set the flight dates, lifetime budget and delivery hours of an ad set",
+	Long: `Set an ad set's start and end times, lifetime budget and dayparting in one
+update, after checking that Meta will accept the combination:
+
+  - dayparting (--hours) needs a lifetime budget, on the ad set or on its
+    campaign, and standard delivery (pacing_type day_parting replaces it)
+  - a lifetime budget needs an end time
+  - an ad set can't switch between a daily and a lifetime budget, and can't
+    get its own budget when its campaign has one
+
+Times are in the ad account's timezone: YYYY-MM-DDTHH:MM, YYYY-MM-DD (a date
+--to covers the whole day) or RFC 3339. --hours takes ranges such as 8-22 or
+8-12,14-22 (whole hours); --days takes mon-fri or sat,sun (default every day).
+
+Examples:
+  meta-ads schedule set 120210000000001 --from 2026-11-27 --to 2026-11-30 \
+    --lifetime-budget 200000 --hours 8-22
+  meta-ads schedule set 120210000000001 --hours 9-18 --days mon-fri
+  meta-ads schedule set 120210000000001 --to 2026-12-31T23:59`,
+	Args: cobra.ExactArgs(1),
+	RunE: runScheduleSet,
+}
+
+var scheduleShowCmd = &cobra.Command{
+	Use:   "show <adset_id>",
+	Short: "Show the flight dates, budget and delivery hours of an ad set",
+	Args:  cobra.ExactArgs(1),
+	RunE:  runScheduleShow,
+}
+
+func init() {
+	scheduleSetCmd.Flags().StringVar(&scheduleFrom, "from", "", "Start time, in the account timezone")
+	scheduleSetCmd.Flags().StringVar(&scheduleTo, "to", "", "End time, in the account timezone")
+	scheduleSetCmd.Flags().StringVar(&scheduleHours, "hours", "", "Delivery hours, e.g. 8-22 or 8-12,14-22")
+	scheduleSetCmd.Flags().StringVar(&scheduleDays, "days", "", "Delivery days for --hours, e.g. mon-fri or sat,sun (default every day)")
+	scheduleSetCmd.Flags().Int64Var(&scheduleLifetimeBudget, "lifetime-budget", 0, "Lifetime budget in cents")
+	scheduleSetCmd.Flags().StringVar(&scheduleTimezone, "timezone", "advertiser", "Timezone of --hours: advertiser (account) or user (viewer)")
+	_ = scheduleSetCmd.RegisterFlagCompletionFunc("timezone", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return []string{"advertiser", "user"}, cobra.ShellCompDirectiveNoFileComp
+	})
+	addFromJSONFlag(scheduleSetCmd, jsonBody{
+		fields: []string{"start_time", "end_time", "lifetime_budget", "adset_schedule", "pacing_type"},
+		flags:  []string{"from", "to", "hours", "days", "lifetime-budget", "timezone"},
+	})
+	scheduleSetCmd.ValidArgsFunction = completeObjects("adset")
+	scheduleShowCmd.ValidArgsFunction = completeObjects("adset")
+
+	scheduleCmd.AddCommand(scheduleSetCmd, scheduleShowCmd)
+	rootCmd.AddCommand(scheduleCmd)
+}
+
+// parseDayMinute parses "8" or "8:00" into minutes from midnight (0-1440).
+func parseDayMinute(v string) (int, error) {
+	h, m, _ := strings.Cut(strings.TrimSpace(v), ":")
+	hour, err := strconv.Atoi(h)
+	if err != nil {
+		return 0, err
+	}
+	minute := 0
+	if m != "" {
+		if minute, err = strconv.Atoi(m); err != nil {
+			return 0, err
+		}
+	}
+	if hour < 0 || minute < 0 || minute > 59 || hour*60+minute > 24*60 {
+		return 0, fmt.Errorf("out of range")
+	}
+	return hour*60 + minute, nil
+}
+
+// parseDays parses --days ("mon-fri", "sat,sun") into day numbers, 0 = Sunday.
+func parseDays(v string) ([]int, error) {
+	if v == "" {
+		return []int{0, 1, 2, 3, 4, 5, 6}, nil
+	}
+	index := func(d string) (int, error) {
+		d = strings.ToLower(strings.TrimSpace(d))
+		for i, w := range weekdays {
+			if len(d) >= 3 && strings.HasPrefix(d, w) {
+				return i, nil
+			}
+		}
+		return 0, usageError("invalid day %q in --days: use mon, tue, wed, thu, fri, sat, sun", d)
+	}
+	seen := map[int]bool{}
+	var days []int
+	for _, part := range strings.Split(v, ",") {
+		from, to, isRange := strings.Cut(part, "-")
+		a, err := index(from)
+		if err != nil {
+			return nil, err
+		}
+		b := a
+		if isRange {
+			if b, err = index(to); err != nil {
+				return nil, err
+			}
+		}
+		for d := a; ; d = (d + 1) % 7 {
+			if !seen[d] {
+				seen[d] = true
+				days = append(days, d)
+			}
+			if d == b {
+				break
+			}
+		}
+	}
+	return days, nil
+}
+
+// parseHours parses --hours into adset_schedule blocks on days.
+func parseHours(v string, days []int, timezoneType string) ([]adsetScheduleBlock, error) {
+	var blocks []adsetScheduleBlock
+	for _, part := range strings.Split(v, ",") {
+		from, to, ok := strings.Cut(part, "-")
+		if !ok {
+			return nil, usageError("invalid --hours range %q: expected START-END, e.g. 8-22", part)
+		}
+		start, err := parseDayMinute(from)
+		if err != nil {
+			return nil, usageError("invalid --hours range %q: %q is not an hour", part, from)
+		}
+		end, err := parseDayMinute(to)
+		if err != nil {
+			return nil, usageError("invalid --hours range %q: %q is not an hour", part, to)
+		}
+		if end <= start {
+			return nil, usageError("invalid --hours range %q: the end must be after the start", part)
+		}
+		// Meta schedules whole hours only.
+		if start%60 != 0 || end%60 != 0 {
+			return nil, usageError("invalid --hours range %q: Meta schedules whole hours only", part)
+		}
+		blocks = append(blocks, adsetScheduleBlock{StartMinute: start, EndMinute: end, Days: days, TimezoneType: timezoneType})
+	}
+	return blocks, nil
+}
+
+// formatScheduleBlock describes a dayparting block, e.g. "08:00-22:00 mon-fri".
+func formatScheduleBlock(b adsetScheduleBlock) string {
+	names := make([]string, len(b.Days))
+	for i, d := range b.Days {
+		if d >= 0 && d < len(weekdays) {
+			names[i] = weekdays[d]
+		}
+	}
+	days := strings.Join(names, ",")
+	if len(b.Days) == 7 {
+		days = "every day"
+	}
+	s := fmt.Sprintf("%02d:%02d-%02d:%02d %s", b.StartMinute/60, b.StartMinute%60, b.EndMinute/60, b.EndMinute%60, days)
+	if b.TimezoneType != "" {
+		s += " (" + strings.ToLower(b.TimezoneType) + " time)"
+	}
+	return s
+}
+
+func runScheduleSet(cmd *cobra.Command, args []string) error {
+	id := args[0]
+	jsonBody, err := fromJSONBody(cmd)
+	if err != nil {
+		return err
+	}
+	if jsonBody != nil {
+		resp, err := client.Post("/"+id, jsonBody)
+		if err != nil {
+			return err
+		}
+		if !output.IsTable(cmd) {
+			return output.Print(cmd, json.RawMessage(resp))
+		}
+		fmt.Printf("✓ Ad set %s schedule updated\n", id)
+		return nil
+	}
+	if scheduleFrom == "" && scheduleTo == "" && scheduleHours == "" && scheduleLifetimeBudget == 0 {
+		return fmt.Errorf("nothing to change — use --from, --to, --hours, --lifetime-budget or --from-json")
+	}
+	if scheduleDays != "" && scheduleHours == "" {
+		return usageError("--days needs --hours")
+	}
+	tzType := strings.ToUpper(scheduleTimezone)
+	if tzType != "ADVERTISER" && tzType != "USER" {
+		return usageError("invalid --timezone %q: use advertiser or user", scheduleTimezone)
+	}
+
+	var cur scheduleAdSet
+	if err := getObject(id, scheduleFields, &cur); err != nil {
+		return err
+	}
+	account := "act_" + cur.AccountID
+	useAccountCurrency(account)
+	loc := accountLocation(account)
+	body := url.Values{}
+
+	// Budget type.
+	campaignBudget := cur.Campaign.DailyBudget.String() != "" || cur.Campaign.LifetimeBudget.String() != ""
+	lifetime := cur.LifetimeBudget.String() != "" || cur.Campaign.LifetimeBudget.String() != ""
+	if scheduleLifetimeBudget > 0 {
+		switch {
+		case campaignBudget:
+			return fmt.Errorf("ad set %s uses the budget of campaign %s — it can't have its own lifetime budget", id, cur.Campaign.ID)
+		case cur.DailyBudget.String() != "":
+			return fmt.Errorf("ad set %s has a daily budget, and Meta doesn't allow switching it to a lifetime budget — create a new ad set", id)
+		}
+		body.Set("lifetime_budget", strconv.FormatInt(scheduleLifetimeBudget, 10))
+		lifetime = true
+	}
+
+	// Flight dates.
+	var start, end time.Time
+	if scheduleFrom != "" {
+		t, err := parseScheduleTime(scheduleFrom, "from", loc, false)
+		if err != nil {
+			return err
+		}
+		start = t
+		body.Set("start_time", start.Format(time.RFC3339))
+	} else if t, ok := parseGraphTime(cur.StartTime); ok {
+		start = t
+	}
+	if scheduleTo != "" {
+		t, err := parseScheduleTime(scheduleTo, "to", loc, true)
+		if err != nil {
+			return err
+		}
+		end = t
+		if !end.After(time.Now()) {
+			return usageError("--to %s is in the past", end.In(loc).Format("2006-01-02 15:04 MST"))
+		}
+		body.Set("end_time", end.Format(time.RFC3339))
+	} else if t, ok := parseGraphTime(cur.EndTime); ok {
+		end = t
+	}
+	if !start.IsZero() && !end.IsZero() && !end.After(start) {
+		return fmt.Errorf("the end time %s must be after the start time %s",
+			end.In(loc).Format("2006-01-02 15:04"), start.In(loc).Format("2006-01-02 15:04"))
+	}
+	if lifetime && end.IsZero() {
+		return fmt.Errorf("a lifetime budget needs an end time — pass --to")
+	}
+
+	// Dayparting.
+	if scheduleHours != "" {
+		if !lifetime {
+			return fmt.Errorf("dayparting needs a lifetime budget, and ad set %s has a daily budget", id)
+		}
+		for _, p := range cur.PacingType {
+			if p == "no_pacing" {
+				return fmt.Errorf("ad set %s uses accelerated delivery (pacing_type no_pacing), which Meta doesn't combine with dayparting", id)
+			}
+		}
+		days, err := parseDays(scheduleDays)
+		if err != nil {
+			return err
+		}
+		blocks, err := parseHours(scheduleHours, days, tzType)
+		if err != nil {
+			return err
+		}
+		data, _ := json.Marshal(blocks)
+		body.Set("adset_schedule", string(data))
+		body.Set("pacing_type", `["day_parting"]`)
+	}
+
+	resp, err := client.Post("/"+id, body)
+	if err != nil {
+		return err
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, json.RawMessage(resp))
+	}
+	fmt.Printf("✓ Ad set %s schedule updated\n", id)
+	if v := body.Get("start_time"); v != "" {
+		fmt.Printf("  Start:    %s\n", start.In(loc).Format("2006-01-02 15:04 MST"))
+	}
+	if v := body.Get("end_time"); v != "" {
+		fmt.Printf("  End:      %s\n", end.In(loc).Format("2006-01-02 15:04 MST"))
+	}
+	if v := body.Get("lifetime_budget"); v != "" {
+		fmt.Printf("  Budget:   %s lifetime\n", output.FormatBudget(v))
+	}
+	if scheduleHours != "" {
+		days, _ := parseDays(scheduleDays)
+		blocks, _ := parseHours(scheduleHours, days, tzType)
+		for _, b := range blocks {
+			fmt.Printf("  Delivery: %s\n", formatScheduleBlock(b))
+		}
+	}
+	return nil
+}
+
+func runScheduleShow(cmd *cobra.Command, args []string) error {
+	var s scheduleAdSet
+	if err := getObject(args[0], scheduleFields, &s); err != nil {
+		return err
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, s)
+	}
+	useAccountCurrency("act_" + s.AccountID)
+
+	budget := "-"
+	switch {
+	case s.LifetimeBudget.String() != "":
+		budget = output.FormatBudget(s.LifetimeBudget.String()) + " lifetime"
+	case s.DailyBudget.String() != "":
+		budget = output.FormatBudget(s.DailyBudget.String()) + "/day"
+	case s.Campaign.LifetimeBudget.String() != "":
+		budget = output.FormatBudget(s.Campaign.LifetimeBudget.String()) + " lifetime (campaign)"
+	case s.Campaign.DailyBudget.String() != "":
+		budget = output.FormatBudget(s.Campaign.DailyBudget.String()) + "/day (campaign)"
+	}
+	delivery := "all day"
+	if len(s.AdsetSchedule) > 0 {
+		parts := make([]string, len(s.AdsetSchedule))
+		for i, b := range s.AdsetSchedule {
+			parts[i] = formatScheduleBlock(b)
+		}
+		delivery = strings.Join(parts, "; ")
+	}
+	output.PrintKeyValue([][]string{
+		{"Ad set", s.Name + " (" + s.ID + ")"},
+		{"Start", output.FormatTime(s.StartTime)},
+		{"End", output.FormatTime(s.EndTime)},
+		{"Budget", budget},
+		{"Pacing", orDash(strings.Join(s.PacingType, ", "))},
+		{"Delivery", delivery},
+	})
+	return nil
+}
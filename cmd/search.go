@@ -0,0 +1,196 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var searchTypes []string
+
+// searchSource is an account edge searched by name.
+type searchSource struct {
+	Type   string
+	Edge   string
+	Fields string
+}
+
+// searchSources are searched in this order, which is also the order of results.
+var searchSources = []searchSource{
+	{"campaign", "campaigns", "id,name,effective_status"},
+	{"adset", "adsets", "id,name,effective_status,campaign_id"},
+	{"ad", "ads", "id,name,effective_status,adset_id"},
+	{"audience", "customaudiences", "id,name,subtype"},
+	{"creative", "adcreatives", "id,name,status"},
+}
+
+// searchResult is one object whose name matched.
+type searchResult struct {
+	Type   string `json:"type"`
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	Status string `json:"status,omitempty"`
+	// Parent is the campaign of an ad set or the ad set of an ad.
+	Parent string `json:"parent_id,omitempty"`
+}
+
+var searchCmd = &cobra.Command{
+	Use:   "search <query>",
+	Short: "Find campaigns, ad sets, ads, audiences and creatives by name",
+	Long: `Search the names of an account's campaigns, ad sets, ads, custom audiences
+and ad creatives at once. Matching is case-insensitive and every word of the
+query must appear in the name. The types are fetched in parallel.
+
+Examples:
+  meta-ads search "black friday" -a act_123456789
+  meta-ads search retargeting --type adset,audience
+  meta-ads search promo --json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runSearch,
+}
+
+func init() {
+	searchCmd.Flags().StringSliceVar(&searchTypes, "type", nil, "Only search these types: campaign, adset, ad, audience, creative (default all)")
+	_ = searchCmd.RegisterFlagCompletionFunc("type", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		types := make([]string, len(searchSources))
+		for i, s := range searchSources {
+			types[i] = s.Type
+		}
+		return types, cobra.ShellCompDirectiveNoFileComp
+	})
+	rootCmd.AddCommand(searchCmd)
+}
+
+func runSearch(cmd *cobra.Command, args []string) error {
+	terms := strings.Fields(strings.ToLower(args[0]))
+	if len(terms) == 0 {
+		return fmt.Errorf("empty search query")
+	}
+	sources, err := selectedSearchSources()
+	if err != nil {
+		return err
+	}
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+
+	results := make([][]searchResult, len(sources))
+	errs := make([]error, len(sources))
+	var wg sync.WaitGroup
+	for i, s := range sources {
+		wg.Add(1)
+		go func(i int, s searchSource) {
+			defer wg.Done()
+			results[i], errs[i] = searchEdge(account, s, terms)
+		}(i, s)
+	}
+	wg.Wait()
+
+	failed := 0
+	for _, err := range errs {
+		if err != nil {
+			failed++
+		}
+	}
+	if failed == len(sources) {
+		return errs[0]
+	}
+	all := []searchResult{}
+	for i, s := range sources {
+		if errs[i] != nil {
+			fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("warning: could not search %s: %v", s.Edge, errs[i])))
+			continue
+		}
+		all = append(all, results[i]...)
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, all)
+	}
+	if len(all) == 0 {
+		fmt.Printf("Nothing in %s matches %q.\n", account, args[0])
+		return nil
+	}
+	rows := make([][]string, len(all))
+	for i, r := range all {
+		rows[i] = []string{r.Type, r.ID, output.Truncate(r.Name, 50), orDash(r.Status), orDash(r.Parent)}
+	}
+	output.PrintTable([]string{"TYPE", "ID", "NAME", "STATUS", "PARENT"}, rows)
+	return nil
+}
+
+// selectedSearchSources returns the sources named by --type, or all of them.
+func selectedSearchSources() ([]searchSource, error) {
+	if len(searchTypes) == 0 {
+		return searchSources, nil
+	}
+	var sources []searchSource
+	for _, s := range searchSources {
+		for _, t := range searchTypes {
+			if strings.EqualFold(strings.TrimSpace(t), s.Type) {
+				sources = append(sources, s)
+				break
+			}
+		}
+	}
+	if len(sources) != len(searchTypes) {
+		return nil, usageError("invalid --type %q: use campaign, adset, ad, audience or creative", strings.Join(searchTypes, ","))
+	}
+	return sources, nil
+}
+
+// searchEdge lists the account's objects of source s and returns those whose
+// name contains every term, sorted by name.
+func searchEdge(account string, s searchSource, terms []string) ([]searchResult, error) {
+	var objects []struct {
+		ID              string `json:"id"`
+		Name            string `json:"name"`
+		EffectiveStatus string `json:"effective_status"`
+		Status          string `json:"status"`
+		Subtype         string `json:"subtype"`
+		CampaignID      string `json:"campaign_id"`
+		AdSetID         string `json:"adset_id"`
+	}
+	if err := getAllInto("/"+account+"/"+s.Edge, s.Fields, &objects); err != nil {
+		return nil, err
+	}
+
+	var out []searchResult
+	for _, o := range objects {
+		if !containsAll(strings.ToLower(o.Name), terms) {
+			continue
+		}
+		r := searchResult{Type: s.Type, ID: o.ID, Name: o.Name, Status: o.EffectiveStatus}
+		switch {
+		case o.CampaignID != "":
+			r.Parent = o.CampaignID
+		case o.AdSetID != "":
+			r.Parent = o.AdSetID
+		}
+		if r.Status == "" {
+			r.Status = o.Status
+		}
+		if r.Status == "" {
+			r.Status = o.Subtype
+		}
+		out = append(out, r)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return strings.ToLower(out[i].Name) < strings.ToLower(out[j].Name) })
+	return out, nil
+}
+
+// containsAll reports whether s contains every term.
+func containsAll(s string, terms []string) bool {
+	for _, t := range terms {
+		if !strings.Contains(s, t) {
+			return false
+		}
+	}
+	return true
+}
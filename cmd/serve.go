@@ -0,0 +1,290 @@
+package cmd
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// serveTokenEnv holds the bearer token clients of serve must present.
+const serveTokenEnv = "META_ADS_SERVE_TOKEN"
+
+var (
+	serveListen    string
+	serveAuthToken string
+)
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Expose a small authenticated REST API over the CLI's client",
+	Long: `Run a local HTTP server exposing the main read and pause operations, so
+dashboards and scripts in other languages reuse meta-ads' authentication,
+rate-limit handling and pagination.
+
+Every request needs "Authorization: Bearer <token>", where the token is
+--auth-token, $` + serveTokenEnv + `, or a random one printed at startup.
+
+Endpoints (JSON; list endpoints follow all pages):
+  GET  /v1/campaigns?account=act_X&status=ACTIVE
+  GET  /v1/adsets?account=act_X&campaign=<id>&status=ACTIVE
+  GET  /v1/ads?account=act_X&adset=<id>&status=ACTIVE
+  GET  /v1/insights?id=<object_id>&level=ad&date_preset=last_7d&fields=spend,clicks
+       (or since=YYYY-MM-DD&until=YYYY-MM-DD; id defaults to the account)
+  GET  /v1/objects/<id>?fields=id,name
+  POST /v1/objects/<id>/pause
+  POST /v1/objects/<id>/activate
+
+account defaults to the resolved --account. Add fields=... to any GET to choose
+the fields returned. Meta API errors are returned as 502 with {"error": ...}.`,
+	Args: cobra.NoArgs,
+	RunE: runServe,
+}
+
+func init() {
+	serveCmd.Flags().StringVar(&serveListen, "listen", "127.0.0.1:8484", "Address to listen on")
+	serveCmd.Flags().StringVar(&serveAuthToken, "auth-token", "", "Bearer token required from clients (default: $"+serveTokenEnv+" or random)")
+	rootCmd.AddCommand(serveCmd)
+}
+
+// serveListFields are the default fields of the list endpoints.
+var serveListFields = map[string]string{
+	"campaigns": "id,name,status,effective_status,objective,daily_budget,lifetime_budget,budget_remaining,bid_strategy,start_time,stop_time,created_time",
+	"adsets":    "id,name,status,effective_status,campaign_id,daily_budget,lifetime_budget,budget_remaining,bid_amount,billing_event,optimization_goal,start_time,end_time,created_time",
+	"ads":       "id,name,status,effective_status,adset_id,campaign_id,created_time,updated_time",
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	token := serveAuthToken
+	if token == "" {
+		token = os.Getenv(serveTokenEnv)
+	}
+	if token == "" {
+		b := make([]byte, 16)
+		if _, err := rand.Read(b); err != nil {
+			return fmt.Errorf("generating token: %w", err)
+		}
+		token = hex.EncodeToString(b)
+		fmt.Fprintf(os.Stderr, "Auth token: %s\n", token)
+	}
+
+	s := &apiServer{token: token}
+	mux := http.NewServeMux()
+	for _, edge := range []string{"campaigns", "adsets", "ads"} {
+		mux.HandleFunc("GET /v1/"+edge, s.handleList(edge))
+	}
+	mux.HandleFunc("GET /v1/insights", s.handleInsights)
+	mux.HandleFunc("GET /v1/objects/{id}", s.handleObject)
+	mux.HandleFunc("POST /v1/objects/{id}/pause", s.handleStatus("PAUSED"))
+	mux.HandleFunc("POST /v1/objects/{id}/activate", s.handleStatus("ACTIVE"))
+
+	srv := &http.Server{
+		Addr:              serveListen,
+		Handler:           logRequests(s.authenticate(mux)),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdown)
+	}()
+
+	fmt.Fprintf(os.Stderr, "Listening on http://%s\n", serveListen)
+	logger.Info("server started", "listen", serveListen)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		logger.Error("server failed", "error", err.Error())
+		return err
+	}
+	logger.Info("server stopped")
+	return nil
+}
+
+// statusRecorder remembers the status code written to a response.
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(code int) {
+	r.status = code
+	r.ResponseWriter.WriteHeader(code)
+}
+
+// logRequests logs every request served: info, or warn for error statuses.
+func logRequests(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		rec := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(rec, r)
+		level := slog.LevelInfo
+		if rec.status >= 400 {
+			level = slog.LevelWarn
+		}
+		logger.Log(r.Context(), level, "request served", "method", r.Method, "path", r.URL.Path,
+			"status", rec.status, "duration_ms", time.Since(start).Milliseconds(), "remote", r.RemoteAddr)
+	})
+}
+
+// apiServer serves the REST API. Graph calls are serialized: the client is
+// shared, and one caller at a time keeps rate-limit usage predictable.
+type apiServer struct {
+	token string
+	mu    sync.Mutex
+}
+
+// authenticate rejects requests without the bearer token.
+func (s *apiServer) authenticate(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(s.token)) != 1 {
+			writeServeError(w, http.StatusUnauthorized, errors.New("missing or invalid bearer token"))
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// account returns the ?account= parameter, or the resolved default account.
+func (s *apiServer) account(r *http.Request) (string, error) {
+	if a := r.URL.Query().Get("account"); a != "" {
+		return accountID(a), nil
+	}
+	return resolveAccount()
+}
+
+func (s *apiServer) handleList(edge string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		account, err := s.account(r)
+		if err != nil {
+			writeServeError(w, http.StatusBadRequest, err)
+			return
+		}
+		params := url.Values{}
+		params.Set("fields", serveListFields[edge])
+		if f := q.Get("fields"); f != "" {
+			params.Set("fields", f)
+		}
+		if st := q.Get("status"); st != "" {
+			params.Set("effective_status", fmt.Sprintf(`["%s"]`, strings.ToUpper(st)))
+		}
+		parent := account
+		switch {
+		case edge == "adsets" && q.Get("campaign") != "":
+			parent = q.Get("campaign")
+		case edge == "ads" && q.Get("adset") != "":
+			parent = q.Get("adset")
+		}
+
+		s.mu.Lock()
+		items, err := client.GetAll("/"+parent+"/"+edge, params)
+		s.mu.Unlock()
+		if err != nil {
+			writeServeError(w, http.StatusBadGateway, err)
+			return
+		}
+		writeServeJSON(w, items)
+	}
+}
+
+func (s *apiServer) handleInsights(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	id := q.Get("id")
+	if id == "" {
+		account, err := s.account(r)
+		if err != nil {
+			writeServeError(w, http.StatusBadRequest, err)
+			return
+		}
+		id = account
+	}
+	params := url.Values{}
+	params.Set("fields", defaultInsightFields)
+	if f := q.Get("fields"); f != "" {
+		params.Set("fields", f)
+	}
+	if l := q.Get("level"); l != "" {
+		params.Set("level", l)
+	}
+	switch since, until := q.Get("since"), q.Get("until"); {
+	case since != "" && until != "":
+		params.Set("time_range", fmt.Sprintf(`{"since":"%s","until":"%s"}`, since, until))
+	case q.Get("date_preset") != "":
+		params.Set("date_preset", q.Get("date_preset"))
+	default:
+		params.Set("date_preset", "last_7d")
+	}
+	if ti := q.Get("time_increment"); ti != "" {
+		params.Set("time_increment", ti)
+	}
+
+	s.mu.Lock()
+	rows, err := client.GetAll("/"+id+"/insights", params)
+	s.mu.Unlock()
+	if err != nil {
+		writeServeError(w, http.StatusBadGateway, err)
+		return
+	}
+	writeServeJSON(w, rows)
+}
+
+func (s *apiServer) handleObject(w http.ResponseWriter, r *http.Request) {
+	params := url.Values{}
+	if f := r.URL.Query().Get("fields"); f != "" {
+		params.Set("fields", f)
+	}
+	s.mu.Lock()
+	body, err := client.Get("/"+r.PathValue("id"), params)
+	s.mu.Unlock()
+	if err != nil {
+		writeServeError(w, http.StatusBadGateway, err)
+		return
+	}
+	writeServeJSON(w, json.RawMessage(body))
+}
+
+func (s *apiServer) handleStatus(status string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body := url.Values{}
+		body.Set("status", status)
+		s.mu.Lock()
+		resp, err := client.Post("/"+r.PathValue("id"), body)
+		s.mu.Unlock()
+		if err != nil {
+			writeServeError(w, http.StatusBadGateway, err)
+			return
+		}
+		writeServeJSON(w, json.RawMessage(resp))
+	}
+}
+
+func writeServeJSON(w http.ResponseWriter, v any) {
+	if items, ok := v.([]json.RawMessage); ok && items == nil {
+		v = []json.RawMessage{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeServeError(w http.ResponseWriter, code int, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(map[string]string{"error": err.Error()})
+}
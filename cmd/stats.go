@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/the20100/meta-ads-cli/internal/api"
+)
+
+var statsFlag bool
+
+func init() {
+	rootCmd.PersistentFlags().BoolVar(&statsFlag, "stats", false, "Print a summary of the API calls, pages, bytes, time, retries and rate-limit usage to stderr at the end")
+}
+
+// printStats writes the --stats summary of the command to stderr. It is
+// printed whether the command succeeded or not.
+func printStats() {
+	if !statsFlag || client == nil {
+		return
+	}
+	s := client.Stats()
+	w := os.Stderr
+	fmt.Fprintln(w, "── API stats ──")
+	fmt.Fprintf(w, "  calls:     %d (%d page(s), %d retried)\n", s.Calls, s.Pages, s.Retries)
+	fmt.Fprintf(w, "  transfer:  %s sent, %s received\n", formatBytes(s.BytesSent), formatBytes(s.BytesReceived))
+	fmt.Fprintf(w, "  API time:  %s\n", s.APITime.Round(time.Millisecond))
+	if s.LastUsage == nil {
+		fmt.Fprintln(w, "  rate limit: no usage reported")
+		return
+	}
+	fmt.Fprintln(w, "  rate limit (first → last response):")
+	first := s.FirstUsage
+	for _, b := range s.LastUsage.BusinessUseCases {
+		var was api.BusinessUseCaseUsage
+		for _, f := range first.BusinessUseCases {
+			if f.BusinessID == b.BusinessID && f.Type == b.Type {
+				was = f
+			}
+		}
+		fmt.Fprintf(w, "    business %s %s: calls %d%% → %d%%, cpu %d%% → %d%%, time %d%% → %d%%\n",
+			b.BusinessID, b.Type, was.CallCount, b.CallCount, was.TotalCPUTime, b.TotalCPUTime, was.TotalTime, b.TotalTime)
+	}
+	if acc := s.LastUsage.AdAccount; acc != nil {
+		var was float64
+		if first.AdAccount != nil {
+			was = first.AdAccount.AccIDUtilPct
+		}
+		fmt.Fprintf(w, "    ad account: %.1f%% → %.1f%%\n", was, acc.AccIDUtilPct)
+	}
+	if app := s.LastUsage.App; app != nil {
+		var was api.AppUsage
+		if first.App != nil {
+			was = *first.App
+		}
+		fmt.Fprintf(w, "    app: calls %d%% → %d%%, cpu %d%% → %d%%, time %d%% → %d%%\n",
+			was.CallCount, app.CallCount, was.TotalCPUTime, app.TotalCPUTime, was.TotalTime, app.TotalTime)
+	}
+}
+
+// formatBytes formats n bytes with a binary unit, e.g. "12.3 KiB".
+func formatBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,271 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	targetingType  string
+	targetingLimit int
+
+	geoTypes   []string
+	geoCountry string
+	geoSpec    bool
+)
+
+// targetingTypes maps --type to the search parameters of /search. Interests
+// are searched by Meta; behaviors and demographics are browsed as a whole
+// and filtered locally.
+var targetingTypes = map[string]url.Values{
+	"interest":    {"type": {"adinterest"}},
+	"behavior":    {"type": {"adTargetingCategory"}, "class": {"behaviors"}},
+	"demographic": {"type": {"adTargetingCategory"}, "class": {"demographics"}},
+}
+
+var targetingCmd = &cobra.Command{
+	Use:   "targeting",
+	Short: "Look up targeting options",
+}
+
+var targetingSearchCmd = &cobra.Command{
+	Use:   "search [query]",
+	Short: "Search interests, behaviors, and demographics",
+	Long: `Search targeting options and print their IDs, names, audience sizes and
+paths, ready for the flexible_spec of a targeting spec.
+
+--type is interest (default), behavior or demographic. The query is required
+for interests; behaviors and demographics are listed in full without one.
+
+Examples:
+  meta-ads targeting search running
+  meta-ads targeting search --type behavior "frequent travelers"
+  meta-ads targeting search --type demographic parents`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runTargetingSearch,
+}
+
+// geoLocationTypes maps --location-type to Meta's location types.
+var geoLocationTypes = map[string]string{
+	"country":      "country",
+	"region":       "region",
+	"city":         "city",
+	"zip":          "zip",
+	"dma":          "geo_market",
+	"neighborhood": "neighborhood",
+}
+
+// geoLocationKeys maps location types to their key in a geo_locations block.
+var geoLocationKeys = map[string]string{
+	"country":      "countries",
+	"region":       "regions",
+	"city":         "cities",
+	"zip":          "zips",
+	"geo_market":   "geo_markets",
+	"neighborhood": "neighborhoods",
+	"subcity":      "subcities",
+}
+
+var targetingGeoCmd = &cobra.Command{
+	Use:   "geo <query>",
+	Short: "Search locations for the geo_locations of a targeting spec",
+	Long: `Search countries, regions, cities, zip codes and DMAs by name.
+
+Each result shows the key to use in a targeting spec; --spec prints the results
+as a ready-made geo_locations block instead.
+
+Examples:
+  meta-ads targeting geo austin --location-type city --country US
+  meta-ads targeting geo 787 --location-type zip --country US
+  meta-ads targeting geo "new york" --location-type dma --spec`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTargetingGeo,
+}
+
+func init() {
+	targetingGeoCmd.Flags().StringSliceVar(&geoTypes, "location-type", nil, "Location types: country, region, city, zip, dma, neighborhood (default: all)")
+	targetingGeoCmd.Flags().StringVar(&geoCountry, "country", "", "Only locations in this country code, e.g. US")
+	targetingGeoCmd.Flags().IntVar(&targetingLimit, "limit", 25, "Max number of results")
+	targetingGeoCmd.Flags().BoolVar(&geoSpec, "spec", false, "Print a geo_locations JSON block of the results")
+
+	targetingSearchCmd.Flags().StringVar(&targetingType, "type", "interest", "Option type: interest, behavior, or demographic")
+	targetingSearchCmd.Flags().IntVar(&targetingLimit, "limit", 25, "Max number of results (0 = all)")
+	targetingCmd.AddCommand(targetingSearchCmd, targetingGeoCmd)
+	rootCmd.AddCommand(targetingCmd)
+}
+
+func runTargetingSearch(cmd *cobra.Command, args []string) error {
+	search, ok := targetingTypes[targetingType]
+	if !ok {
+		return usageError("invalid --type %q — use interest, behavior, or demographic", targetingType)
+	}
+	query := ""
+	if len(args) == 1 {
+		query = args[0]
+	}
+	if query == "" && targetingType == "interest" {
+		return fmt.Errorf("a query is required to search interests")
+	}
+
+	params := url.Values{}
+	for k, v := range search {
+		params[k] = v
+	}
+	if targetingType == "interest" {
+		params.Set("q", query)
+		if targetingLimit > 0 {
+			params.Set("limit", strconv.Itoa(targetingLimit))
+		}
+	}
+
+	resp, err := client.Get("/search", params)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Data []api.TargetingOption `json:"data"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return fmt.Errorf("parsing targeting options: %w", err)
+	}
+	options := result.Data
+
+	if targetingType != "interest" && query != "" {
+		q := strings.ToLower(query)
+		kept := options[:0]
+		for _, o := range options {
+			if strings.Contains(strings.ToLower(o.Name+" "+strings.Join(o.Path, " ")), q) {
+				kept = append(kept, o)
+			}
+		}
+		options = kept
+	}
+	if targetingLimit > 0 && len(options) > targetingLimit {
+		options = options[:targetingLimit]
+	}
+	if options == nil {
+		options = []api.TargetingOption{}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, options)
+	}
+	if len(options) == 0 {
+		fmt.Println("No targeting options found.")
+		return nil
+	}
+	rows := make([][]string, len(options))
+	for i, o := range options {
+		rows[i] = []string{
+			o.ID,
+			output.Truncate(o.Name, 40),
+			audienceSizeRange(o.AudienceSizeLowerBound, o.AudienceSizeUpperBound),
+			output.Truncate(strings.Join(o.Path, " > "), 60),
+		}
+	}
+	output.PrintTable([]string{"ID", "NAME", "AUDIENCE SIZE", "PATH"}, rows)
+	return nil
+}
+
+// audienceSizeRange renders an audience size estimate, e.g. "1.2M–1.5M".
+func audienceSizeRange(lower, upper int) string {
+	if upper <= 0 {
+		return formatCount(lower)
+	}
+	return formatCount(lower) + "–" + formatCount(upper)
+}
+
+func runTargetingGeo(cmd *cobra.Command, args []string) error {
+	params := url.Values{}
+	params.Set("type", "adgeolocation")
+	params.Set("q", args[0])
+	if targetingLimit > 0 {
+		params.Set("limit", strconv.Itoa(targetingLimit))
+	}
+	if len(geoTypes) > 0 {
+		var types []string
+		for _, t := range geoTypes {
+			lt, ok := geoLocationTypes[strings.ToLower(t)]
+			if !ok {
+				return usageError("invalid --location-type %q — use country, region, city, zip, dma, or neighborhood", t)
+			}
+			types = append(types, lt)
+		}
+		b, _ := json.Marshal(types)
+		params.Set("location_types", string(b))
+	}
+	if geoCountry != "" {
+		params.Set("country_code", strings.ToUpper(geoCountry))
+	}
+
+	resp, err := client.Get("/search", params)
+	if err != nil {
+		return err
+	}
+	var result struct {
+		Data []api.GeoLocation `json:"data"`
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return fmt.Errorf("parsing locations: %w", err)
+	}
+	locations := result.Data
+	if locations == nil {
+		locations = []api.GeoLocation{}
+	}
+
+	if geoSpec {
+		b, err := json.MarshalIndent(geoLocationsBlock(locations), "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+		return nil
+	}
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, locations)
+	}
+	if len(locations) == 0 {
+		fmt.Println("No locations found.")
+		return nil
+	}
+	rows := make([][]string, len(locations))
+	for i, l := range locations {
+		rows[i] = []string{
+			l.Key,
+			output.Truncate(l.Name, 40),
+			l.Type,
+			l.Region,
+			l.CountryCode,
+			geoLocationKeys[l.Type],
+		}
+	}
+	output.PrintTable([]string{"KEY", "NAME", "TYPE", "REGION", "COUNTRY", "GEO_LOCATIONS FIELD"}, rows)
+	return nil
+}
+
+// geoLocationsBlock groups locations into a targeting geo_locations block:
+// country codes under "countries", other types as {"key": ...} entries.
+func geoLocationsBlock(locations []api.GeoLocation) map[string]any {
+	block := map[string]any{}
+	for _, l := range locations {
+		field, ok := geoLocationKeys[l.Type]
+		if !ok {
+			continue
+		}
+		if field == "countries" {
+			codes, _ := block[field].([]string)
+			block[field] = append(codes, l.Key)
+			continue
+		}
+		entries, _ := block[field].([]map[string]string)
+		block[field] = append(entries, map[string]string{"key": l.Key})
+	}
+	return block
+}
@@ -0,0 +1,235 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var targetingValidateCmd = &cobra.Command{
+	Use:   "validate <spec|@file|->",
+	Short: "Check a targeting spec and estimate its audience size",
+	Long: `Check a targeting spec before using it in an ad set:
+
+  - interest, behavior and other option IDs are checked with Meta's targeting
+    validation, catching unknown and deprecated options;
+  - options both included and excluded are reported as conflicts;
+  - the spec is sent to the reach estimate, which reports any other error
+    and the estimated audience size.
+
+Exits with an error when problems are found.
+
+Example:
+  meta-ads targeting validate @targeting.json -a act_123456789`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTargetingValidate,
+}
+
+func init() {
+	targetingCmd.AddCommand(targetingValidateCmd)
+}
+
+// targetingOptionFields are the targeting spec fields holding {id, name} options.
+var targetingOptionFields = []string{
+	"interests", "behaviors", "life_events", "industries", "income", "family_statuses",
+	"work_positions", "work_employers", "education_schools", "education_majors", "user_adclusters",
+}
+
+// targetingRef is an option referenced by a targeting spec.
+type targetingRef struct {
+	Field    string `json:"field"`
+	ID       string `json:"id"`
+	Name     string `json:"name,omitempty"`
+	Excluded bool   `json:"excluded,omitempty"`
+}
+
+// targetingIssue is a problem found in a targeting spec.
+type targetingIssue struct {
+	Problem string `json:"problem"`
+	Field   string `json:"field,omitempty"`
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Detail  string `json:"detail,omitempty"`
+}
+
+// targetingValidation is the result of targeting validate.
+type targetingValidation struct {
+	Valid         bool             `json:"valid"`
+	Issues        []targetingIssue `json:"issues"`
+	AudienceLower int              `json:"audience_size_lower_bound,omitempty"`
+	AudienceUpper int              `json:"audience_size_upper_bound,omitempty"`
+}
+
+func runTargetingValidate(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+	data, err := readArgValue(args[0])
+	if err != nil {
+		return err
+	}
+	// Numbers are kept as written so option IDs don't turn into floats.
+	var spec map[string]any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&spec); err != nil {
+		return fmt.Errorf("parsing targeting spec: %w", err)
+	}
+
+	result := targetingValidation{Issues: []targetingIssue{}}
+	refs := targetingRefs(spec)
+
+	// Options both included and excluded.
+	included := map[string]bool{}
+	for _, r := range refs {
+		if !r.Excluded {
+			included[r.ID] = true
+		}
+	}
+	for _, r := range refs {
+		if r.Excluded && included[r.ID] {
+			result.Issues = append(result.Issues, targetingIssue{Problem: "conflict", Field: r.Field, ID: r.ID, Name: r.Name, Detail: "both included and excluded"})
+		}
+	}
+
+	// Unknown and deprecated options.
+	if len(refs) > 0 {
+		invalid, err := invalidTargetingIDs(account, refs)
+		if err != nil {
+			return fmt.Errorf("validating targeting options: %w", err)
+		}
+		for _, r := range refs {
+			if detail, ok := invalid[r.ID]; ok {
+				result.Issues = append(result.Issues, targetingIssue{Problem: "invalid", Field: r.Field, ID: r.ID, Name: r.Name, Detail: detail})
+			}
+		}
+	}
+
+	// Everything else, and the audience size.
+	params := url.Values{}
+	params.Set("targeting_spec", string(data))
+	resp, err := client.Get("/"+account+"/reachestimate", params)
+	if err != nil {
+		result.Issues = append(result.Issues, targetingIssue{Problem: "rejected", Detail: err.Error()})
+	} else {
+		var estimate struct {
+			Data struct {
+				UsersLowerBound int `json:"users_lower_bound"`
+				UsersUpperBound int `json:"users_upper_bound"`
+			} `json:"data"`
+		}
+		if err := json.Unmarshal(resp, &estimate); err != nil {
+			return fmt.Errorf("parsing reach estimate: %w", err)
+		}
+		result.AudienceLower = estimate.Data.UsersLowerBound
+		result.AudienceUpper = estimate.Data.UsersUpperBound
+	}
+	result.Valid = len(result.Issues) == 0
+
+	if !output.IsTable(cmd) {
+		if err := output.Print(cmd, result); err != nil {
+			return err
+		}
+	} else {
+		if len(result.Issues) > 0 {
+			rows := make([][]string, len(result.Issues))
+			for i, is := range result.Issues {
+				rows[i] = []string{output.Red(is.Problem), is.Field, is.ID, output.Truncate(is.Name, 30), is.Detail}
+			}
+			output.PrintTable([]string{"PROBLEM", "FIELD", "ID", "NAME", "DETAIL"}, rows)
+			fmt.Println()
+		} else {
+			fmt.Printf("✓ %d targeting option(s) valid\n", len(refs))
+		}
+		if result.AudienceUpper > 0 {
+			fmt.Printf("Estimated audience: %s\n", audienceSizeRange(result.AudienceLower, result.AudienceUpper))
+		}
+	}
+	if !result.Valid {
+		return fmt.Errorf("%d problem(s) found in the targeting spec", len(result.Issues))
+	}
+	return nil
+}
+
+// targetingRefs collects the options a spec references: at the top level, in
+// flexible_spec groups, and in exclusions.
+func targetingRefs(spec map[string]any) []targetingRef {
+	var refs []targetingRef
+	collect := func(group any, excluded bool) {
+		m, ok := group.(map[string]any)
+		if !ok {
+			return
+		}
+		for _, field := range targetingOptionFields {
+			items, _ := m[field].([]any)
+			for _, item := range items {
+				ref := targetingRef{Field: field, ID: fmt.Sprint(item), Excluded: excluded}
+				if v, ok := item.(map[string]any); ok {
+					ref.ID = fmt.Sprint(v["id"])
+					ref.Name, _ = v["name"].(string)
+				}
+				refs = append(refs, ref)
+			}
+		}
+	}
+	collect(spec, false)
+	if groups, ok := spec["flexible_spec"].([]any); ok {
+		for _, g := range groups {
+			collect(g, false)
+		}
+	}
+	collect(spec["exclusions"], true)
+	return refs
+}
+
+// invalidTargetingIDs checks the options of refs with Meta's targeting
+// validation and returns the rejected IDs with the reason.
+func invalidTargetingIDs(account string, refs []targetingRef) (map[string]string, error) {
+	seen := map[string]bool{}
+	var ids []string
+	for _, r := range refs {
+		if !seen[r.ID] {
+			seen[r.ID] = true
+			ids = append(ids, r.ID)
+		}
+	}
+	sort.Strings(ids)
+	idList, _ := json.Marshal(ids)
+
+	params := url.Values{}
+	params.Set("id_list", string(idList))
+	resp, err := client.Get("/"+account+"/targetingvalidation", params)
+	if err != nil {
+		return nil, err
+	}
+	var results struct {
+		Data []struct {
+			ID    string `json:"id"`
+			Valid bool   `json:"valid"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(resp, &results); err != nil {
+		return nil, err
+	}
+
+	invalid := map[string]string{}
+	returned := map[string]bool{}
+	for _, r := range results.Data {
+		returned[r.ID] = true
+		if !r.Valid {
+			invalid[r.ID] = "deprecated or no longer available"
+		}
+	}
+	for _, id := range ids {
+		if !returned[id] {
+			invalid[id] = "unknown targeting ID"
+		}
+	}
+	return invalid, nil
+}
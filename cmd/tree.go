@@ -0,0 +1,211 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	treeCampaign   string
+	treeSpend      bool
+	treeDatePreset string
+)
+
+// treeNode is a campaign, ad set or ad of the tree. Campaigns hold ad sets
+// and ad sets hold ads.
+type treeNode struct {
+	ID             string      `json:"id"`
+	Name           string      `json:"name"`
+	Status         string      `json:"effective_status"`
+	DailyBudget    string      `json:"daily_budget,omitempty"`
+	LifetimeBudget string      `json:"lifetime_budget,omitempty"`
+	Spend          string      `json:"spend,omitempty"`
+	AdSets         []*treeNode `json:"adsets,omitempty"`
+	Ads            []*treeNode `json:"ads,omitempty"`
+}
+
+var treeCmd = &cobra.Command{
+	Use:   "tree",
+	Short: "Show campaigns → ad sets → ads as a tree",
+	Long: `Show the structure of an ad account, or of one campaign, as an indented tree
+with each object's effective status and budget. --spend adds the spend of each
+object over --date-preset.
+
+Examples:
+  meta-ads tree -a act_123456789
+  meta-ads tree --campaign 120210000000000 --spend --date-preset last_30d
+  meta-ads tree --json`,
+	Args: cobra.NoArgs,
+	RunE: runTree,
+}
+
+func init() {
+	treeCmd.Flags().StringVar(&treeCampaign, "campaign", "", "Only show this campaign")
+	treeCmd.Flags().BoolVar(&treeSpend, "spend", false, "Add the spend of each object")
+	treeCmd.Flags().StringVar(&treeDatePreset, "date-preset", "last_7d", "Period of --spend (e.g. today, last_7d, last_30d, maximum)")
+	_ = treeCmd.RegisterFlagCompletionFunc("campaign", completeObjectFlag("campaign"))
+	rootCmd.AddCommand(treeCmd)
+}
+
+func runTree(cmd *cobra.Command, args []string) error {
+	var (
+		root, account string
+		campaigns     []*treeNode
+	)
+	const fields = "id,name,effective_status,daily_budget,lifetime_budget"
+	if treeCampaign != "" {
+		var c struct {
+			treeNode
+			AccountID string `json:"account_id"`
+		}
+		if err := getObject(treeCampaign, fields+",account_id", &c); err != nil {
+			return err
+		}
+		root, account = treeCampaign, "act_"+c.AccountID
+		campaigns = []*treeNode{&c.treeNode}
+	} else {
+		var err error
+		if account, err = resolveAccount(); err != nil {
+			return err
+		}
+		root = account
+		if err := getAllInto("/"+account+"/campaigns", fields, &campaigns); err != nil {
+			return err
+		}
+	}
+
+	var adsets []struct {
+		treeNode
+		CampaignID string `json:"campaign_id"`
+	}
+	if err := getAllInto("/"+root+"/adsets", fields+",campaign_id", &adsets); err != nil {
+		return err
+	}
+	var ads []struct {
+		treeNode
+		AdSetID string `json:"adset_id"`
+	}
+	if err := getAllInto("/"+root+"/ads", "id,name,effective_status,adset_id", &ads); err != nil {
+		return err
+	}
+
+	byID := map[string]*treeNode{}
+	for _, c := range campaigns {
+		byID[c.ID] = c
+	}
+	for i := range adsets {
+		s := &adsets[i].treeNode
+		byID[s.ID] = s
+		if c := byID[adsets[i].CampaignID]; c != nil {
+			c.AdSets = append(c.AdSets, s)
+		}
+	}
+	for i := range ads {
+		a := &ads[i].treeNode
+		byID[a.ID] = a
+		if s := byID[ads[i].AdSetID]; s != nil {
+			s.Ads = append(s.Ads, a)
+		}
+	}
+
+	if treeSpend {
+		spend, err := treeSpendByID(root)
+		if err != nil {
+			return err
+		}
+		for id, n := range byID {
+			n.Spend = strconv.FormatFloat(spend[id], 'f', 2, 64)
+		}
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, campaigns)
+	}
+	if len(campaigns) == 0 {
+		fmt.Println("No campaigns found.")
+		return nil
+	}
+
+	useAccountCurrency(account)
+	currency := ""
+	if treeSpend {
+		if c := displayCurrency(account, accountCurrency(account)); c != "" {
+			currency = " " + c
+		}
+	}
+	headers := []string{"NAME", "ID", "STATUS", "BUDGET"}
+	if treeSpend {
+		headers = append(headers, "SPEND")
+	}
+	var rows [][]string
+	add := func(prefix string, n *treeNode) {
+		row := []string{prefix + output.Truncate(n.Name, 45), n.ID, output.Status(n.Status), treeBudget(n)}
+		if treeSpend {
+			row = append(row, n.Spend+currency)
+		}
+		rows = append(rows, row)
+	}
+	for _, c := range campaigns {
+		add("", c)
+		for i, s := range c.AdSets {
+			branch, indent := "├─ ", "│  "
+			if i == len(c.AdSets)-1 {
+				branch, indent = "└─ ", "   "
+			}
+			add(branch, s)
+			for j, a := range s.Ads {
+				if j == len(s.Ads)-1 {
+					add(indent+"└─ ", a)
+				} else {
+					add(indent+"├─ ", a)
+				}
+			}
+		}
+	}
+	output.PrintTable(headers, rows)
+	return nil
+}
+
+// treeBudget renders the daily or lifetime budget of n, or "-".
+func treeBudget(n *treeNode) string {
+	switch {
+	case n.DailyBudget != "" && n.DailyBudget != "0":
+		return output.FormatBudget(n.DailyBudget) + "/day"
+	case n.LifetimeBudget != "" && n.LifetimeBudget != "0":
+		return output.FormatBudget(n.LifetimeBudget) + " lifetime"
+	}
+	return "-"
+}
+
+// treeSpendByID returns the spend over --date-preset of every campaign, ad
+// set and ad under root, summed from ad-level insights.
+func treeSpendByID(root string) (map[string]float64, error) {
+	params := url.Values{}
+	params.Set("fields", "campaign_id,adset_id,ad_id,spend")
+	params.Set("level", "ad")
+	params.Set("date_preset", treeDatePreset)
+	spend := map[string]float64{}
+	err := client.GetEach("/"+root+"/insights", params, func(raw json.RawMessage) error {
+		var row struct {
+			CampaignID string         `json:"campaign_id"`
+			AdSetID    string         `json:"adset_id"`
+			AdID       string         `json:"ad_id"`
+			Spend      api.FlexString `json:"spend"`
+		}
+		if err := json.Unmarshal(raw, &row); err != nil {
+			return fmt.Errorf("parsing insight: %w", err)
+		}
+		v, _ := strconv.ParseFloat(row.Spend.String(), 64)
+		spend[row.CampaignID] += v
+		spend[row.AdSetID] += v
+		spend[row.AdID] += v
+		return nil
+	})
+	return spend, err
+}
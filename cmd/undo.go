@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/config"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	undoLast bool
+	undoID   string
+)
+
+var undoCmd = &cobra.Command{
+	Use:   "undo --last | --id <audit_id>",
+	Short: "Restore the values a logged update or pause overwrote",
+	Long: `Revert a change recorded in the audit log (see: meta-ads audit list) by
+setting the object's status, name, budgets, bid amount, spend cap or end time
+back to the values they had before it.
+
+--last reverts the most recent change that can be undone and hasn't been yet.
+Creations and deletions can't be undone, and neither can changes made in
+batches (bulk) or before the audit log recorded previous values.
+
+Examples:
+  meta-ads campaigns pause 120210000000000   # oops, wrong campaign
+  meta-ads undo --last
+  meta-ads undo --id lx3k9q0a2b`,
+	Args: cobra.NoArgs,
+	RunE: runUndo,
+}
+
+func init() {
+	undoCmd.Flags().BoolVar(&undoLast, "last", false, "Undo the most recent undoable change")
+	undoCmd.Flags().StringVar(&undoID, "id", "", "Undo the change with this audit log ID")
+	undoCmd.MarkFlagsMutuallyExclusive("last", "id")
+	rootCmd.AddCommand(undoCmd)
+}
+
+func runUndo(cmd *cobra.Command, args []string) error {
+	if !undoLast && undoID == "" {
+		return usageError("pass --last or --id <audit_id> (IDs are shown by: meta-ads audit list)")
+	}
+	entries, err := readAuditLog(config.AuditLogPath(), func(string, auditEntry) bool { return true })
+	if err != nil {
+		return err
+	}
+	undone := map[string]bool{}
+	for _, e := range entries {
+		if e.UndoOf != "" && e.Result == "ok" {
+			undone[e.UndoOf] = true
+		}
+	}
+
+	var target *auditEntry
+	if undoID != "" {
+		for i := range entries {
+			if entries[i].ID == undoID {
+				target = &entries[i]
+				break
+			}
+		}
+		switch {
+		case target == nil:
+			return fmt.Errorf("no audit log entry %s", undoID)
+		case target.Result != "ok":
+			return fmt.Errorf("change %s failed — there is nothing to undo", undoID)
+		case len(target.Previous) == 0:
+			return fmt.Errorf("change %s (%s) has no previous values recorded and can't be undone", undoID, target.Command)
+		case undone[undoID]:
+			return fmt.Errorf("change %s was already undone", undoID)
+		}
+	} else {
+		for i := len(entries) - 1; i >= 0; i-- {
+			e := &entries[i]
+			if e.Result == "ok" && len(e.Previous) > 0 && e.UndoOf == "" && !undone[e.ID] {
+				target = e
+				break
+			}
+		}
+		if target == nil {
+			return fmt.Errorf("no change to undo in %s", config.AuditLogPath())
+		}
+	}
+
+	body := url.Values{}
+	var changes, skipped []string
+	keys := make([]string, 0, len(target.Previous))
+	for k := range target.Previous {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		prev := target.Previous[k]
+		if prev == "" {
+			// The field was unset before; the API can't clear it back.
+			skipped = append(skipped, k)
+			continue
+		}
+		body.Set(k, prev)
+		changes = append(changes, fmt.Sprintf("%s: %s → %s", k, target.Fields[k], prev))
+	}
+	if len(body) == 0 {
+		return fmt.Errorf("change %s only set fields that were empty before (%s) — nothing to restore", target.ID, strings.Join(skipped, ", "))
+	}
+	if len(skipped) > 0 {
+		fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("warning: %s were empty before the change and are left as they are", strings.Join(skipped, ", "))))
+	}
+
+	if err := confirm(fmt.Sprintf("Undo %q on %s from %s (%s)", target.Command, objectLabel(target.ObjectID), output.FormatTime(target.Time), strings.Join(changes, ", "))); err != nil {
+		return err
+	}
+
+	auditUndoOf = target.ID
+	resp, err := client.Post("/"+target.ObjectID, body)
+	auditUndoOf = ""
+	if err != nil {
+		return err
+	}
+
+	if !output.IsTable(cmd) {
+		return output.Print(cmd, json.RawMessage(resp))
+	}
+	fmt.Printf("✓ Undid %s on %s\n", target.Command, target.ObjectID)
+	for _, c := range changes {
+		fmt.Printf("  %s\n", c)
+	}
+	return nil
+}
@@ -0,0 +1,217 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/api"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+var (
+	watchInterval time.Duration
+	watchLevels   []string
+)
+
+var watchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Print a live feed of status, budget and review changes",
+	Long: `Poll the campaigns, ad sets and ads of an account every --interval and print
+what changed since the previous poll: status flips, budget changes, new
+disapprovals, and objects created or removed.
+
+The first poll only records the current state. With --json (or whenever
+output is piped) each change is printed as one JSON line, ready to feed into
+alerting.
+
+Examples:
+  meta-ads watch --account act_123456789 --interval 60s
+  meta-ads watch --level ad --json | jq -c 'select(.type == "disapproved")'`,
+	Args: cobra.NoArgs,
+	RunE: runWatch,
+}
+
+func init() {
+	watchCmd.Flags().DurationVar(&watchInterval, "interval", time.Minute, "Time between polls (minimum 10s)")
+	watchCmd.Flags().StringSliceVar(&watchLevels, "level", []string{"campaign", "adset", "ad"}, "Levels to watch: campaign, adset, ad")
+	rootCmd.AddCommand(watchCmd)
+}
+
+// watchedObject is the state of a campaign, ad set or ad compared between polls.
+type watchedObject struct {
+	ID               string         `json:"id"`
+	Name             string         `json:"name"`
+	EffectiveStatus  string         `json:"effective_status"`
+	DailyBudget      api.FlexString `json:"daily_budget"`
+	LifetimeBudget   api.FlexString `json:"lifetime_budget"`
+	AdReviewFeedback struct {
+		Global map[string]string `json:"global"`
+	} `json:"ad_review_feedback"`
+}
+
+// watchFields are the fields polled at each level.
+var watchFields = map[string]string{
+	"campaign": "id,name,effective_status,daily_budget,lifetime_budget",
+	"adset":    "id,name,effective_status,daily_budget,lifetime_budget",
+	"ad":       "id,name,effective_status,ad_review_feedback",
+}
+
+// watchEvent is one change seen by watch.
+type watchEvent struct {
+	Time  string `json:"time"`
+	Level string `json:"level"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	// Type is created, removed, status, budget or disapproved.
+	Type   string `json:"type"`
+	Field  string `json:"field,omitempty"`
+	From   string `json:"from,omitempty"`
+	To     string `json:"to,omitempty"`
+	Detail string `json:"detail,omitempty"`
+}
+
+func runWatch(cmd *cobra.Command, args []string) error {
+	account, err := resolveAccount()
+	if err != nil {
+		return err
+	}
+	if watchInterval < 10*time.Second {
+		return usageError("--interval must be at least 10s")
+	}
+	for _, l := range watchLevels {
+		if watchFields[l] == "" {
+			return usageError("invalid --level %q: must be campaign, adset or ad", l)
+		}
+	}
+	useAccountCurrency(account)
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	// state holds the objects seen at the last successful poll of each level.
+	state := map[string]map[string]watchedObject{}
+	for {
+		now := time.Now()
+		for _, level := range watchLevels {
+			var objects []watchedObject
+			if err := getAllInto("/"+account+"/"+level+"s", watchFields[level], &objects); err != nil {
+				fmt.Fprintln(os.Stderr, output.Warn(fmt.Sprintf("%s %ss: %v", now.Format("15:04:05"), level, err)))
+				logger.Warn("poll failed", "level", level, "error", err.Error())
+				continue
+			}
+			current := make(map[string]watchedObject, len(objects))
+			for _, o := range objects {
+				current[o.ID] = o
+			}
+			previous, seen := state[level]
+			state[level] = current
+			if !seen {
+				if output.IsTable(cmd) {
+					fmt.Fprintf(os.Stderr, "Watching %d %s(s) in %s\n", len(current), levelLabel(level), account)
+				}
+				logger.Info("watch started", "account", account, "level", level, "objects", len(current))
+				continue
+			}
+
+			for _, e := range diffWatched(level, previous, current, now) {
+				logger.Info("change detected", "level", e.Level, "id", e.ID, "type", e.Type, "field", e.Field, "from", e.From, "to", e.To)
+				if !output.IsTable(cmd) {
+					if err := output.PrintLine(e); err != nil {
+						return err
+					}
+					continue
+				}
+				printWatchEvent(e)
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchInterval):
+		}
+	}
+}
+
+// diffWatched returns the changes between two polls of a level, sorted by object ID.
+func diffWatched(level string, previous, current map[string]watchedObject, now time.Time) []watchEvent {
+	ts := now.UTC().Format(time.RFC3339)
+	var events []watchEvent
+	add := func(o watchedObject, typ, field, from, to, detail string) {
+		events = append(events, watchEvent{Time: ts, Level: level, ID: o.ID, Name: o.Name, Type: typ, Field: field, From: from, To: to, Detail: detail})
+	}
+
+	for id, o := range current {
+		old, ok := previous[id]
+		if !ok {
+			add(o, "created", "", "", o.EffectiveStatus, "")
+			continue
+		}
+		if old.EffectiveStatus != o.EffectiveStatus {
+			if o.EffectiveStatus == "DISAPPROVED" {
+				add(o, "disapproved", "effective_status", old.EffectiveStatus, o.EffectiveStatus, reviewReasons(o))
+			} else {
+				add(o, "status", "effective_status", old.EffectiveStatus, o.EffectiveStatus, "")
+			}
+		}
+		if old.DailyBudget.String() != o.DailyBudget.String() {
+			add(o, "budget", "daily_budget", old.DailyBudget.String(), o.DailyBudget.String(), "")
+		}
+		if old.LifetimeBudget.String() != o.LifetimeBudget.String() {
+			add(o, "budget", "lifetime_budget", old.LifetimeBudget.String(), o.LifetimeBudget.String(), "")
+		}
+	}
+	for id, o := range previous {
+		if _, ok := current[id]; !ok {
+			add(o, "removed", "", o.EffectiveStatus, "", "")
+		}
+	}
+
+	sort.SliceStable(events, func(i, j int) bool { return events[i].ID < events[j].ID })
+	return events
+}
+
+// reviewReasons summarizes the review feedback of a disapproved ad.
+func reviewReasons(o watchedObject) string {
+	reasons := make([]string, 0, len(o.AdReviewFeedback.Global))
+	for k, v := range o.AdReviewFeedback.Global {
+		reasons = append(reasons, k+": "+v)
+	}
+	sort.Strings(reasons)
+	return strings.Join(reasons, "; ")
+}
+
+// levelLabel returns the display name of a level.
+func levelLabel(level string) string {
+	if level == "adset" {
+		return "ad set"
+	}
+	return level
+}
+
+// printWatchEvent prints a change for the terminal.
+func printWatchEvent(e watchEvent) {
+	var change string
+	switch e.Type {
+	case "created":
+		change = output.Green("created") + " (" + e.To + ")"
+	case "removed":
+		change = output.Yellow("removed") + " (was " + e.From + ")"
+	case "budget":
+		change = fmt.Sprintf("%s %s → %s", strings.TrimSuffix(e.Field, "_budget")+" budget", output.FormatBudget(e.From), output.FormatBudget(e.To))
+	case "disapproved":
+		change = output.Red("DISAPPROVED") + " (was " + e.From + ")"
+		if e.Detail != "" {
+			change += ": " + e.Detail
+		}
+	default:
+		change = fmt.Sprintf("%s → %s", e.From, e.To)
+	}
+	fmt.Printf("%s  %-8s %s (%s)  %s\n", time.Now().Format("15:04:05"), levelLabel(e.Level), output.Truncate(e.Name, 40), e.ID, change)
+}
@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/the20100/meta-ads-cli/internal/output"
+)
+
+// webhookVerifyTokenEnv holds the verify token of webhooks listen.
+const webhookVerifyTokenEnv = "META_WEBHOOK_VERIFY_TOKEN"
+
+var (
+	webhooksPort        int
+	webhooksPath        string
+	webhooksVerifyToken string
+	webhooksAppSecret   string
+	webhooksForward     string
+	webhooksFields      []string
+)
+
+var webhooksCmd = &cobra.Command{
+	Use:   "webhooks",
+	Short: "Receive Meta webhook events",
+}
+
+var webhooksListenCmd = &cobra.Command{
+	Use:   "listen",
+	Short: "Receive leadgen and ad account webhooks and print them as NDJSON",
+	Long: `Run the endpoint Meta delivers webhooks to: answer the subscription
+verification with --verify-token, check the X-Hub-Signature-256 of every
+delivery against the app secret, and print each change as one JSON line:
+
+  {"received":"...","object":"page","entry_id":"<page_id>","time":1700000000,
+   "field":"leadgen","value":{"leadgen_id":"...","form_id":"...",...}}
+
+Expose the port publicly (reverse proxy or tunnel) and use its URL as the
+callback URL of the app's Page (leadgen) or Ad Account webhooks. Use --forward
+to also POST each event to another URL, e.g. a chat or CRM hook.
+
+The app secret defaults to META_APP_SECRET or the stored one; no access token
+is needed. Fetch the lead itself with: meta-ads leads download --form <form_id>.
+
+Examples:
+  meta-ads webhooks listen --port 8787 --verify-token s3cret
+  meta-ads webhooks listen --field leadgen --forward https://hooks.example.com/leads`,
+	Args: cobra.NoArgs,
+	RunE: runWebhooksListen,
+}
+
+func init() {
+	webhooksListenCmd.Flags().IntVar(&webhooksPort, "port", 8787, "Port to listen on")
+	webhooksListenCmd.Flags().StringVar(&webhooksPath, "path", "/", "Path of the callback URL")
+	webhooksListenCmd.Flags().StringVar(&webhooksVerifyToken, "verify-token", "", "Verify token set in the app's webhook settings (default: $"+webhookVerifyTokenEnv+")")
+	webhooksListenCmd.Flags().StringVar(&webhooksAppSecret, "app-secret", "", "App secret used to check signatures (default: META_APP_SECRET or the stored one)")
+	webhooksListenCmd.Flags().StringVar(&webhooksForward, "forward", "", "Also POST each event as JSON to this URL")
+	webhooksListenCmd.Flags().StringSliceVar(&webhooksFields, "field", nil, "Only print changes of these fields, e.g. leadgen (default: all)")
+	webhooksCmd.AddCommand(webhooksListenCmd)
+	rootCmd.AddCommand(webhooksCmd)
+}
+
+// webhookEvent is one change of a webhook delivery.
+type webhookEvent struct {
+	Received string          `json:"received"`
+	Object   string          `json:"object"`
+	EntryID  string          `json:"entry_id"`
+	Time     int64           `json:"time"`
+	Field    string          `json:"field"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// webhookPayload is the body of a webhook delivery.
+type webhookPayload struct {
+	Object string `json:"object"`
+	Entry  []struct {
+		ID      string `json:"id"`
+		Time    int64  `json:"time"`
+		Changes []struct {
+			Field string          `json:"field"`
+			Value json.RawMessage `json:"value"`
+		} `json:"changes"`
+	} `json:"entry"`
+}
+
+func runWebhooksListen(cmd *cobra.Command, args []string) error {
+	// The path becomes a ServeMux pattern, which panics on anything else.
+	if !strings.HasPrefix(webhooksPath, "/") || strings.ContainsAny(webhooksPath, "{} \t") {
+		return usageError("invalid --path %q: use an absolute path such as /webhook, without braces or spaces", webhooksPath)
+	}
+	verifyToken := webhooksVerifyToken
+	if verifyToken == "" {
+		verifyToken = os.Getenv(webhookVerifyTokenEnv)
+	}
+	if verifyToken == "" {
+		return usageError("--verify-token is required (or set %s)", webhookVerifyTokenEnv)
+	}
+	appSecret := webhooksAppSecret
+	if appSecret == "" {
+		appSecret = resolveEnv(appSecretEnvVars...)
+	}
+	if appSecret == "" {
+		loadConfig()
+		if cfg != nil {
+			appSecret = cfg.AppSecret
+		}
+	}
+	if appSecret == "" {
+		return usageError("--app-secret is required to check webhook signatures (or set META_APP_SECRET)")
+	}
+
+	l := &webhookListener{verifyToken: verifyToken, appSecret: appSecret, fields: map[string]bool{}}
+	for _, f := range webhooksFields {
+		l.fields[strings.TrimSpace(f)] = true
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+webhooksPath, l.handleVerify)
+	mux.HandleFunc("POST "+webhooksPath, l.handleDelivery)
+
+	addr := ":" + strconv.Itoa(webhooksPort)
+	srv := &http.Server{
+		Addr:              addr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		shutdown, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		_ = srv.Shutdown(shutdown)
+	}()
+
+	fmt.Fprintf(os.Stderr, "Listening for webhooks on %s%s\n", addr, webhooksPath)
+	if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// webhookListener receives webhook deliveries. Events are printed one at a
+// time so lines from concurrent deliveries don't interleave.
+type webhookListener struct {
+	verifyToken string
+	appSecret   string
+	fields      map[string]bool
+	mu          sync.Mutex
+}
+
+// handleVerify answers the subscription handshake Meta sends when the
+// callback URL is saved.
+func (l *webhookListener) handleVerify(w http.ResponseWriter, r *http.Request) {
+	q := r.URL.Query()
+	if q.Get("hub.mode") != "subscribe" || subtle.ConstantTimeCompare([]byte(q.Get("hub.verify_token")), []byte(l.verifyToken)) != 1 {
+		fmt.Fprintln(os.Stderr, output.Warn("rejected a verification request with a wrong verify token"))
+		http.Error(w, "verification failed", http.StatusForbidden)
+		return
+	}
+	fmt.Fprintln(os.Stderr, "Subscription verified")
+	_, _ = io.WriteString(w, q.Get("hub.challenge"))
+}
+
+func (l *webhookListener) handleDelivery(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+	if err != nil {
+		http.Error(w, "reading body", http.StatusBadRequest)
+		return
+	}
+	if !validWebhookSignature(body, r.Header.Get("X-Hub-Signature-256"), l.appSecret) {
+		fmt.Fprintln(os.Stderr, output.Warn("rejected a delivery with an invalid signature"))
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+	var p webhookPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		http.Error(w, "invalid JSON", http.StatusBadRequest)
+		return
+	}
+	// Acknowledge first: Meta retries deliveries that aren't answered quickly.
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+
+	received := time.Now().UTC().Format(time.RFC3339)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, e := range p.Entry {
+		for _, c := range e.Changes {
+			if len(l.fields) > 0 && !l.fields[c.Field] {
+				continue
+			}
+			ev := webhookEvent{Received: received, Object: p.Object, EntryID: e.ID, Time: e.Time, Field: c.Field, Value: c.Value}
+			if err := output.PrintLine(ev); err != nil {
+				fmt.Fprintln(os.Stderr, output.Warn("printing event: "+err.Error()))
+			}
+			if webhooksForward != "" {
+				if err := forwardWebhookEvent(ev); err != nil {
+					fmt.Fprintln(os.Stderr, output.Warn("forwarding event: "+err.Error()))
+				}
+			}
+		}
+	}
+}
+
+// validWebhookSignature checks the "sha256=<hex>" HMAC of body Meta signs
+// deliveries with.
+func validWebhookSignature(body []byte, header, appSecret string) bool {
+	sig, ok := strings.CutPrefix(header, "sha256=")
+	if !ok {
+		return false
+	}
+	got, err := hex.DecodeString(sig)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(appSecret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// forwardWebhookEvent posts ev as JSON to --forward.
+func forwardWebhookEvent(ev webhookEvent) error {
+	payload, _ := json.Marshal(ev)
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	resp, err := httpClient.Post(webhooksForward, "application/json", bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+)
+
+// BatchMax is the maximum number of requests Meta accepts in one batch call.
+const BatchMax = 50
+
+// BatchRequest is one request of a batch call.
+type BatchRequest struct {
+	Method      string `json:"method"`
+	RelativeURL string `json:"relative_url"`
+	// Body is the form-encoded body of POST requests.
+	Body string `json:"body,omitempty"`
+}
+
+// BatchResponse is the result of one request of a batch call.
+type BatchResponse struct {
+	Code int    `json:"code"`
+	Body string `json:"body"`
+}
+
+// Err returns the Meta error of the response, or nil when it succeeded.
+func (r BatchResponse) Err() error {
+	var errResp struct {
+		Error *MetaError `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(r.Body), &errResp); err == nil && errResp.Error != nil {
+		return errResp.Error
+	}
+	if r.Code >= 400 {
+		return fmt.Errorf("HTTP %d: %s", r.Code, r.Body)
+	}
+	return nil
+}
+
+// Batch runs up to BatchMax requests in a single call. Responses are in
+// request order; a request that Meta didn't run (e.g. timed out) gets an
+// empty response with code 0.
+func (c *Client) Batch(requests []BatchRequest) ([]BatchResponse, error) {
+	if len(requests) > BatchMax {
+		return nil, fmt.Errorf("batch of %d requests exceeds the maximum of %d", len(requests), BatchMax)
+	}
+	if c.dryRun != nil {
+		out := make([]BatchResponse, len(requests))
+		for i, r := range requests {
+			params, _ := url.ParseQuery(r.Body)
+			out[i] = BatchResponse{Code: 200, Body: string(c.skipMutation(r.Method, "/"+r.RelativeURL, params))}
+		}
+		return out, nil
+	}
+	out, err := c.sendBatch(requests)
+	c.recordBatch(requests, out, err)
+	return out, err
+}
+
+// BatchGet runs up to BatchMax reads in a single call, given as relative
+// URLs such as "act_123/insights?fields=spend", e.g. to read the same edge of
+// many objects. Unlike Batch, it is sent in dry-run mode too, and its
+// requests aren't reported as mutations.
+func (c *Client) BatchGet(relativeURLs []string) ([]BatchResponse, error) {
+	if len(relativeURLs) > BatchMax {
+		return nil, fmt.Errorf("batch of %d requests exceeds the maximum of %d", len(relativeURLs), BatchMax)
+	}
+	requests := make([]BatchRequest, len(relativeURLs))
+	for i, u := range relativeURLs {
+		requests[i] = BatchRequest{Method: "GET", RelativeURL: u}
+	}
+	return c.sendBatch(requests)
+}
+
+// sendBatch sends requests in one batch call and returns their responses in
+// request order.
+func (c *Client) sendBatch(requests []BatchRequest) ([]BatchResponse, error) {
+	data, err := json.Marshal(requests)
+	if err != nil {
+		return nil, err
+	}
+	body := url.Values{}
+	body.Set("batch", string(data))
+
+	resp, err := c.post("/", body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Entries are null for requests that weren't processed.
+	var raw []*BatchResponse
+	if err := json.Unmarshal(resp, &raw); err != nil {
+		return nil, fmt.Errorf("parsing batch response: %w", err)
+	}
+	out := make([]BatchResponse, len(requests))
+	for i := range out {
+		if i < len(raw) && raw[i] != nil {
+			out[i] = *raw[i]
+		}
+	}
+	return out, nil
+}
+
+// recordBatch logs each request of a batch and reports it to the mutation
+// hook, with its response, or with err when the whole batch failed.
+func (c *Client) recordBatch(requests []BatchRequest, responses []BatchResponse, err error) {
+	c.hookMu.Lock()
+	defer c.hookMu.Unlock()
+	for i, r := range requests {
+		params, _ := url.ParseQuery(r.Body)
+		m := Mutation{Method: r.Method, Path: "/" + r.RelativeURL, Params: params, Err: err}
+		if err == nil {
+			m.Response = []byte(responses[i].Body)
+			m.Err = responses[i].Err()
+			if responses[i].Code == 0 {
+				m.Err = errNotRun
+			}
+		}
+		c.logMutation(m)
+		if c.onMutation != nil {
+			c.onMutation(m)
+		}
+	}
+}
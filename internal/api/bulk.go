@@ -0,0 +1,161 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// errNotRun is the error of a batched request Meta didn't process, e.g.
+// because the batch timed out.
+var errNotRun = errors.New("not processed by Meta")
+
+// BulkItem is one mutation run by a BulkExecutor.
+type BulkItem struct {
+	Method string
+	// Path is relative to the API version, e.g. "/120210000000000".
+	Path   string
+	Params url.Values
+}
+
+// BulkResult is the outcome of one BulkItem.
+type BulkResult struct {
+	Response json.RawMessage
+	Err      error
+	// Attempts is how many times the item was sent.
+	Attempts int
+}
+
+// BulkExecutor runs many mutations through batch requests. Items are split
+// into chunks of BatchMax, Concurrency chunks are sent at a time, and items
+// that failed with a retryable error (throttling, a transient error on a
+// read, or a request Meta didn't run) are sent again, up to Retries times.
+type BulkExecutor struct {
+	Concurrency int
+	Retries     int
+	// Progress, when set, is called after each chunk with the number of
+	// items done so far.
+	Progress func(done, total int)
+
+	client *Client
+}
+
+// NewBulkExecutor returns a BulkExecutor sending 4 chunks at a time.
+func (c *Client) NewBulkExecutor() *BulkExecutor {
+	return &BulkExecutor{Concurrency: 4, Retries: maxRetries, client: c}
+}
+
+// Run sends items and returns their results in the same order.
+func (b *BulkExecutor) Run(items []BulkItem) []BulkResult {
+	results := make([]BulkResult, len(items))
+	pending := make([]int, len(items))
+	for i := range pending {
+		pending[i] = i
+	}
+	for round := 0; ; round++ {
+		b.send(items, results, pending)
+		if round >= b.Retries {
+			return results
+		}
+		var retry []int
+		var wait time.Duration
+		for _, i := range pending {
+			if d, ok := itemRetryDelay(items[i], results[i], round); ok {
+				retry = append(retry, i)
+				wait = maxDuration(wait, d)
+			}
+		}
+		if len(retry) == 0 {
+			return results
+		}
+		fmt.Fprintf(os.Stderr, "⚠️  %d of %d request(s) failed — retrying them in %s\n", len(retry), len(items), wait.Round(time.Second))
+		b.client.countStat(&b.client.stats.Retries, len(retry))
+		b.client.log().Warn("retrying failed batch items", "items", len(retry), "of", len(items), "wait", wait.String(), "round", round+1)
+		time.Sleep(wait)
+		pending = retry
+	}
+}
+
+// send runs the items at indexes pending, recording their results.
+func (b *BulkExecutor) send(items []BulkItem, results []BulkResult, pending []int) {
+	c := b.client
+	var chunks [][]int
+	for start := 0; start < len(pending); start += BatchMax {
+		chunks = append(chunks, pending[start:min(start+BatchMax, len(pending))])
+	}
+
+	var mu sync.Mutex
+	done := 0
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, max(b.Concurrency, 1))
+	for _, chunk := range chunks {
+		requests := make([]BatchRequest, len(chunk))
+		for n, i := range chunk {
+			requests[n] = items[i].batchRequest()
+			if c.dryRun == nil {
+				c.announceMutation(Mutation{Method: items[i].Method, Path: items[i].Path, Params: cloneValues(items[i].Params)})
+			}
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(chunk []int, requests []BatchRequest) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			responses, err := c.Batch(requests)
+			mu.Lock()
+			defer mu.Unlock()
+			for n, i := range chunk {
+				r := &results[i]
+				r.Attempts++
+				r.Response, r.Err = nil, err
+				if err != nil {
+					continue
+				}
+				if r.Err = responses[n].Err(); r.Err == nil && responses[n].Code == 0 {
+					r.Err = errNotRun
+				}
+				if r.Err == nil {
+					r.Response = json.RawMessage(responses[n].Body)
+				}
+			}
+			done += len(chunk)
+			if b.Progress != nil {
+				b.Progress(done, len(pending))
+			}
+		}(chunk, requests)
+	}
+	wg.Wait()
+}
+
+// batchRequest returns item as a request of a batch call.
+func (item BulkItem) batchRequest() BatchRequest {
+	r := BatchRequest{Method: item.Method, RelativeURL: strings.TrimPrefix(item.Path, "/")}
+	if item.Method == http.MethodPost {
+		r.Body = item.Params.Encode()
+	} else if len(item.Params) > 0 {
+		r.RelativeURL += "?" + item.Params.Encode()
+	}
+	return r
+}
+
+// itemRetryDelay returns how long to wait before sending again an item that
+// failed in the given round (from 0), and false when it shouldn't be.
+func itemRetryDelay(item BulkItem, r BulkResult, round int) (time.Duration, bool) {
+	if r.Err == nil {
+		return 0, false
+	}
+	if errors.Is(r.Err, errNotRun) {
+		return time.Second << round, round < maxRetries
+	}
+	var metaErr *MetaError
+	if !errors.As(r.Err, &metaErr) {
+		return 0, false
+	}
+	return retryDelay(metaErr, nil, item.Method, round)
+}
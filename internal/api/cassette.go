@@ -0,0 +1,171 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// cassette is the file written by SetRecord and read by SetReplay.
+type cassette struct {
+	RecordedAt   string        `json:"recorded_at"`
+	Interactions []interaction `json:"interactions"`
+}
+
+// interaction is one recorded HTTP exchange. The request identifies it on
+// replay; neither holds credentials (see redactBody).
+type interaction struct {
+	Request  traceRequest  `json:"request"`
+	Response traceResponse `json:"response"`
+}
+
+// key identifies the request of an interaction: its method, its URL and its
+// form.
+func (r traceRequest) key() string {
+	form, _ := json.Marshal(r.Form)
+	return r.Method + " " + r.URL + " " + string(form)
+}
+
+// cassetteRequest returns req as recorded in a cassette. Secret parameters
+// are left out rather than redacted, so a cassette replays whatever the
+// token and app secret.
+func cassetteRequest(req *http.Request) traceRequest {
+	u := *req.URL
+	query := u.Query()
+	for k := range query {
+		if IsSecretParam(k) {
+			query.Del(k)
+		}
+	}
+	u.RawQuery = query.Encode()
+	form := traceRequestForm(req)
+	for k := range form {
+		if IsSecretParam(k) {
+			delete(form, k)
+		}
+	}
+	return traceRequest{Method: req.Method, URL: u.String(), Form: form}
+}
+
+// SetRecord makes the client save every exchange with the Graph API to the
+// cassette file path, which SetReplay plays back later. The file is rewritten
+// after each response, so it is complete even when the command fails.
+func (c *Client) SetRecord(path string) error {
+	t := &recordTransport{next: c.httpClient.Transport, path: path}
+	t.cassette.RecordedAt = time.Now().UTC().Format(time.RFC3339)
+	if err := t.save(); err != nil {
+		return err
+	}
+	c.httpClient.Transport = t
+	return nil
+}
+
+// recordTransport is the http.RoundTripper of SetRecord.
+type recordTransport struct {
+	next http.RoundTripper
+	path string
+
+	mu       sync.Mutex
+	cassette cassette
+}
+
+func (t *recordTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded := cassetteRequest(req)
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+
+	r := traceResponse{Status: resp.StatusCode, Headers: traceHeaders(resp.Header), Body: redactBody(body)}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cassette.Interactions = append(t.cassette.Interactions, interaction{Request: recorded, Response: r})
+	if err := t.save(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// save writes the cassette to its file.
+func (t *recordTransport) save() error {
+	var data bytes.Buffer
+	enc := json.NewEncoder(&data)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(t.cassette); err != nil {
+		return err
+	}
+	if err := os.WriteFile(t.path, data.Bytes(), 0o600); err != nil {
+		return fmt.Errorf("writing cassette: %w", err)
+	}
+	return nil
+}
+
+// SetReplay makes the client answer every request from the cassette file
+// path recorded by SetRecord, without calling the Graph API. Each request is
+// answered by the next unused interaction with the same method, URL and form
+// (credentials aside), so a command replays exactly as recorded; a request
+// the cassette doesn't hold fails.
+func (c *Client) SetReplay(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("reading cassette: %w", err)
+	}
+	var cas cassette
+	if err := json.Unmarshal(data, &cas); err != nil {
+		return fmt.Errorf("parsing cassette %s: %w", path, err)
+	}
+	t := &replayTransport{path: path, pending: map[string][]traceResponse{}}
+	for _, in := range cas.Interactions {
+		k := in.Request.key()
+		t.pending[k] = append(t.pending[k], in.Response)
+	}
+	c.httpClient.Transport = t
+	return nil
+}
+
+// replayTransport is the http.RoundTripper of SetReplay.
+type replayTransport struct {
+	path string
+
+	mu      sync.Mutex
+	pending map[string][]traceResponse
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	recorded := cassetteRequest(req)
+	k := recorded.key()
+	t.mu.Lock()
+	responses := t.pending[k]
+	if len(responses) == 0 {
+		t.mu.Unlock()
+		// The error names the request already.
+		return nil, fmt.Errorf("no recorded response in cassette %s", t.path)
+	}
+	r := responses[0]
+	t.pending[k] = responses[1:]
+	t.mu.Unlock()
+
+	body := []byte(r.Body)
+	var s string
+	if json.Unmarshal(r.Body, &s) == nil {
+		// Recorded as a string because it wasn't JSON.
+		body = []byte(s)
+	}
+	resp := mockResponse(req, r.Status, body)
+	for name, value := range r.Headers {
+		resp.Header.Set(name, value)
+	}
+	return resp, nil
+}
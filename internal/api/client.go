@@ -4,36 +4,147 @@ import (
 	"crypto/hmac"
 	"crypto/sha256"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"sync"
 	"time"
 )
 
-const baseURL = "https://graph.facebook.com/v25.0"
+// DefaultVersion is the Graph API version used unless SetVersion is called.
+const DefaultVersion = "v25.0"
+
+const graphURL = "https://graph.facebook.com/"
 
 // Client is an authenticated Meta Graph API client.
 type Client struct {
 	token      string
 	appSecret  string
 	httpClient *http.Client
+	baseURL    string
+
+	// lastUsage holds the rate-limit headers of the most recent response,
+	// and accountUsage the latest business use case usage of each ad
+	// account (see AccountUsage). mu guards them, dryRuns and the trace
+	// counters: requests may run concurrently.
+	mu           sync.Mutex
+	lastUsage    *Usage
+	accountUsage map[string]int
+
+	// dryRun receives the mutations that are printed instead of sent; see SetDryRun.
+	dryRun  io.Writer
+	dryRuns int
+
+	// beforeMutation and onMutation are called around every POST and DELETE;
+	// see BeforeMutation and OnMutation.
+	beforeMutation func(Mutation)
+	onMutation     func(Mutation)
+	// hookMu serializes the calls to the mutation hooks, which concurrent
+	// batches would otherwise make at the same time.
+	hookMu sync.Mutex
+
+	// logger receives the structured logs of the client; see SetLogger.
+	logger *slog.Logger
+
+	// traceDir receives a file per HTTP exchange; see SetTraceDir. traces
+	// numbers them and traceWarned is set once writing one failed; mu
+	// guards both.
+	traceDir    string
+	traces      int
+	traceWarned bool
+
+	// stats counts the API activity; mu guards it. See Stats.
+	stats Stats
+
+	// onPage is called as GetPages fetches several pages; see OnPage.
+	onPage func(PageProgress)
+}
+
+// Defaults of HTTPOptions.
+const (
+	DefaultTimeout      = 30 * time.Second
+	DefaultIdleTimeout  = 90 * time.Second
+	DefaultMaxIdleConns = 10
+)
+
+// HTTPOptions tunes the HTTP connections of a Client. Zero values select the
+// defaults.
+type HTTPOptions struct {
+	// Timeout bounds each request, reading the response included.
+	Timeout time.Duration
+	// IdleTimeout is how long an idle connection is kept open for reuse.
+	IdleTimeout time.Duration
+	// DisableKeepAlives opens a new connection for every request.
+	DisableKeepAlives bool
+	// MaxIdleConns is how many idle connections are kept open. All requests
+	// go to the same host, so it is also the limit per host.
+	MaxIdleConns int
 }
 
 // NewClient creates a new authenticated Client.
 // appSecret is optional but enables appsecret_proof for server-side calls.
-func NewClient(token, appSecret string) *Client {
+func NewClient(token, appSecret string, opts HTTPOptions) *Client {
+	if opts.Timeout <= 0 {
+		opts.Timeout = DefaultTimeout
+	}
+	if opts.IdleTimeout <= 0 {
+		opts.IdleTimeout = DefaultIdleTimeout
+	}
+	if opts.MaxIdleConns <= 0 {
+		opts.MaxIdleConns = DefaultMaxIdleConns
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.IdleConnTimeout = opts.IdleTimeout
+	transport.DisableKeepAlives = opts.DisableKeepAlives
+	transport.MaxIdleConns = opts.MaxIdleConns
+	transport.MaxIdleConnsPerHost = opts.MaxIdleConns
 	return &Client{
 		token:     token,
 		appSecret: appSecret,
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   opts.Timeout,
+			Transport: transport,
 		},
+		baseURL: graphURL + DefaultVersion,
+	}
+}
+
+// SetVersion selects the Graph API version (e.g. "v23.0") for subsequent requests.
+func (c *Client) SetVersion(version string) {
+	if !strings.HasPrefix(version, "v") {
+		version = "v" + version
 	}
+	c.baseURL = graphURL + version
 }
 
+// URL returns the full Graph API URL of path (e.g. "/123/events") at the
+// selected version.
+func (c *Client) URL(path string) string {
+	return c.baseURL + path
+}
+
+// SetLogger makes the client log every request (debug), retry (warn) and
+// failed mutation (error) to logger. A nil logger turns logging off.
+func (c *Client) SetLogger(logger *slog.Logger) {
+	c.logger = logger
+}
+
+// log returns the logger of the client, discarding when none is set.
+func (c *Client) log() *slog.Logger {
+	if c.logger == nil {
+		return discardLogger
+	}
+	return c.logger
+}
+
+var discardLogger = slog.New(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: slog.LevelError + 1}))
+
 // appSecretProof computes HMAC-SHA256(token, appSecret) as a hex string.
 func (c *Client) appSecretProof() string {
 	if c.appSecret == "" {
@@ -54,50 +165,75 @@ func (c *Client) baseParams() url.Values {
 	return params
 }
 
-// checkRateLimit reads X-Business-Use-Case-Usage and warns to stderr if high.
-func checkRateLimit(headers http.Header) {
-	buc := headers.Get("X-Business-Use-Case-Usage")
-	if buc == "" {
-		return
-	}
-	// Shape: {"<id>":[{"call_count":N,"total_cputime":N,"total_time":N,"type":"..."}]}
-	var parsed map[string][]struct {
-		CallCount int `json:"call_count"`
-		TotalTime int `json:"total_time"`
-	}
-	if err := json.Unmarshal([]byte(buc), &parsed); err != nil {
-		return
-	}
-	for _, entries := range parsed {
-		for _, e := range entries {
-			if e.CallCount > 75 || e.TotalTime > 75 {
-				fmt.Fprintf(os.Stderr, "⚠️  Rate limit: %d%% used — slow down to avoid HTTP 613\n", max(e.CallCount, e.TotalTime))
+// doRequest executes an HTTP request and returns the body bytes.
+// It handles Meta error responses and rate limit warnings, and sends the
+// request again after throttling and transient errors (see retryDelay).
+func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		body, usage, err := c.send(req)
+		var metaErr *MetaError
+		if err == nil || !errors.As(err, &metaErr) {
+			return body, err
+		}
+		if metaErr.Code == codeInvalidToken {
+			return nil, fmt.Errorf("%w\n%s", err, tokenErrorHint)
+		}
+		wait, retry := retryDelay(metaErr, usage, req.Method, attempt)
+		if !retry {
+			return nil, err
+		}
+		if wait > maxRetryWait {
+			return nil, fmt.Errorf("%w\nMeta expects calls to be accepted again in %s — try later", err, wait.Round(time.Minute))
+		}
+		if req.GetBody != nil {
+			if req.Body, err = req.GetBody(); err != nil {
+				return nil, err
 			}
 		}
+		fmt.Fprintf(os.Stderr, "⚠️  %v — retrying in %s (%d/%d)\n", metaErr, wait.Round(time.Second), attempt+1, maxRetries)
+		c.countStat(&c.stats.Retries, 1)
+		c.log().Warn("retrying graph request", "method", req.Method, "path", req.URL.Path,
+			"code", metaErr.Code, "wait", wait.String(), "attempt", attempt+1)
+		time.Sleep(wait)
 	}
 }
 
-func max(a, b int) int {
-	if a > b {
-		return a
+// send executes an HTTP request once and returns the body bytes and the
+// rate-limit usage of the response.
+func (c *Client) send(req *http.Request) ([]byte, *Usage, error) {
+	var traceForm map[string]string
+	if c.traceDir != "" {
+		traceForm = traceRequestForm(req)
 	}
-	return b
-}
-
-// doRequest executes an HTTP request and returns the body bytes.
-// It handles Meta error responses and rate limit warnings.
-func (c *Client) doRequest(req *http.Request) ([]byte, error) {
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return nil, fmt.Errorf("request failed: %w", err)
+		c.log().Debug("graph request", "method", req.Method, "path", req.URL.Path,
+			"duration_ms", time.Since(start).Milliseconds(), "error", err.Error())
+		if c.traceDir != "" {
+			c.writeTrace(req, traceForm, start, nil, nil, err)
+		}
+		c.countCall(requestSize(req), 0, time.Since(start), nil)
+		return nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	c.log().Debug("graph request", "method", req.Method, "path", req.URL.Path,
+		"status", resp.StatusCode, "duration_ms", time.Since(start).Milliseconds())
 
-	checkRateLimit(resp.Header)
+	usage := ParseUsage(resp.Header)
+	c.mu.Lock()
+	c.lastUsage = usage
+	c.recordAccountUsage(usage)
+	c.mu.Unlock()
+	checkRateLimit(usage)
 
 	body, err := io.ReadAll(resp.Body)
+	c.countCall(requestSize(req), int64(len(body)), time.Since(start), usage)
+	if c.traceDir != "" {
+		c.writeTrace(req, traceForm, start, resp, body, err)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("reading response: %w", err)
+		return nil, usage, fmt.Errorf("reading response: %w", err)
 	}
 
 	// Check for Meta API error in body (Meta returns 200 even for some errors)
@@ -105,19 +241,27 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 		Error *MetaError `json:"error"`
 	}
 	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != nil {
-		return nil, errResp.Error
+		return nil, usage, errResp.Error
 	}
 
 	if resp.StatusCode >= 400 {
-		return nil, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
+		return nil, usage, fmt.Errorf("HTTP %d: %s", resp.StatusCode, string(body))
 	}
 
-	return body, nil
+	return body, usage, nil
+}
+
+// LastUsage returns the rate-limit usage reported on the most recent response,
+// or nil if no request has been made yet.
+func (c *Client) LastUsage() *Usage {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.lastUsage
 }
 
 // Get makes an authenticated GET request to the given path with extra params.
 func (c *Client) Get(path string, params url.Values) ([]byte, error) {
-	reqURL, err := buildURL(path, c.baseParams(), params)
+	reqURL, err := buildURL(c.baseURL, path, c.baseParams(), params)
 	if err != nil {
 		return nil, err
 	}
@@ -133,7 +277,19 @@ func (c *Client) Get(path string, params url.Values) ([]byte, error) {
 
 // Post makes an authenticated POST request to the given path with form body.
 func (c *Client) Post(path string, body url.Values) ([]byte, error) {
-	reqURL, err := buildURL(path, c.baseParams(), nil)
+	if c.dryRun != nil {
+		return c.skipMutation(http.MethodPost, path, body), nil
+	}
+	fields := cloneValues(body)
+	c.announceMutation(Mutation{Method: http.MethodPost, Path: path, Params: fields})
+	resp, err := c.post(path, body)
+	c.recordMutation(Mutation{Method: http.MethodPost, Path: path, Params: fields, Response: resp, Err: err})
+	return resp, err
+}
+
+// post sends a POST request without reporting it to the mutation hook.
+func (c *Client) post(path string, body url.Values) ([]byte, error) {
+	reqURL, err := buildURL(c.baseURL, path, c.baseParams(), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -153,11 +309,73 @@ func (c *Client) Post(path string, body url.Values) ([]byte, error) {
 	return c.doRequest(req)
 }
 
+// Delete makes an authenticated DELETE request to the given path with extra params.
+func (c *Client) Delete(path string, params url.Values) ([]byte, error) {
+	if c.dryRun != nil {
+		return c.skipMutation(http.MethodDelete, path, params), nil
+	}
+	c.announceMutation(Mutation{Method: http.MethodDelete, Path: path, Params: cloneValues(params)})
+	reqURL, err := buildURL(c.baseURL, path, c.baseParams(), params)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest(http.MethodDelete, reqURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.doRequest(req)
+	c.recordMutation(Mutation{Method: http.MethodDelete, Path: path, Params: cloneValues(params), Response: resp, Err: err})
+	return resp, err
+}
+
 // GetAll fetches all pages of a list endpoint, following paging.next cursors.
 // Returns all items as raw JSON messages.
 func (c *Client) GetAll(path string, params url.Values) ([]json.RawMessage, error) {
+	return c.GetAllLimit(path, params, 0)
+}
+
+// GetAllLimit is GetAll stopping once limit items are collected (0 for all):
+// pages are followed until then, and none is larger than needed.
+func (c *Client) GetAllLimit(path string, params url.Values, limit int) ([]json.RawMessage, error) {
+	if limit > 0 && params.Get("limit") == "" {
+		params = cloneValues(params)
+		params.Set("limit", strconv.Itoa(min(limit, 100)))
+	}
 	var all []json.RawMessage
+	err := c.GetEach(path, params, func(item json.RawMessage) error {
+		all = append(all, item)
+		if len(all) == limit {
+			return StopPaging
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return all, nil
+}
+
+// StopPaging is returned by the function given to GetEach or GetPages to stop
+// fetching pages without an error.
+var StopPaging = errors.New("stop paging")
+
+// GetEach fetches all pages of a list endpoint like GetAll, but hands each item
+// to fn as soon as its page arrives instead of buffering the whole result.
+// Paging stops at the first error returned by fn, or without an error at
+// StopPaging.
+func (c *Client) GetEach(path string, params url.Values, fn func(item json.RawMessage) error) error {
+	_, err := c.GetPages(path, params, 0, fn)
+	return err
+}
 
+// GetPages is GetEach stopping after maxPages pages (0 for all). It returns
+// the cursor of the page after the last one fetched, to pass as the "after"
+// parameter later, or "" when there are no more pages or fn returned
+// StopPaging.
+func (c *Client) GetPages(path string, params url.Values, maxPages int, fn func(item json.RawMessage) error) (string, error) {
 	// Clone params to avoid mutating caller's map
 	p := url.Values{}
 	for k, v := range params {
@@ -169,33 +387,94 @@ func (c *Client) GetAll(path string, params url.Values) ([]json.RawMessage, erro
 
 	currentPath := path
 
-	for {
+	pages, items := 0, 0
+	if c.onPage != nil {
+		defer func() {
+			if pages > 1 {
+				c.onPage(PageProgress{Path: path, Pages: pages, Items: items, Done: true})
+			}
+		}()
+	}
+	for pages = 1; ; pages++ {
+		if c.onPage != nil && pages > 1 {
+			c.onPage(PageProgress{Path: path, Pages: pages - 1, Items: items})
+		}
 		body, err := c.Get(currentPath, p)
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 
+		c.countStat(&c.stats.Pages, 1)
+
 		var page struct {
 			Data   []json.RawMessage `json:"data"`
 			Paging *Paging           `json:"paging"`
 		}
 		if err := json.Unmarshal(body, &page); err != nil {
-			return nil, fmt.Errorf("parsing page: %w", err)
+			return "", fmt.Errorf("parsing page: %w", err)
 		}
+		items += len(page.Data)
 
-		all = append(all, page.Data...)
+		for _, item := range page.Data {
+			if err := fn(item); errors.Is(err, StopPaging) {
+				return "", nil
+			} else if err != nil {
+				return "", err
+			}
+		}
 
 		// No more pages
 		if page.Paging == nil || page.Paging.Next == "" {
-			break
+			return "", nil
+		}
+		if pages == maxPages {
+			return page.Paging.after(), nil
 		}
 
 		// Next page: use the full URL from paging.next (already includes access_token etc.)
 		currentPath = page.Paging.Next
 		p = url.Values{} // params are already embedded in the Next URL
 	}
+}
 
-	return all, nil
+// after returns the cursor of the next page, from the cursors or else from
+// the next URL.
+func (p *Paging) after() string {
+	if p.Cursors != nil && p.Cursors.After != "" {
+		return p.Cursors.After
+	}
+	if u, err := url.Parse(p.Next); err == nil {
+		return u.Query().Get("after")
+	}
+	return ""
+}
+
+// Count returns the total number of items of a list endpoint from
+// summary=total_count, fetching a single item instead of every page.
+func (c *Client) Count(path string, params url.Values) (int, error) {
+	p := url.Values{}
+	for k, v := range params {
+		p[k] = v
+	}
+	p.Set("fields", "id")
+	p.Set("limit", "1")
+	p.Set("summary", "total_count")
+	body, err := c.Get(path, p)
+	if err != nil {
+		return 0, err
+	}
+	var page struct {
+		Summary *struct {
+			TotalCount *int `json:"total_count"`
+		} `json:"summary"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		return 0, fmt.Errorf("parsing page: %w", err)
+	}
+	if page.Summary == nil || page.Summary.TotalCount == nil {
+		return 0, fmt.Errorf("Meta returned no total count for %s", path)
+	}
+	return *page.Summary.TotalCount, nil
 }
 
 // GetRaw makes a GET to a full URL (used for paging.next which is a complete URL).
@@ -209,8 +488,8 @@ func (c *Client) GetRaw(fullURL string) ([]byte, error) {
 }
 
 // buildURL constructs a full URL from path, base params, and extra params.
-// If path starts with "http", it's used as-is.
-func buildURL(path string, base, extra url.Values) (string, error) {
+// If path starts with "http", it's used as-is; otherwise it is relative to baseURL.
+func buildURL(baseURL, path string, base, extra url.Values) (string, error) {
 	var u *url.URL
 	var err error
 
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// SetDryRun makes POST and DELETE requests print what they would send to w
+// instead of sending it, and return a synthetic success. GET requests are
+// still sent. A nil w turns dry-run off.
+func (c *Client) SetDryRun(w io.Writer) {
+	c.dryRun = w
+}
+
+// DryRun reports whether mutations are printed instead of sent.
+func (c *Client) DryRun() bool {
+	return c.dryRun != nil
+}
+
+// skipMutation prints a mutation for dry-run and returns the synthetic
+// response: success, plus a placeholder ID for callers that create objects.
+func (c *Client) skipMutation(method, path string, params url.Values) []byte {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.dryRuns++
+	fmt.Fprintf(c.dryRun, "DRY RUN %s %s\n", method, path)
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range params[k] {
+			if IsSecretParam(k) {
+				v = "[redacted]"
+			}
+			fmt.Fprintf(c.dryRun, "  %s=%s\n", k, v)
+		}
+	}
+	return []byte(fmt.Sprintf(`{"success":true,"id":"dry-run-%d"}`, c.dryRuns))
+}
+
+// IsSecretParam reports whether the value of parameter k must not be printed
+// or logged.
+func IsSecretParam(k string) bool {
+	k = strings.ToLower(k)
+	return k == "appsecret_proof" || strings.Contains(k, "token") || strings.Contains(k, "secret") || strings.Contains(k, "password")
+}
@@ -0,0 +1,31 @@
+package api
+
+// Meta documentation pages linked from error hints.
+const (
+	docsErrors      = "https://developers.facebook.com/docs/marketing-api/error-reference"
+	docsTokens      = "https://developers.facebook.com/docs/facebook-login/guides/access-tokens/debugging-and-error-handling"
+	docsPermissions = "https://developers.facebook.com/docs/marketing-api/overview/authorization"
+	docsRateLimits  = "https://developers.facebook.com/docs/graph-api/overview/rate-limiting"
+)
+
+// codeInvalidParameter is the error of a field or parameter Meta rejected.
+const codeInvalidParameter = 100
+
+// Hint returns what to try after e and the Meta documentation page about it,
+// for the common error codes. Both are empty for the others.
+func (e *MetaError) Hint() (hint, docs string) {
+	switch {
+	case e.Code == codeInvalidParameter:
+		return "a field or parameter is missing, unknown or has an invalid value — check the names and values sent (--dry-run prints the request without sending it)", docsErrors
+	case e.Code == codeInvalidToken, e.Code == codeSession:
+		// doRequest already says to log in again.
+		return "", docsTokens
+	case e.IsAuth():
+		return "the token lacks a permission (ads_management, ads_read, business_management) or the user has no role on this object — check: meta-ads config doctor", docsPermissions
+	case e.IsRateLimit():
+		return "too many calls — wait before retrying, and check the usage with: meta-ads quota", docsRateLimits
+	case e.IsTemporary():
+		return "a temporary problem on Meta's side — try again in a moment", docsErrors
+	}
+	return "", ""
+}
@@ -0,0 +1,107 @@
+package api
+
+import (
+	"encoding/json"
+	"net/url"
+	"sync"
+)
+
+// FanOutItem is one object whose edge a FanOut fetches.
+type FanOutItem struct {
+	// Path is the edge to fetch, e.g. "/120210000000000/insights".
+	Path string
+	// Account is the ad account of the object, e.g. "act_123", whose usage
+	// paces the fetches; empty when unknown.
+	Account string
+}
+
+// FanOutResult is the outcome of one FanOutItem: the items of every page,
+// or the error that stopped the fetch.
+type FanOutResult struct {
+	Items []json.RawMessage
+	Err   error
+}
+
+// FanOut fetches an edge of many objects concurrently, e.g. the insights of
+// hundreds of campaigns, instead of one after the other. Concurrency fetches
+// run at a time, at most PerAccount of them on the same ad account, and only
+// one on an account whose business use case usage has reached SlowDownAt
+// percent (the most used account, for objects of unknown account). Throttled
+// requests are retried as usual, and an object that fails doesn't stop the
+// others: its error is in its result.
+type FanOut struct {
+	Concurrency int
+	PerAccount  int
+	SlowDownAt  int
+	// Progress, when set, is called after each object with the number of
+	// objects done so far.
+	Progress func(done, total int)
+
+	client *Client
+}
+
+// NewFanOut returns a FanOut running 8 fetches at a time, 4 per account.
+func (c *Client) NewFanOut() *FanOut {
+	return &FanOut{Concurrency: 8, PerAccount: 4, SlowDownAt: 75, client: c}
+}
+
+// Run fetches every page of each item with params and returns the results in
+// the same order.
+func (f *FanOut) Run(items []FanOutItem, params url.Values) []FanOutResult {
+	results := make([]FanOutResult, len(items))
+	var (
+		mu      sync.Mutex
+		slot    = sync.NewCond(&mu)
+		running = map[string]int{}
+		done    int
+	)
+	acquire := func(account string) {
+		mu.Lock()
+		for running[account] >= f.accountLimit(account) {
+			slot.Wait()
+		}
+		running[account]++
+		mu.Unlock()
+	}
+	release := func(account string) {
+		mu.Lock()
+		running[account]--
+		done++
+		if f.Progress != nil {
+			f.Progress(done, len(items))
+		}
+		mu.Unlock()
+		slot.Broadcast()
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(max(f.Concurrency, 1), len(items)); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				acquire(items[i].Account)
+				results[i].Items, results[i].Err = f.client.GetAll(items[i].Path, params)
+				release(items[i].Account)
+			}
+		}()
+	}
+	for i := range items {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
+
+// accountLimit returns how many fetches may run at once on account.
+func (f *FanOut) accountLimit(account string) int {
+	if f.client.AccountUsage(account) >= f.SlowDownAt {
+		return 1
+	}
+	if account == "" {
+		return max(f.Concurrency, 1)
+	}
+	return max(f.PerAccount, 1)
+}
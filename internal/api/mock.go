@@ -0,0 +1,161 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// SetMockDir makes the client answer every request from the JSON fixtures in
+// dir instead of calling the Graph API, to develop and test scripts offline.
+//
+// A request is answered with the file named after its path, the API version
+// removed: GET /v25.0/act_123/campaigns reads act_123/campaigns.json, and a
+// POST or DELETE reads act_123/campaigns.post.json or .delete.json. A
+// fixture with a top-level "error" object is returned as a failed request.
+// Reads without a fixture fail; writes without one succeed with
+// {"success":true}. Batch requests are answered item by item.
+func (c *Client) SetMockDir(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("mock directory: %w", err)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("mock directory: %s is not a directory", dir)
+	}
+	c.httpClient.Transport = mockTransport{dir: dir}
+	return nil
+}
+
+// mockTransport is the http.RoundTripper of the mock mode; see SetMockDir.
+type mockTransport struct {
+	dir string
+}
+
+func (t mockTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	path := mockPath(req.URL.Path)
+	if req.Method == http.MethodPost && path == "" {
+		form, err := mockForm(req)
+		if err != nil {
+			return nil, err
+		}
+		if batch := form.Get("batch"); batch != "" {
+			return t.batch(req, batch)
+		}
+	}
+	status, body, err := t.fixture(req.Method, path)
+	if err != nil {
+		return nil, err
+	}
+	return mockResponse(req, status, body), nil
+}
+
+// fixture returns the status and body answering method on path.
+func (t mockTransport) fixture(method, path string) (int, []byte, error) {
+	name := path
+	if name == "" {
+		name = "root"
+	}
+	if method != http.MethodGet {
+		name += "." + strings.ToLower(method)
+	}
+	file := filepath.Join(t.dir, filepath.FromSlash(name)+".json")
+	body, err := os.ReadFile(file)
+	switch {
+	case errors.Is(err, fs.ErrNotExist) && method != http.MethodGet:
+		return http.StatusOK, []byte(`{"success":true}`), nil
+	case errors.Is(err, fs.ErrNotExist):
+		return http.StatusBadRequest, mockError(fmt.Sprintf("no mock fixture for %s /%s: add %s", method, path, file)), nil
+	case err != nil:
+		return 0, nil, fmt.Errorf("reading mock fixture: %w", err)
+	}
+	if !json.Valid(body) {
+		return 0, nil, fmt.Errorf("mock fixture %s is not valid JSON", file)
+	}
+	var errResp struct {
+		Error json.RawMessage `json:"error"`
+	}
+	if json.Unmarshal(body, &errResp) == nil && len(errResp.Error) > 0 && string(errResp.Error) != "null" {
+		return http.StatusBadRequest, body, nil
+	}
+	return http.StatusOK, body, nil
+}
+
+// batch answers a batch request with the fixture of each of its items.
+func (t mockTransport) batch(req *http.Request, batch string) (*http.Response, error) {
+	var requests []BatchRequest
+	if err := json.Unmarshal([]byte(batch), &requests); err != nil {
+		return mockResponse(req, http.StatusBadRequest, mockError("invalid batch: "+err.Error())), nil
+	}
+	responses := make([]BatchResponse, len(requests))
+	for i, r := range requests {
+		u, err := url.Parse("/" + r.RelativeURL)
+		if err != nil {
+			return nil, err
+		}
+		status, body, err := t.fixture(r.Method, mockPath(u.Path))
+		if err != nil {
+			return nil, err
+		}
+		responses[i] = BatchResponse{Code: status, Body: string(body)}
+	}
+	data, err := json.Marshal(responses)
+	if err != nil {
+		return nil, err
+	}
+	return mockResponse(req, http.StatusOK, data), nil
+}
+
+var mockVersion = regexp.MustCompile(`^v\d+(\.\d+)?$`)
+
+// mockPath returns a request path without its leading slash and API version,
+// e.g. "act_123/campaigns".
+func mockPath(path string) string {
+	path = strings.Trim(path, "/")
+	if first, rest, _ := strings.Cut(path, "/"); mockVersion.MatchString(first) {
+		path = rest
+	}
+	return path
+}
+
+// mockForm returns the form fields of the body of req, leaving it unread.
+func mockForm(req *http.Request) (url.Values, error) {
+	if req.Body == nil {
+		return url.Values{}, nil
+	}
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, err
+	}
+	req.Body = io.NopCloser(bytes.NewReader(data))
+	return url.ParseQuery(string(data))
+}
+
+func mockResponse(req *http.Request, status int, body []byte) *http.Response {
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		StatusCode:    status,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": []string{"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+// mockError returns a Graph API error body with message.
+func mockError(message string) []byte {
+	data, _ := json.Marshal(map[string]MetaError{"error": {Message: message, Type: "MockException", Code: 100}})
+	return data
+}
@@ -0,0 +1,62 @@
+package api
+
+import "net/url"
+
+// Mutation is a POST or DELETE request sent by the client, with its outcome.
+type Mutation struct {
+	Method string
+	Path   string
+	// Params are the parameters given by the caller, without the access token.
+	Params   url.Values
+	Response []byte
+	Err      error
+}
+
+// OnMutation sets a function called after every POST and DELETE request
+// (each request of a batch separately), e.g. to keep an audit log.
+// Requests skipped by dry-run are not reported.
+func (c *Client) OnMutation(fn func(Mutation)) {
+	c.onMutation = fn
+}
+
+// BeforeMutation sets a function called before every POST and DELETE request
+// outside batches, and before each item of a BulkExecutor, with Response and
+// Err unset, e.g. to capture the state the change overwrites.
+func (c *Client) BeforeMutation(fn func(Mutation)) {
+	c.beforeMutation = fn
+}
+
+func (c *Client) announceMutation(m Mutation) {
+	if c.beforeMutation != nil {
+		c.hookMu.Lock()
+		defer c.hookMu.Unlock()
+		c.beforeMutation(m)
+	}
+}
+
+func (c *Client) recordMutation(m Mutation) {
+	c.logMutation(m)
+	if c.onMutation != nil {
+		c.hookMu.Lock()
+		defer c.hookMu.Unlock()
+		c.onMutation(m)
+	}
+}
+
+// logMutation logs m: info when it succeeded, error when it failed.
+func (c *Client) logMutation(m Mutation) {
+	if m.Err != nil {
+		c.log().Error("mutation failed", "method", m.Method, "path", m.Path, "error", m.Err.Error())
+		return
+	}
+	c.log().Info("mutation", "method", m.Method, "path", m.Path)
+}
+
+// cloneValues returns a copy of v, which Post adds the access token to.
+func cloneValues(v url.Values) url.Values {
+	out := make(url.Values, len(v))
+	for k, vs := range v {
+		out[k] = append([]string(nil), vs...)
+	}
+	return out
+}
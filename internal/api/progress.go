@@ -0,0 +1,19 @@
+package api
+
+// PageProgress is how far a multi-page fetch of GetPages has got.
+type PageProgress struct {
+	// Path is the edge being fetched, e.g. "/act_123/campaigns".
+	Path string
+	// Pages and Items count the pages and items fetched so far.
+	Pages int
+	Items int
+	// Done is set on the last call, when the fetch has ended.
+	Done bool
+}
+
+// OnPage sets a function called before each page after the first of a
+// GetPages fetch, and once more when it ends, e.g. to draw a progress line.
+// Fetches of a single page don't call it. It may be called concurrently.
+func (c *Client) OnPage(fn func(PageProgress)) {
+	c.onPage = fn
+}
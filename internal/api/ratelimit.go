@@ -0,0 +1,133 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+)
+
+// BusinessUseCaseUsage is one entry of the X-Business-Use-Case-Usage header.
+// Percentages are 0–100; EstimatedTimeToRegainAccess is in minutes.
+type BusinessUseCaseUsage struct {
+	BusinessID                  string `json:"business_id"`
+	Type                        string `json:"type"`
+	CallCount                   int    `json:"call_count"`
+	TotalCPUTime                int    `json:"total_cputime"`
+	TotalTime                   int    `json:"total_time"`
+	EstimatedTimeToRegainAccess int    `json:"estimated_time_to_regain_access"`
+	AdsAPIAccessTier            string `json:"ads_api_access_tier,omitempty"`
+}
+
+// AdAccountUsage is the parsed X-Ad-Account-Usage header.
+// ResetTimeDuration is in seconds.
+type AdAccountUsage struct {
+	AccIDUtilPct      float64 `json:"acc_id_util_pct"`
+	ResetTimeDuration int     `json:"reset_time_duration"`
+	AdsAPIAccessTier  string  `json:"ads_api_access_tier,omitempty"`
+}
+
+// AppUsage is the parsed X-App-Usage header.
+type AppUsage struct {
+	CallCount    int `json:"call_count"`
+	TotalCPUTime int `json:"total_cputime"`
+	TotalTime    int `json:"total_time"`
+}
+
+// Usage holds every rate-limit header Meta returned on a response.
+type Usage struct {
+	BusinessUseCases []BusinessUseCaseUsage `json:"business_use_cases,omitempty"`
+	AdAccount        *AdAccountUsage        `json:"ad_account,omitempty"`
+	App              *AppUsage              `json:"app,omitempty"`
+}
+
+// ParseUsage extracts the rate-limit usage headers from a Graph API response.
+// Malformed headers are skipped.
+func ParseUsage(headers http.Header) *Usage {
+	u := &Usage{}
+
+	// Shape: {"<id>":[{"call_count":N,"total_cputime":N,"total_time":N,"type":"..."}]}
+	if buc := headers.Get("X-Business-Use-Case-Usage"); buc != "" {
+		var parsed map[string][]BusinessUseCaseUsage
+		if err := json.Unmarshal([]byte(buc), &parsed); err == nil {
+			for id, entries := range parsed {
+				for _, e := range entries {
+					e.BusinessID = id
+					u.BusinessUseCases = append(u.BusinessUseCases, e)
+				}
+			}
+		}
+	}
+
+	if acc := headers.Get("X-Ad-Account-Usage"); acc != "" {
+		var parsed AdAccountUsage
+		if err := json.Unmarshal([]byte(acc), &parsed); err == nil {
+			u.AdAccount = &parsed
+		}
+	}
+
+	if app := headers.Get("X-App-Usage"); app != "" {
+		var parsed AppUsage
+		if err := json.Unmarshal([]byte(app), &parsed); err == nil {
+			u.App = &parsed
+		}
+	}
+
+	return u
+}
+
+// percent returns the highest of the usage percentages of e.
+func (e BusinessUseCaseUsage) percent() int {
+	return max(e.CallCount, max(e.TotalTime, e.TotalCPUTime))
+}
+
+// recordAccountUsage keeps the business use case usage of u for each
+// account it reports on; c.mu must be held.
+func (c *Client) recordAccountUsage(u *Usage) {
+	if len(u.BusinessUseCases) == 0 {
+		return
+	}
+	if c.accountUsage == nil {
+		c.accountUsage = map[string]int{}
+	}
+	latest := map[string]int{}
+	for _, e := range u.BusinessUseCases {
+		latest[e.BusinessID] = max(latest[e.BusinessID], e.percent())
+	}
+	for id, pct := range latest {
+		c.accountUsage[id] = pct
+	}
+}
+
+// AccountUsage returns the business use case usage, in percent, last
+// reported for account (with or without its act_ prefix), or for the most
+// used account when account is empty; 0 when none was reported.
+func (c *Client) AccountUsage(account string) int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if account != "" {
+		return c.accountUsage[strings.TrimPrefix(account, "act_")]
+	}
+	highest := 0
+	for _, pct := range c.accountUsage {
+		highest = max(highest, pct)
+	}
+	return highest
+}
+
+// checkRateLimit warns to stderr if any business use case is above 75% usage.
+func checkRateLimit(u *Usage) {
+	for _, e := range u.BusinessUseCases {
+		if e.CallCount > 75 || e.TotalTime > 75 {
+			fmt.Fprintf(os.Stderr, "⚠️  Rate limit: %d%% used — slow down to avoid HTTP 613\n", max(e.CallCount, e.TotalTime))
+		}
+	}
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
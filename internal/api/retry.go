@@ -0,0 +1,122 @@
+package api
+
+import (
+	"net/http"
+	"time"
+)
+
+// maxRetries is how many times a request failing with a retryable error is
+// sent again.
+const maxRetries = 3
+
+// maxRetryWait caps the wait before a retry: when Meta asks for a longer
+// cooldown, the request fails instead of hanging.
+const maxRetryWait = 5 * time.Minute
+
+// tokenErrorHint is appended to errors caused by an invalid or expired token.
+const tokenErrorHint = "the access token is invalid or expired — log in again: meta-ads auth login"
+
+// Meta error codes that decide how a failed request is retried.
+const (
+	codeTransient     = 2
+	codeAppThrottled  = 4
+	codeUserThrottled = 17
+	codePageThrottled = 32
+	codeSession       = 102
+	codePermission    = 10
+	codeInvalidToken  = 190
+	codeAPIThrottled  = 613
+	// 80000–80014 are the business use case limits, e.g. 80004 for too
+	// many calls to an ad account.
+	codeFirstBUCLimit = 80000
+	codeLastBUCLimit  = 80014
+	// 200–299 are the permission errors, e.g. 200 for a missing ads_management.
+	codeFirstPermission = 200
+	codeLastPermission  = 299
+)
+
+// IsRateLimit reports whether e is a rate-limit error, one that still failed
+// after the retries.
+func (e *MetaError) IsRateLimit() bool {
+	return e.IsThrottled()
+}
+
+// IsAuth reports whether e comes from the access token: invalid, expired or
+// missing a permission.
+func (e *MetaError) IsAuth() bool {
+	switch {
+	case e.Code == codeInvalidToken, e.Code == codeSession, e.Code == codePermission:
+		return true
+	}
+	return e.Code >= codeFirstPermission && e.Code <= codeLastPermission
+}
+
+// IsThrottled reports whether e is a rate-limit error: the request was
+// rejected, so it is safe to send again whatever its method.
+func (e *MetaError) IsThrottled() bool {
+	switch e.Code {
+	case codeAppThrottled, codeUserThrottled, codePageThrottled, codeAPIThrottled:
+		return true
+	}
+	return e.isBUCThrottled()
+}
+
+// isBUCThrottled reports whether e is a business use case rate limit, such
+// as 80004 (too many calls to the ad account).
+func (e *MetaError) isBUCThrottled() bool {
+	return e.Code >= codeFirstBUCLimit && e.Code <= codeLastBUCLimit
+}
+
+// IsTemporary reports whether e is a temporary server-side failure: code 2,
+// or any error Meta flags with is_transient.
+func (e *MetaError) IsTemporary() bool {
+	return e.Code == codeTransient || e.IsTransient
+}
+
+// cooldown returns the wait Meta estimates before calls are accepted again,
+// from the usage headers of the failed response, or 0 when it gave none.
+// bucOnly restricts it to the business use case estimates.
+func (u *Usage) cooldown(bucOnly bool) time.Duration {
+	if u == nil {
+		return 0
+	}
+	var d time.Duration
+	for _, e := range u.BusinessUseCases {
+		d = maxDuration(d, time.Duration(e.EstimatedTimeToRegainAccess)*time.Minute)
+	}
+	if u.AdAccount != nil && !bucOnly {
+		d = maxDuration(d, time.Duration(u.AdAccount.ResetTimeDuration)*time.Second)
+	}
+	return d
+}
+
+// retryDelay returns how long to wait before sending again a request with
+// method that failed with e on its attempt-th try (from 0), and false when it
+// shouldn't be retried.
+//
+// Throttled requests wait for the cooldown in the usage headers, else back
+// off from 10s. Transient errors back off from 1s, and only reads are
+// retried: a failed write may still have been applied.
+func retryDelay(e *MetaError, usage *Usage, method string, attempt int) (time.Duration, bool) {
+	if attempt >= maxRetries {
+		return 0, false
+	}
+	backoff := func(base time.Duration) time.Duration { return base << attempt }
+	switch {
+	case e.IsThrottled():
+		if d := usage.cooldown(e.isBUCThrottled()); d > 0 {
+			return d, true
+		}
+		return backoff(10 * time.Second), true
+	case e.IsTemporary() && method == http.MethodGet:
+		return backoff(time.Second), true
+	}
+	return 0, false
+}
+
+func maxDuration(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
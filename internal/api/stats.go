@@ -0,0 +1,72 @@
+package api
+
+import (
+	"net/http"
+	"time"
+)
+
+// Stats counts the API activity of a Client since it was created.
+type Stats struct {
+	// Calls is the number of HTTP requests sent, retries included; a batch
+	// is one call.
+	Calls int `json:"calls"`
+	// Pages is the number of list pages fetched by GetEach and GetAll.
+	Pages         int           `json:"pages"`
+	BytesSent     int64         `json:"bytes_sent"`
+	BytesReceived int64         `json:"bytes_received"`
+	APITime       time.Duration `json:"api_time_ns"`
+	// Retries counts the requests, and the items of bulk batches, sent again
+	// after an error.
+	Retries int `json:"retries"`
+	// FirstUsage and LastUsage are the first and last rate-limit usage Meta
+	// reported, nil when it reported none.
+	FirstUsage *Usage `json:"first_usage,omitempty"`
+	LastUsage  *Usage `json:"last_usage,omitempty"`
+}
+
+// Stats returns the API activity of the client so far.
+func (c *Client) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// countCall records one HTTP exchange in the stats.
+func (c *Client) countCall(sent, received int64, d time.Duration, usage *Usage) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stats.Calls++
+	c.stats.BytesSent += sent
+	c.stats.BytesReceived += received
+	c.stats.APITime += d
+	if usage != nil && !usage.empty() {
+		if c.stats.FirstUsage == nil {
+			c.stats.FirstUsage = usage
+		}
+		c.stats.LastUsage = usage
+	}
+}
+
+// countStat adds n to the stat selected by field.
+func (c *Client) countStat(field *int, n int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	*field += n
+}
+
+// requestSize returns the bytes of the URL and body of req.
+func requestSize(req *http.Request) int64 {
+	return int64(len(req.URL.String())) + max64(req.ContentLength, 0)
+}
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// empty reports whether u holds no usage header.
+func (u *Usage) empty() bool {
+	return len(u.BusinessUseCases) == 0 && u.AdAccount == nil && u.App == nil
+}
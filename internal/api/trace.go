@@ -0,0 +1,243 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// traceExchange is one HTTP exchange written by the trace mode.
+type traceExchange struct {
+	N          int            `json:"n"`
+	Time       string         `json:"time"`
+	DurationMS int64          `json:"duration_ms"`
+	FBTraceID  string         `json:"fbtrace_id,omitempty"`
+	Request    traceRequest   `json:"request"`
+	Response   *traceResponse `json:"response,omitempty"`
+	Error      string         `json:"error,omitempty"`
+}
+
+type traceRequest struct {
+	Method  string            `json:"method"`
+	URL     string            `json:"url"`
+	Headers map[string]string `json:"headers,omitempty"`
+	// Form holds the fields of a form-encoded body.
+	Form map[string]string `json:"form,omitempty"`
+}
+
+type traceResponse struct {
+	Status  int               `json:"status"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body"`
+}
+
+// SetTraceDir makes the client write every HTTP exchange (request, response,
+// timing and fbtrace_id, with credentials removed) to a numbered JSON file in
+// dir, e.g. to attach to a bug report to Meta. Numbering continues after the
+// files already in dir, so several commands make one bundle. An empty dir
+// turns it off.
+func (c *Client) SetTraceDir(dir string) error {
+	c.traceDir = dir
+	if dir == "" {
+		return nil
+	}
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return fmt.Errorf("creating trace directory: %w", err)
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return fmt.Errorf("reading trace directory: %w", err)
+	}
+	for _, e := range entries {
+		prefix, _, _ := strings.Cut(e.Name(), "-")
+		if n, err := strconv.Atoi(prefix); err == nil && n > c.traces {
+			c.traces = n
+		}
+	}
+	return nil
+}
+
+// traceRequestForm returns the form fields of the body of req, secrets
+// redacted, without consuming it.
+func traceRequestForm(req *http.Request) map[string]string {
+	if req.GetBody == nil {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	data, _ := io.ReadAll(body)
+	values, _ := url.ParseQuery(string(data))
+	form := make(map[string]string, len(values))
+	for k := range values {
+		form[k] = values.Get(k)
+		if IsSecretParam(k) {
+			form[k] = "[redacted]"
+		}
+	}
+	return form
+}
+
+// writeTrace writes one exchange to the trace directory. Failures to write
+// are reported once to stderr and don't fail the request.
+func (c *Client) writeTrace(req *http.Request, form map[string]string, start time.Time, resp *http.Response, body []byte, err error) {
+	c.mu.Lock()
+	c.traces++
+	n := c.traces
+	c.mu.Unlock()
+
+	u := *req.URL
+	u.RawQuery = sanitizeQuery(u.RawQuery)
+	ex := traceExchange{
+		N:          n,
+		Time:       start.UTC().Format(time.RFC3339Nano),
+		DurationMS: time.Since(start).Milliseconds(),
+		Request: traceRequest{
+			Method:  req.Method,
+			URL:     u.String(),
+			Headers: traceHeaders(req.Header),
+			Form:    form,
+		},
+	}
+	if err != nil {
+		ex.Error = err.Error()
+	}
+	if resp != nil {
+		ex.FBTraceID = resp.Header.Get("X-Fb-Trace-Id")
+		r := &traceResponse{Status: resp.StatusCode, Headers: traceHeaders(resp.Header), Body: redactBody(body)}
+		var errResp struct {
+			Error struct {
+				FBTraceID string `json:"fbtrace_id"`
+			} `json:"error"`
+		}
+		if json.Unmarshal(body, &errResp) == nil && errResp.Error.FBTraceID != "" {
+			ex.FBTraceID = errResp.Error.FBTraceID
+		}
+		ex.Response = r
+	}
+
+	var data bytes.Buffer
+	enc := json.NewEncoder(&data)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(ex)
+	name := fmt.Sprintf("%04d-%s-%s.json", n, req.Method, traceName(req.URL.Path))
+	if werr := os.WriteFile(filepath.Join(c.traceDir, name), data.Bytes(), 0o600); werr != nil {
+		c.mu.Lock()
+		warned := c.traceWarned
+		c.traceWarned = true
+		c.mu.Unlock()
+		if !warned {
+			fmt.Fprintf(os.Stderr, "⚠️  could not write the trace: %v\n", werr)
+		}
+	}
+}
+
+var traceNameUnsafe = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// traceName turns a request path into a file name fragment.
+func traceName(path string) string {
+	name := traceNameUnsafe.ReplaceAllString(strings.Trim(path, "/"), "_")
+	if len(name) > 60 {
+		name = name[:60]
+	}
+	if name == "" {
+		name = "root"
+	}
+	return name
+}
+
+// sanitizeQuery redacts the secret parameters of a URL-encoded query or form
+// body. Malformed pairs are dropped rather than copied unredacted.
+func sanitizeQuery(raw string) string {
+	values, _ := url.ParseQuery(raw)
+	for k := range values {
+		if IsSecretParam(k) {
+			values[k] = []string{"[redacted]"}
+		}
+	}
+	return values.Encode()
+}
+
+// redactBody returns a response body as JSON with its credentials removed:
+// the values of secret keys (access_token, token, client_secret...) and the
+// secret parameters of the URLs it holds, such as paging.next. A body that
+// isn't JSON, e.g. a form-encoded token, is returned as a JSON string.
+func redactBody(body []byte) json.RawMessage {
+	dec := json.NewDecoder(bytes.NewReader(body))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil || dec.More() {
+		text := string(body)
+		if values, err := url.ParseQuery(text); err == nil {
+			for k := range values {
+				if IsSecretParam(k) {
+					text = sanitizeQuery(text)
+					break
+				}
+			}
+		}
+		out, _ := json.Marshal(text)
+		return out
+	}
+	var data bytes.Buffer
+	enc := json.NewEncoder(&data)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(redactValue(v)); err != nil {
+		out, _ := json.Marshal("[redacted]")
+		return out
+	}
+	return bytes.TrimRight(data.Bytes(), "\n")
+}
+
+// redactValue redacts the secrets of a decoded JSON value, in place.
+func redactValue(v any) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for k, e := range v {
+			if IsSecretParam(k) {
+				v[k] = "[redacted]"
+			} else {
+				v[k] = redactValue(e)
+			}
+		}
+	case []any:
+		for i, e := range v {
+			v[i] = redactValue(e)
+		}
+	case string:
+		if u, err := url.Parse(v); err == nil && u.Scheme != "" && u.RawQuery != "" {
+			u.RawQuery = sanitizeQuery(u.RawQuery)
+			return u.String()
+		}
+	}
+	return v
+}
+
+// traceHeaders flattens headers, redacting credentials and cookies.
+func traceHeaders(h http.Header) map[string]string {
+	if len(h) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(h))
+	for k, vs := range h {
+		v := strings.Join(vs, ", ")
+		switch strings.ToLower(k) {
+		case "authorization", "cookie", "set-cookie":
+			v = "[redacted]"
+		}
+		out[k] = v
+	}
+	return out
+}
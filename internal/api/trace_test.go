@@ -0,0 +1,86 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+const (
+	testToken     = "EAAtesttoken123"
+	testAppSecret = "testappsecret456"
+)
+
+// tokenServer answers like Graph API list and token endpoints do: with the
+// caller's credentials in paging URLs and token fields.
+func tokenServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		next := "https://graph.facebook.com/v23.0/me/accounts?" + r.URL.RawQuery + "&after=abc"
+		fmt.Fprintf(w, `{"data":[{"id":"1","access_token":"EAApagetoken789"}],"paging":{"next":%q},"debug":{"token":%q}}`, next, testToken)
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+// assertNoSecrets fails when a file of dir contains a credential.
+func assertNoSecrets(t *testing.T, c *Client, dir string) {
+	t.Helper()
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) == 0 {
+		t.Fatal("nothing was written")
+	}
+	for _, e := range entries {
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, secret := range []string{testToken, "EAApagetoken789", "appsecret_proof=" + c.appSecretProof()} {
+			if strings.Contains(string(data), secret) {
+				t.Errorf("%s contains %q:\n%s", e.Name(), secret, data)
+			}
+		}
+	}
+}
+
+func TestTraceRedactsResponseBody(t *testing.T) {
+	srv := tokenServer(t)
+	c := NewClient(testToken, testAppSecret, HTTPOptions{})
+	c.baseURL = srv.URL
+	dir := t.TempDir()
+	if err := c.SetTraceDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get("/me/accounts", nil); err != nil {
+		t.Fatal(err)
+	}
+	assertNoSecrets(t, c, dir)
+}
+
+func TestRecordRedactsResponseBody(t *testing.T) {
+	srv := tokenServer(t)
+	c := NewClient(testToken, testAppSecret, HTTPOptions{})
+	c.baseURL = srv.URL
+	dir := t.TempDir()
+	if err := c.SetRecord(filepath.Join(dir, "cassette.json")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get("/me/accounts", nil); err != nil {
+		t.Fatal(err)
+	}
+	assertNoSecrets(t, c, dir)
+}
+
+func TestRedactBodyForm(t *testing.T) {
+	got := string(redactBody([]byte("access_token=" + testToken + "&token_type=bearer")))
+	if strings.Contains(got, testToken) {
+		t.Errorf("redactBody kept the token: %s", got)
+	}
+}
@@ -42,13 +42,30 @@ type MetaError struct {
 	Message string `json:"message"`
 	Type    string `json:"type"`
 	Subcode int    `json:"error_subcode"`
+	// IsTransient is set by Meta on errors that may succeed when retried.
+	IsTransient bool `json:"is_transient"`
+	// UserTitle and UserMsg explain the error in terms the user can act on,
+	// e.g. "Budget too low"; Meta only sets them on some errors.
+	UserTitle string `json:"error_user_title,omitempty"`
+	UserMsg   string `json:"error_user_msg,omitempty"`
+	// FBTraceID identifies the failed request when contacting Meta support.
+	FBTraceID string `json:"fbtrace_id,omitempty"`
 }
 
 func (e *MetaError) Error() string {
+	s := "meta api error " + itoa(e.Code) + ": " + e.Message
 	if e.Subcode != 0 {
-		return "meta api error " + itoa(e.Code) + " (subcode " + itoa(e.Subcode) + "): " + e.Message
+		s = "meta api error " + itoa(e.Code) + " (subcode " + itoa(e.Subcode) + "): " + e.Message
 	}
-	return "meta api error " + itoa(e.Code) + ": " + e.Message
+	switch {
+	case e.UserTitle != "" && e.UserMsg != "":
+		s += " — " + e.UserTitle + ": " + e.UserMsg
+	case e.UserMsg != "":
+		s += " — " + e.UserMsg
+	case e.UserTitle != "":
+		s += " — " + e.UserTitle
+	}
+	return s
 }
 
 func itoa(n int) string {
@@ -107,6 +124,7 @@ type Campaign struct {
 	StopTime        string `json:"stop_time,omitempty"`
 	CreatedTime     string `json:"created_time,omitempty"`
 	UpdatedTime     string `json:"updated_time,omitempty"`
+	AccountID       string `json:"account_id,omitempty"`
 }
 
 // AdSet represents a Meta ad set.
@@ -128,6 +146,7 @@ type AdSet struct {
 	CreatedTime     string     `json:"created_time,omitempty"`
 	UpdatedTime     string     `json:"updated_time,omitempty"`
 	DestinationType string     `json:"destination_type,omitempty"`
+	AccountID       string     `json:"account_id,omitempty"`
 	// Nested campaign info (returned when requesting campaign{id,name,objective})
 	Campaign *struct {
 		ID        string `json:"id"`
@@ -186,6 +205,16 @@ type Pixel struct {
 	LastFiredTime string `json:"last_fired_time,omitempty"`
 	CreationTime  string `json:"creation_time,omitempty"`
 	IsUnavailable bool   `json:"is_unavailable,omitempty"`
+
+	// Detail fields (requested by pixels get)
+	OwnerBusiness           *Business `json:"owner_business,omitempty"`
+	OwnerAdAccount          *Account  `json:"owner_ad_account,omitempty"`
+	DataUseSetting          string    `json:"data_use_setting,omitempty"`
+	EnableAutomaticMatching bool      `json:"enable_automatic_matching,omitempty"`
+	AutomaticMatchingFields []string  `json:"automatic_matching_fields,omitempty"`
+	FirstPartyCookieStatus  string    `json:"first_party_cookie_status,omitempty"`
+	SharedAccounts          []Account `json:"shared_accounts,omitempty"`
+	SharedAgencies          []Business `json:"shared_agencies,omitempty"`
 }
 
 // User is returned by GET /me.
@@ -194,3 +223,365 @@ type User struct {
 	Name  string `json:"name"`
 	Email string `json:"email,omitempty"`
 }
+
+// Activity is a single entry from an ad account's change history (/{account}/activities).
+type Activity struct {
+	EventTime           string `json:"event_time"`
+	EventType           string `json:"event_type"`
+	TranslatedEventType string `json:"translated_event_type,omitempty"`
+	ActorID             string `json:"actor_id,omitempty"`
+	ActorName           string `json:"actor_name,omitempty"`
+	ObjectID            string `json:"object_id,omitempty"`
+	ObjectName          string `json:"object_name,omitempty"`
+	ObjectType          string `json:"object_type,omitempty"`
+	ApplicationName     string `json:"application_name,omitempty"`
+	ExtraData           string `json:"extra_data,omitempty"`
+}
+
+// FundingSource describes the payment method attached to an ad account.
+type FundingSource struct {
+	ID            string `json:"id"`
+	DisplayString string `json:"display_string,omitempty"`
+	Type          int    `json:"type"`
+}
+
+// AccountBilling holds the billing-related fields of an ad account.
+// Monetary values are in minor currency units (cents), like budgets.
+type AccountBilling struct {
+	ID                   string         `json:"id"`
+	Name                 string         `json:"name"`
+	Currency             string         `json:"currency"`
+	Status               int            `json:"account_status"`
+	DisableReason        int            `json:"disable_reason,omitempty"`
+	Balance              FlexString     `json:"balance,omitempty"`
+	AmountSpent          FlexString     `json:"amount_spent,omitempty"`
+	SpendCap             FlexString     `json:"spend_cap,omitempty"`
+	IsPrepayAccount      bool           `json:"is_prepay_account"`
+	FundingSourceDetails *FundingSource `json:"funding_source_details,omitempty"`
+	NextBillDate         string         `json:"next_bill_date,omitempty"`
+}
+
+// Business represents a Meta Business Manager.
+type Business struct {
+	ID                 string `json:"id"`
+	Name               string `json:"name"`
+	VerificationStatus string `json:"verification_status,omitempty"`
+	CreatedTime        string `json:"created_time,omitempty"`
+}
+
+// Page represents a Facebook Page.
+type Page struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Category string `json:"category,omitempty"`
+	// Instagram is the Instagram business account connected to the page.
+	Instagram *InstagramAccount `json:"instagram_business_account,omitempty"`
+}
+
+// InstagramAccount is an Instagram business account.
+type InstagramAccount struct {
+	ID       string `json:"id"`
+	Username string `json:"username,omitempty"`
+}
+
+// Recommendation is a delivery recommendation attached to a campaign, ad set, or ad
+// (the "recommendations" field), e.g. creative fatigue or learning limited.
+type Recommendation struct {
+	Title      string `json:"title"`
+	Message    string `json:"message"`
+	Importance string `json:"importance"`
+	Confidence string `json:"confidence,omitempty"`
+	Code       int    `json:"code,omitempty"`
+	BlameField string `json:"blame_field,omitempty"`
+}
+
+// PixelStatsBucket is one time bucket returned by the pixel /stats edge.
+type PixelStatsBucket struct {
+	StartTime   string `json:"start_time"`
+	Aggregation string `json:"aggregation"`
+	Data        []struct {
+		Value string `json:"value"`
+		Count int    `json:"count"`
+	} `json:"data"`
+}
+
+// DACheck is a pixel diagnostics check from the /da_checks edge.
+type DACheck struct {
+	Key         string `json:"key"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Result      string `json:"result"`
+	UserMessage string `json:"user_message,omitempty"`
+	ActionURI   string `json:"action_uri,omitempty"`
+}
+
+// OfflineEventSet represents an offline conversion data set.
+type OfflineEventSet struct {
+	ID             string     `json:"id"`
+	Name           string     `json:"name"`
+	Description    string     `json:"description,omitempty"`
+	ValidEntries   int        `json:"valid_entries,omitempty"`
+	MatchedEntries int        `json:"matched_entries,omitempty"`
+	EventTimeMin   FlexString `json:"event_time_min,omitempty"`
+	EventTimeMax   FlexString `json:"event_time_max,omitempty"`
+}
+
+// CustomConversion represents a custom conversion defined on an ad account.
+type CustomConversion struct {
+	ID                     string          `json:"id"`
+	Name                   string          `json:"name"`
+	Description            string          `json:"description,omitempty"`
+	CustomEventType        string          `json:"custom_event_type,omitempty"`
+	EventSourceType        string          `json:"event_source_type,omitempty"`
+	Rule                   json.RawMessage `json:"rule,omitempty"`
+	DefaultConversionValue FlexString      `json:"default_conversion_value,omitempty"`
+	Pixel                  *struct {
+		ID string `json:"id"`
+	} `json:"pixel,omitempty"`
+	CreationTime  string `json:"creation_time,omitempty"`
+	LastFiredTime string `json:"last_fired_time,omitempty"`
+	IsArchived    bool   `json:"is_archived,omitempty"`
+	IsUnavailable bool   `json:"is_unavailable,omitempty"`
+}
+
+// AEMEventConfig is one prioritized web event in a pixel's Aggregated Event
+// Measurement configuration. Lower priority numbers rank higher.
+type AEMEventConfig struct {
+	Domain             string `json:"domain"`
+	EventName          string `json:"event_name"`
+	CustomConversionID string `json:"custom_conversion_id,omitempty"`
+	Priority           int    `json:"priority"`
+	ValueOptimization  bool   `json:"is_value_optimization,omitempty"`
+}
+
+// TokenDebug is the data returned by /debug_token for an access token.
+type TokenDebug struct {
+	AppID       string   `json:"app_id"`
+	Application string   `json:"application"`
+	Type        string   `json:"type"`
+	IsValid     bool     `json:"is_valid"`
+	ExpiresAt   int64    `json:"expires_at"`
+	UserID      string   `json:"user_id"`
+	Scopes      []string `json:"scopes"`
+	Error       *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// AdRule represents an automated rule from an ad account's adrules_library.
+type AdRule struct {
+	ID             string              `json:"id"`
+	Name           string              `json:"name"`
+	Status         string              `json:"status"`
+	EvaluationSpec *RuleEvaluationSpec `json:"evaluation_spec,omitempty"`
+	ExecutionSpec  *RuleExecutionSpec  `json:"execution_spec,omitempty"`
+	ScheduleSpec   json.RawMessage     `json:"schedule_spec,omitempty"`
+	CreatedTime    string              `json:"created_time,omitempty"`
+	UpdatedTime    string              `json:"updated_time,omitempty"`
+}
+
+// RuleFilter is a condition of a rule (filter, trigger or execution option).
+type RuleFilter struct {
+	Type     string          `json:"type,omitempty"`
+	Field    string          `json:"field"`
+	Operator string          `json:"operator,omitempty"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// RuleEvaluationSpec describes which objects a rule evaluates and when.
+type RuleEvaluationSpec struct {
+	EvaluationType string       `json:"evaluation_type"`
+	Filters        []RuleFilter `json:"filters,omitempty"`
+	Trigger        *RuleFilter  `json:"trigger,omitempty"`
+}
+
+// RuleExecutionSpec describes what a rule does to matching objects.
+type RuleExecutionSpec struct {
+	ExecutionType    string       `json:"execution_type"`
+	ExecutionOptions []RuleFilter `json:"execution_options,omitempty"`
+}
+
+// AdStudy represents a Meta ad study (split test or lift study).
+type AdStudy struct {
+	ID                 string        `json:"id"`
+	Name               string        `json:"name"`
+	Description        string        `json:"description,omitempty"`
+	Type               string        `json:"type"`
+	StartTime          string        `json:"start_time,omitempty"`
+	EndTime            string        `json:"end_time,omitempty"`
+	CreatedTime        string        `json:"created_time,omitempty"`
+	CanceledTime       string        `json:"canceled_time,omitempty"`
+	ObservationEndTime string        `json:"observation_end_time,omitempty"`
+	Cells              []AdStudyCell `json:"cells,omitempty"`
+}
+
+// AdStudyCell is a test group of an ad study.
+type AdStudyCell struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	TreatmentPercentage int      `json:"treatment_percentage"`
+	Campaigns           []IDName `json:"campaigns,omitempty"`
+	AdSets              []IDName `json:"adsets,omitempty"`
+}
+
+// IDName is an object reference with its name.
+type IDName struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+
+// LeadForm represents a lead generation (instant) form of a page.
+type LeadForm struct {
+	ID          string             `json:"id"`
+	Name        string             `json:"name"`
+	Status      string             `json:"status"`
+	Locale      string             `json:"locale,omitempty"`
+	LeadsCount  int                `json:"leads_count"`
+	CreatedTime string             `json:"created_time,omitempty"`
+	Questions   []LeadFormQuestion `json:"questions,omitempty"`
+}
+
+// LeadFormQuestion is a question of a lead form.
+type LeadFormQuestion struct {
+	Key   string `json:"key"`
+	Label string `json:"label,omitempty"`
+	Type  string `json:"type"`
+}
+
+// Lead is a lead submitted through a lead form.
+type Lead struct {
+	ID           string      `json:"id"`
+	CreatedTime  string      `json:"created_time"`
+	FormID       string      `json:"form_id,omitempty"`
+	AdID         string      `json:"ad_id,omitempty"`
+	AdName       string      `json:"ad_name,omitempty"`
+	AdSetID      string      `json:"adset_id,omitempty"`
+	AdSetName    string      `json:"adset_name,omitempty"`
+	CampaignID   string      `json:"campaign_id,omitempty"`
+	CampaignName string      `json:"campaign_name,omitempty"`
+	Platform     string      `json:"platform,omitempty"`
+	IsOrganic    bool        `json:"is_organic"`
+	FieldData    []LeadField `json:"field_data"`
+}
+
+// LeadField is one answer of a lead.
+type LeadField struct {
+	Name   string   `json:"name"`
+	Values []string `json:"values"`
+}
+
+// ProductCatalog represents a Commerce Manager product catalog.
+type ProductCatalog struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Vertical     string `json:"vertical,omitempty"`
+	ProductCount int    `json:"product_count"`
+}
+
+// ProductSet is a filtered subset of a catalog's products.
+type ProductSet struct {
+	ID           string `json:"id"`
+	Name         string `json:"name"`
+	Filter       string `json:"filter,omitempty"`
+	ProductCount int    `json:"product_count"`
+}
+
+// ProductFeed is a data feed that populates a catalog.
+type ProductFeed struct {
+	ID           string             `json:"id"`
+	Name         string             `json:"name"`
+	ProductCount int                `json:"product_count"`
+	LatestUpload *ProductFeedUpload `json:"latest_upload,omitempty"`
+}
+
+// ProductFeedUpload is one ingestion run of a product feed.
+type ProductFeedUpload struct {
+	ID                string `json:"id"`
+	StartTime         string `json:"start_time,omitempty"`
+	EndTime           string `json:"end_time,omitempty"`
+	InputMethod       string `json:"input_method,omitempty"`
+	ErrorCount        int    `json:"error_count"`
+	WarningCount      int    `json:"warning_count"`
+	NumDetectedItems  int    `json:"num_detected_items"`
+	NumPersistedItems int    `json:"num_persisted_items"`
+}
+
+// ProductFeedUploadError is a group of errors of a feed upload.
+type ProductFeedUploadError struct {
+	ID          string `json:"id"`
+	Summary     string `json:"summary"`
+	Description string `json:"description,omitempty"`
+	Severity    string `json:"severity"`
+	TotalCount  int    `json:"total_count"`
+}
+
+// TargetingOption is an interest, behavior, or demographic usable in targeting.
+type TargetingOption struct {
+	ID                     string   `json:"id"`
+	Name                   string   `json:"name"`
+	Type                   string   `json:"type,omitempty"`
+	Path                   []string `json:"path,omitempty"`
+	Description            string   `json:"description,omitempty"`
+	AudienceSizeLowerBound int      `json:"audience_size_lower_bound,omitempty"`
+	AudienceSizeUpperBound int      `json:"audience_size_upper_bound,omitempty"`
+}
+
+// GeoLocation is a location returned by the adgeolocation search.
+type GeoLocation struct {
+	Key         string `json:"key"`
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	CountryCode string `json:"country_code,omitempty"`
+	CountryName string `json:"country_name,omitempty"`
+	Region      string `json:"region,omitempty"`
+	RegionID    int    `json:"region_id,omitempty"`
+}
+
+// ArchivedAd is an ad from the Ad Library (ads_archive).
+type ArchivedAd struct {
+	ID                 string   `json:"id"`
+	PageID             string   `json:"page_id"`
+	PageName           string   `json:"page_name"`
+	CreationTime       string   `json:"ad_creation_time,omitempty"`
+	DeliveryStartTime  string   `json:"ad_delivery_start_time,omitempty"`
+	DeliveryStopTime   string   `json:"ad_delivery_stop_time,omitempty"`
+	SnapshotURL        string   `json:"ad_snapshot_url"`
+	PublisherPlatforms []string `json:"publisher_platforms,omitempty"`
+	Languages          []string `json:"languages,omitempty"`
+	CreativeBodies     []string `json:"ad_creative_bodies,omitempty"`
+	CreativeLinkTitles []string `json:"ad_creative_link_titles,omitempty"`
+}
+
+// BudgetSchedule is a planned budget increase (high demand period) of a
+// campaign or ad set. BudgetValue is a percentage for MULTIPLIER schedules
+// and an amount in cents for ABSOLUTE ones; times are unix seconds.
+type BudgetSchedule struct {
+	ID              string     `json:"id"`
+	BudgetValue     FlexString `json:"budget_value"`
+	BudgetValueType string     `json:"budget_value_type"`
+	TimeStart       int64      `json:"time_start"`
+	TimeEnd         int64      `json:"time_end"`
+	RecurrenceType  string     `json:"recurrence_type,omitempty"`
+}
+
+// AdImage is an image uploaded to an ad account's library, keyed by its hash.
+type AdImage struct {
+	Hash        string `json:"hash"`
+	Name        string `json:"name,omitempty"`
+	Width       int    `json:"width,omitempty"`
+	Height      int    `json:"height,omitempty"`
+	URL         string `json:"url,omitempty"`
+	Status      string `json:"status,omitempty"`
+	CreatedTime string `json:"created_time,omitempty"`
+}
+
+// AdVideo is a video uploaded to an ad account's library. Length is in seconds.
+type AdVideo struct {
+	ID          string  `json:"id"`
+	Title       string  `json:"title,omitempty"`
+	Length      float64 `json:"length,omitempty"`
+	Picture     string  `json:"picture,omitempty"`
+	CreatedTime string  `json:"created_time,omitempty"`
+}
@@ -0,0 +1,306 @@
+// Package capi builds Conversions API payloads.
+//
+// Customer information parameters (email, phone, names, ...) must be
+// normalized and SHA-256 hashed before they are sent to Meta; Hash applies
+// the normalization rules documented for each match key.
+package capi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// UserData holds customer information parameters. Hashed keys are stored as
+// hex-encoded SHA-256 digests; client IP and user agent are sent in clear.
+type UserData struct {
+	Email           []string `json:"em,omitempty"`
+	Phone           []string `json:"ph,omitempty"`
+	FirstName       []string `json:"fn,omitempty"`
+	LastName        []string `json:"ln,omitempty"`
+	City            []string `json:"ct,omitempty"`
+	State           []string `json:"st,omitempty"`
+	Zip             []string `json:"zp,omitempty"`
+	Country         []string `json:"country,omitempty"`
+	ExternalID      []string `json:"external_id,omitempty"`
+	ClientIPAddress string   `json:"client_ip_address,omitempty"`
+	ClientUserAgent string   `json:"client_user_agent,omitempty"`
+	FBC             string   `json:"fbc,omitempty"`
+	FBP             string   `json:"fbp,omitempty"`
+}
+
+// Event is a single server event sent to /{pixel_id}/events.
+type Event struct {
+	EventName      string         `json:"event_name"`
+	EventTime      int64          `json:"event_time"`
+	EventID        string         `json:"event_id,omitempty"`
+	EventSourceURL string         `json:"event_source_url,omitempty"`
+	ActionSource   string         `json:"action_source"`
+	UserData       UserData       `json:"user_data"`
+	CustomData     map[string]any `json:"custom_data,omitempty"`
+}
+
+// Response is the body returned by /{pixel_id}/events.
+type Response struct {
+	EventsReceived int      `json:"events_received"`
+	Messages       []string `json:"messages,omitempty"`
+	FBTraceID      string   `json:"fbtrace_id,omitempty"`
+}
+
+// HashedKeys lists the user_data keys that must be hashed, by their API names.
+var HashedKeys = []string{"em", "ph", "fn", "ln", "ct", "st", "zp", "country", "external_id"}
+
+// Normalize applies Meta's normalization rules for a match key.
+func Normalize(key, value string) string {
+	v := strings.ToLower(strings.TrimSpace(value))
+	switch key {
+	case "ph":
+		// Digits only, including the country code.
+		return keepRunes(v, unicode.IsDigit)
+	case "fn", "ln", "ct":
+		// Letters only; no punctuation or spaces.
+		return keepRunes(v, unicode.IsLetter)
+	case "st", "country":
+		return keepRunes(v, unicode.IsLetter)
+	case "zp":
+		v = strings.ReplaceAll(v, " ", "")
+		if i := strings.Index(v, "-"); i >= 0 {
+			v = v[:i]
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+// Hash normalizes value for the given key and returns its hex SHA-256 digest.
+// Values that already look like a SHA-256 digest are returned unchanged.
+// Empty values yield "".
+func Hash(key, value string) string {
+	if IsHashed(value) {
+		return strings.ToLower(value)
+	}
+	n := Normalize(key, value)
+	if n == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(n))
+	return hex.EncodeToString(sum[:])
+}
+
+// IsHashed reports whether s looks like a hex-encoded SHA-256 digest.
+func IsHashed(s string) bool {
+	if len(s) != 64 {
+		return false
+	}
+	for _, r := range s {
+		if !strings.ContainsRune("0123456789abcdefABCDEF", r) {
+			return false
+		}
+	}
+	return true
+}
+
+// Set hashes value and stores it under the given match key. Unknown keys and
+// empty values are ignored; it reports whether a value was stored.
+func (u *UserData) Set(key, value string) bool {
+	switch key {
+	case "client_ip_address":
+		u.ClientIPAddress = value
+		return value != ""
+	case "client_user_agent":
+		u.ClientUserAgent = value
+		return value != ""
+	case "fbc":
+		u.FBC = value
+		return value != ""
+	case "fbp":
+		u.FBP = value
+		return value != ""
+	}
+
+	h := Hash(key, value)
+	if h == "" {
+		return false
+	}
+	switch key {
+	case "em":
+		u.Email = append(u.Email, h)
+	case "ph":
+		u.Phone = append(u.Phone, h)
+	case "fn":
+		u.FirstName = append(u.FirstName, h)
+	case "ln":
+		u.LastName = append(u.LastName, h)
+	case "ct":
+		u.City = append(u.City, h)
+	case "st":
+		u.State = append(u.State, h)
+	case "zp":
+		u.Zip = append(u.Zip, h)
+	case "country":
+		u.Country = append(u.Country, h)
+	case "external_id":
+		u.ExternalID = append(u.ExternalID, h)
+	default:
+		return false
+	}
+	return true
+}
+
+// IsEmpty reports whether no customer information parameter is set.
+func (u UserData) IsEmpty() bool {
+	return len(u.Email) == 0 && len(u.Phone) == 0 && len(u.FirstName) == 0 &&
+		len(u.LastName) == 0 && len(u.City) == 0 && len(u.State) == 0 &&
+		len(u.Zip) == 0 && len(u.Country) == 0 && len(u.ExternalID) == 0 &&
+		u.ClientIPAddress == "" && u.ClientUserAgent == "" && u.FBC == "" && u.FBP == ""
+}
+
+func keepRunes(s string, keep func(rune) bool) string {
+	var b strings.Builder
+	for _, r := range s {
+		if keep(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// maxEventAgeDays returns how old an event with actionSource may be: 62 days
+// for physical_store events, which are often uploaded in batches after the
+// sale, and 7 days for the others.
+func maxEventAgeDays(actionSource string) int {
+	if actionSource == "physical_store" {
+		return 62
+	}
+	return 7
+}
+
+// Validate checks the fields Meta requires on every server event.
+func (e Event) Validate() error {
+	if e.EventName == "" {
+		return fmt.Errorf("missing event_name")
+	}
+	if e.EventTime == 0 {
+		return fmt.Errorf("missing event_time")
+	}
+	if e.EventTime > time.Now().Add(time.Minute).Unix() {
+		return fmt.Errorf("event_time is in the future")
+	}
+	if e.ActionSource == "" {
+		return fmt.Errorf("missing action_source")
+	}
+	if days := maxEventAgeDays(e.ActionSource); time.Since(time.Unix(e.EventTime, 0)) > time.Duration(days)*24*time.Hour {
+		return fmt.Errorf("event_time is older than %d days, the limit for %s events", days, e.ActionSource)
+	}
+	if e.UserData.IsEmpty() {
+		return fmt.Errorf("missing user_data")
+	}
+	return nil
+}
+
+// ParseJSONEvent decodes one event object, as found on a line of an NDJSON
+// file, hashing any clear-text customer information. user_data values may be a
+// string or an array of strings. actionSource is used when the event has none.
+func ParseJSONEvent(data []byte, actionSource string) (Event, error) {
+	var raw struct {
+		EventName      string                     `json:"event_name"`
+		EventTime      json.Number                `json:"event_time"`
+		EventID        string                     `json:"event_id"`
+		EventSourceURL string                     `json:"event_source_url"`
+		ActionSource   string                     `json:"action_source"`
+		UserData       map[string]json.RawMessage `json:"user_data"`
+		CustomData     map[string]any             `json:"custom_data"`
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&raw); err != nil {
+		return Event{}, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	e := Event{
+		EventName:      raw.EventName,
+		EventID:        raw.EventID,
+		EventSourceURL: raw.EventSourceURL,
+		ActionSource:   raw.ActionSource,
+		CustomData:     raw.CustomData,
+	}
+	if e.ActionSource == "" {
+		e.ActionSource = actionSource
+	}
+	if raw.EventTime != "" {
+		t, err := raw.EventTime.Int64()
+		if err != nil {
+			return e, fmt.Errorf("invalid event_time %q", raw.EventTime)
+		}
+		e.EventTime = t
+	}
+	for _, key := range NumericCustomData {
+		switch v := e.CustomData[key].(type) {
+		case nil:
+		case json.Number:
+			if _, err := ParseNumber(v.String()); err != nil {
+				return e, fmt.Errorf("invalid custom_data.%s %s", key, v)
+			}
+		case string:
+			n, err := ParseNumber(v)
+			if err != nil {
+				return e, fmt.Errorf("invalid custom_data.%s %q", key, v)
+			}
+			e.CustomData[key] = n
+		default:
+			return e, fmt.Errorf("custom_data.%s must be a number", key)
+		}
+	}
+
+	for key, v := range raw.UserData {
+		var one string
+		if json.Unmarshal(v, &one) == nil {
+			if !e.UserData.Set(key, one) && one != "" && !isKnownKey(key) {
+				return e, fmt.Errorf("unknown user_data key %q", key)
+			}
+			continue
+		}
+		var many []string
+		if err := json.Unmarshal(v, &many); err != nil {
+			return e, fmt.Errorf("user_data.%s must be a string or array of strings", key)
+		}
+		for _, s := range many {
+			if !e.UserData.Set(key, s) && s != "" && !isKnownKey(key) {
+				return e, fmt.Errorf("unknown user_data key %q", key)
+			}
+		}
+	}
+	return e, nil
+}
+
+// NumericCustomData are the custom_data keys Meta reads as numbers.
+var NumericCustomData = []string{"value", "predicted_ltv", "num_items"}
+
+// ParseNumber parses s as a JSON number that fits in a float64, the form
+// numeric custom_data values are sent in.
+func ParseNumber(s string) (json.Number, error) {
+	n := json.Number(strings.TrimSpace(s))
+	if _, err := n.Float64(); err != nil || !json.Valid([]byte(n)) {
+		return "", fmt.Errorf("%q is not a number", s)
+	}
+	return n, nil
+}
+
+func isKnownKey(key string) bool {
+	switch key {
+	case "client_ip_address", "client_user_agent", "fbc", "fbp":
+		return true
+	}
+	for _, k := range HashedKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,173 @@
+// Package catalog compiles the filter shorthand of `meta-ads catalogs` into
+// the JSON rules Meta uses for product set filters.
+package catalog
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// operators maps the shorthand operators to Meta's filter operators.
+var operators = map[string]string{
+	"=":                "eq",
+	"==":               "eq",
+	"eq":               "eq",
+	"!=":               "neq",
+	"neq":              "neq",
+	">":                "gt",
+	">=":               "gte",
+	"<":                "lt",
+	"<=":               "lte",
+	"contains":         "i_contains",
+	"not_contains":     "i_not_contains",
+	"starts_with":      "i_starts_with",
+	"in":               "is_any",
+	"not_in":           "is_not_any",
+	"i_contains":       "i_contains",
+	"i_not_contains":   "i_not_contains",
+	"i_starts_with":    "i_starts_with",
+	"is_any":           "is_any",
+	"is_not_any":       "is_not_any",
+	"gt":               "gt",
+	"gte":              "gte",
+	"lt":               "lt",
+	"lte":              "lte",
+	"case_contains":    "contains",
+	"case_starts_with": "starts_with",
+}
+
+// Operators lists the shorthand operators, for help texts.
+const Operators = "=, !=, >, >=, <, <=, contains, not_contains, starts_with, in, not_in"
+
+// ParseFilter compiles a filter such as
+//
+//	retailer_id starts_with SKU-A and price > 1000 or brand in Nike, Adidas
+//
+// into Meta's product set filter. "and" binds tighter than "or"; values
+// containing spaces around keywords can be quoted with ' or ". Text
+// comparisons are case-insensitive. A filter starting with "{" is taken as
+// raw filter JSON and only validated.
+func ParseFilter(s string) (map[string]any, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, fmt.Errorf("empty filter")
+	}
+	if strings.HasPrefix(s, "{") {
+		var raw map[string]any
+		if err := json.Unmarshal([]byte(s), &raw); err != nil {
+			return nil, fmt.Errorf("invalid filter JSON: %w", err)
+		}
+		return raw, nil
+	}
+
+	tokens, err := tokenize(s)
+	if err != nil {
+		return nil, err
+	}
+
+	var alternatives []map[string]any
+	for _, group := range split(tokens, "or") {
+		var conds []map[string]any
+		for _, c := range split(group, "and") {
+			cond, err := parseCondition(c)
+			if err != nil {
+				return nil, err
+			}
+			conds = append(conds, cond)
+		}
+		alternatives = append(alternatives, combine("and", conds))
+	}
+	return combine("or", alternatives), nil
+}
+
+// token is a word of a filter; quoted words are never keywords.
+type token struct {
+	text   string
+	quoted bool
+}
+
+// tokenize splits s on spaces, keeping quoted strings together.
+func tokenize(s string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(s); {
+		switch c := s[i]; {
+		case c == ' ' || c == '\t':
+			i++
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(s[i+1:], c)
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated quote in filter")
+			}
+			tokens = append(tokens, token{text: s[i+1 : i+1+end], quoted: true})
+			i += end + 2
+		default:
+			end := strings.IndexAny(s[i:], " \t")
+			if end < 0 {
+				end = len(s) - i
+			}
+			tokens = append(tokens, token{text: s[i : i+end]})
+			i += end
+		}
+	}
+	return tokens, nil
+}
+
+// split cuts tokens at every unquoted keyword.
+func split(tokens []token, keyword string) [][]token {
+	var parts [][]token
+	start := 0
+	for i, t := range tokens {
+		if !t.quoted && strings.EqualFold(t.text, keyword) {
+			parts = append(parts, tokens[start:i])
+			start = i + 1
+		}
+	}
+	return append(parts, tokens[start:])
+}
+
+// parseCondition compiles "<field> <operator> <value...>".
+func parseCondition(tokens []token) (map[string]any, error) {
+	words := make([]string, len(tokens))
+	for i, t := range tokens {
+		words[i] = t.text
+	}
+	if len(tokens) < 3 {
+		return nil, fmt.Errorf("invalid condition %q — expected \"<field> <operator> <value>\"", strings.Join(words, " "))
+	}
+	field := strings.ToLower(tokens[0].text)
+	op, ok := operators[strings.ToLower(tokens[1].text)]
+	if !ok {
+		return nil, fmt.Errorf("unknown operator %q — use %s", tokens[1].text, Operators)
+	}
+
+	var value any = strings.Join(words[2:], " ")
+	switch op {
+	case "is_any", "is_not_any":
+		var values []string
+		for _, v := range strings.Split(value.(string), ",") {
+			if v = strings.TrimSpace(v); v != "" {
+				values = append(values, v)
+			}
+		}
+		value = values
+	case "gt", "gte", "lt", "lte", "eq", "neq":
+		if n, err := strconv.ParseFloat(value.(string), 64); err == nil && !tokens[2].quoted {
+			value = n
+		}
+	}
+	return map[string]any{field: map[string]any{op: value}}, nil
+}
+
+// combine joins conditions with "and" or "or", leaving a single one as is.
+func combine(op string, conds []map[string]any) map[string]any {
+	if len(conds) == 1 {
+		return conds[0]
+	}
+	list := make([]any, len(conds))
+	for i, c := range conds {
+		list[i] = c
+	}
+	return map[string]any{op: list}
+}
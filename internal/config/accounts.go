@@ -0,0 +1,186 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// AccountConfig holds per-account overrides, keyed by act_* ID in Config.Accounts.
+type AccountConfig struct {
+	// Label is a display name shown next to the account ID.
+	Label string `json:"label,omitempty"`
+	// InsightFields replaces the default insights get --fields.
+	InsightFields string `json:"insight_fields,omitempty"`
+	// AttributionWindows is the default insights get --attribution-windows.
+	AttributionWindows string `json:"attribution_windows,omitempty"`
+	// Currency overrides the currency code shown after money amounts. It is
+	// only a label: amounts are neither converted nor read in its minor units.
+	Currency string `json:"currency,omitempty"`
+	// MonthlyTarget is the spend budgets pacing compares the month against,
+	// in minor units of the account currency.
+	MonthlyTarget string `json:"monthly_target,omitempty"`
+	// MaxFrequency is the frequency insights frequency flags objects above.
+	MaxFrequency string `json:"max_frequency,omitempty"`
+}
+
+func (a *AccountConfig) empty() bool {
+	return *a == AccountConfig{}
+}
+
+// Account returns the overrides of account (an act_* ID), or the zero value
+// when the account has no section.
+func (c *Config) Account(account string) AccountConfig {
+	if a, ok := c.Accounts[account]; ok && a != nil {
+		return *a
+	}
+	return AccountConfig{}
+}
+
+// AccountKey is a per-account setting managed with `meta-ads config account`.
+type AccountKey struct {
+	Name string
+	Help string
+
+	get func(*AccountConfig) string
+	set func(*AccountConfig, string) error
+}
+
+// Get returns the value of k for account in c ("" when unset).
+func (k AccountKey) Get(c *Config, account string) string {
+	a := c.Account(account)
+	return k.get(&a)
+}
+
+// Set stores value for k in the section of account, creating it if needed.
+// An empty value clears the key, and the section once it is empty.
+func (k AccountKey) Set(c *Config, account, value string) error {
+	a := c.Account(account)
+	if err := k.set(&a, value); err != nil {
+		return err
+	}
+	if a.empty() {
+		delete(c.Accounts, account)
+		return nil
+	}
+	if c.Accounts == nil {
+		c.Accounts = map[string]*AccountConfig{}
+	}
+	c.Accounts[account] = &a
+	return nil
+}
+
+// AttributionWindows are the values accepted by the action_attribution_windows
+// insights parameter.
+var AttributionWindows = []string{"1d_click", "7d_click", "28d_click", "1d_view", "7d_view", "28d_view", "1d_ev", "dda", "default"}
+
+// ParseAttributionWindows validates a comma-separated list of attribution windows.
+func ParseAttributionWindows(v string) ([]string, error) {
+	var windows []string
+	for _, w := range strings.Split(v, ",") {
+		w = strings.ToLower(strings.TrimSpace(w))
+		if w == "" {
+			continue
+		}
+		valid := false
+		for _, known := range AttributionWindows {
+			if w == known {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return nil, fmt.Errorf("invalid attribution window %q — use %s", w, strings.Join(AttributionWindows, ", "))
+		}
+		windows = append(windows, w)
+	}
+	return windows, nil
+}
+
+var currencyPattern = regexp.MustCompile(`^[A-Za-z]{3}$`)
+
+// AccountKeys lists every per-account key, in display order.
+var AccountKeys = []AccountKey{
+	{
+		Name: "label",
+		Help: "Display name shown next to the account ID",
+		get:  func(a *AccountConfig) string { return a.Label },
+		set:  func(a *AccountConfig, v string) error { a.Label = v; return nil },
+	},
+	{
+		Name: "insight_fields",
+		Help: "Default insights get --fields for this account",
+		get:  func(a *AccountConfig) string { return a.InsightFields },
+		set: func(a *AccountConfig, v string) error {
+			a.InsightFields = strings.ReplaceAll(v, " ", "")
+			return nil
+		},
+	},
+	{
+		Name: "attribution_windows",
+		Help: "Default insights get --attribution-windows, e.g. 7d_click,1d_view",
+		get:  func(a *AccountConfig) string { return a.AttributionWindows },
+		set: func(a *AccountConfig, v string) error {
+			windows, err := ParseAttributionWindows(v)
+			if err != nil {
+				return err
+			}
+			a.AttributionWindows = strings.Join(windows, ",")
+			return nil
+		},
+	},
+	{
+		Name: "currency",
+		Help: "Currency code shown after money amounts (a label, amounts aren't converted), e.g. EUR",
+		get:  func(a *AccountConfig) string { return a.Currency },
+		set: func(a *AccountConfig, v string) error {
+			if v != "" && !currencyPattern.MatchString(v) {
+				return fmt.Errorf("invalid currency %q — expected an ISO 4217 code like EUR", v)
+			}
+			a.Currency = strings.ToUpper(v)
+			return nil
+		},
+	},
+	{
+		Name: "monthly_target",
+		Help: "Monthly spend target in cents used by budgets pacing, e.g. 1500000",
+		get:  func(a *AccountConfig) string { return a.MonthlyTarget },
+		set: func(a *AccountConfig, v string) error {
+			if n, err := strconv.ParseInt(v, 10, 64); v != "" && (err != nil || n < 0) {
+				return fmt.Errorf("invalid monthly_target %q — expected an amount in cents, e.g. 1500000", v)
+			}
+			a.MonthlyTarget = v
+			return nil
+		},
+	},
+	{
+		Name: "max_frequency",
+		Help: "Frequency above which insights frequency flags an object, e.g. 3.5",
+		get:  func(a *AccountConfig) string { return a.MaxFrequency },
+		set: func(a *AccountConfig, v string) error {
+			if f, err := strconv.ParseFloat(v, 64); v != "" && (err != nil || f <= 0) {
+				return fmt.Errorf("invalid max_frequency %q — expected a positive number, e.g. 3.5", v)
+			}
+			a.MaxFrequency = v
+			return nil
+		},
+	},
+}
+
+// LookupAccountKey returns the per-account key called name.
+func LookupAccountKey(name string) (AccountKey, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, k := range AccountKeys {
+		if k.Name == name {
+			return k, nil
+		}
+	}
+	names := make([]string, len(AccountKeys))
+	for i, k := range AccountKeys {
+		names[i] = k.Name
+	}
+	sort.Strings(names)
+	return AccountKey{}, fmt.Errorf("unknown account key %q — available: %s", name, strings.Join(names, ", "))
+}
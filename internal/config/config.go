@@ -3,6 +3,7 @@ package config
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"path/filepath"
 )
@@ -26,14 +27,79 @@ type Config struct {
 	// App credentials stored optionally; env vars META_APP_ID / META_APP_SECRET take priority.
 	AppID     string `json:"app_id,omitempty"`
 	AppSecret string `json:"app_secret,omitempty"`
+	// Aliases maps human names to ad account IDs (e.g. "shop" → "act_123").
+	Aliases map[string]string `json:"aliases,omitempty"`
+	// Accounts holds per-account overrides keyed by act_* ID.
+	Accounts map[string]*AccountConfig `json:"accounts,omitempty"`
+	// Preferences used as flag defaults (flag > env var > config).
+	Output     string `json:"output,omitempty"`
+	Pretty     bool   `json:"pretty,omitempty"`
+	APIVersion string `json:"api_version,omitempty"`
+	Timeout    string `json:"timeout,omitempty"`
+	// KeepAlive is how long idle HTTP connections are kept for reuse, or
+	// "off" to open a new connection for every request.
+	KeepAlive    string `json:"keep_alive,omitempty"`
+	MaxIdleConns int    `json:"max_idle_conns,omitempty"`
+	// ConfirmBudgetIncrease is the budget increase, in percent, above which
+	// updates ask for confirmation ("off" never asks).
+	ConfirmBudgetIncrease string `json:"confirm_budget_increase,omitempty"`
+	// ReportingCurrency is the currency insights amounts are converted to.
+	ReportingCurrency string `json:"reporting_currency,omitempty"`
+	// FXRates is the exchange rate source: "ecb" or a rates file path.
+	FXRates string `json:"fx_rates,omitempty"`
+	// Encrypted holds AccessToken and AppSecret when the file is encrypted at
+	// rest (see auth encrypt-config). Load decrypts it transparently.
+	Encrypted *Encrypted `json:"encrypted,omitempty"`
+
+	// passphrase is set when the config is (to be) saved encrypted.
+	passphrase string
+}
+
+// KeepSettings copies the user settings of prev (default account, aliases,
+// account sections, preferences, encryption) into c, for commands that replace the credentials.
+func (c *Config) KeepSettings(prev *Config) {
+	if prev == nil {
+		return
+	}
+	c.DefaultAccount = prev.DefaultAccount
+	c.Aliases = prev.Aliases
+	c.Accounts = prev.Accounts
+	c.Output = prev.Output
+	c.Pretty = prev.Pretty
+	c.APIVersion = prev.APIVersion
+	c.Timeout = prev.Timeout
+	c.KeepAlive = prev.KeepAlive
+	c.MaxIdleConns = prev.MaxIdleConns
+	c.ConfirmBudgetIncrease = prev.ConfirmBudgetIncrease
+	c.ReportingCurrency = prev.ReportingCurrency
+	c.FXRates = prev.FXRates
+	c.passphrase = prev.passphrase
+}
+
+// EnvPath is the environment variable that overrides the config file location.
+const EnvPath = "META_ADS_CONFIG"
+
+// pathOverride is set by SetPath (the --config flag).
+var pathOverride string
+
+// SetPath makes Load, Save and Clear use path instead of the default location.
+// It takes priority over META_ADS_CONFIG.
+func SetPath(path string) {
+	pathOverride = path
 }
 
-// configPath returns the path to the config file.
-// Uses os.UserConfigDir() for cross-platform support:
+// configPath returns the path to the config file: the SetPath override,
+// else $META_ADS_CONFIG, else os.UserConfigDir() for cross-platform support:
 //   - macOS:   ~/Library/Application Support/meta-ads/config.json
 //   - Linux:   ~/.config/meta-ads/config.json
 //   - Windows: %AppData%\meta-ads\config.json
 func configPath() (string, error) {
+	if pathOverride != "" {
+		return pathOverride, nil
+	}
+	if p := os.Getenv(EnvPath); p != "" {
+		return p, nil
+	}
 	dir, err := os.UserConfigDir()
 	if err != nil {
 		return "", err
@@ -60,10 +126,16 @@ func Load() (*Config, error) {
 	if err := json.Unmarshal(data, &cfg); err != nil {
 		return nil, err
 	}
+	if cfg.Encrypted != nil {
+		if err := cfg.open(); err != nil {
+			return nil, err
+		}
+	}
 	return &cfg, nil
 }
 
 // Save writes the config file with 0600 permissions.
+// The secrets are encrypted when cfg.IsEncrypted().
 func Save(cfg *Config) error {
 	path, err := configPath()
 	if err != nil {
@@ -74,7 +146,15 @@ func Save(cfg *Config) error {
 		return err
 	}
 
-	data, err := json.MarshalIndent(cfg, "", "  ")
+	out := *cfg
+	out.Encrypted = nil
+	if cfg.IsEncrypted() {
+		if err := out.seal(); err != nil {
+			return fmt.Errorf("encrypting config: %w", err)
+		}
+	}
+
+	data, err := json.MarshalIndent(&out, "", "  ")
 	if err != nil {
 		return err
 	}
@@ -95,6 +175,16 @@ func Clear() error {
 	return err
 }
 
+// AuditLogPath returns the path of the log of mutating API calls, next to
+// the config file ("" when there is no config directory).
+func AuditLogPath() string {
+	p, err := configPath()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(filepath.Dir(p), "audit.jsonl")
+}
+
 // Path returns the config file path for display purposes.
 func Path() string {
 	p, _ := configPath()
@@ -0,0 +1,144 @@
+package config
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// EnvPassphrase is the environment variable holding the passphrase of an
+// encrypted config file.
+const EnvPassphrase = "META_ADS_PASSPHRASE"
+
+// ErrNoPassphrase is returned by Load when the config file is encrypted and
+// no passphrase is available.
+var ErrNoPassphrase = errors.New("config file is encrypted — set " + EnvPassphrase + " or run in a terminal to be prompted")
+
+// PassphrasePrompt asks the user for the passphrase of an encrypted config.
+// It is set by the CLI when a terminal is available; nil means "don't prompt".
+var PassphrasePrompt func() (string, error)
+
+// Encrypted holds the secret fields of a config file encrypted at rest with
+// AES-256-GCM, under a key derived from a passphrase with scrypt.
+type Encrypted struct {
+	KDF        string `json:"kdf"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// secrets are the fields stored inside Encrypted.
+type secrets struct {
+	AccessToken string `json:"access_token"`
+	AppSecret   string `json:"app_secret,omitempty"`
+}
+
+// scrypt parameters recommended for interactive logins.
+const (
+	scryptN      = 1 << 15
+	scryptR      = 8
+	scryptP      = 1
+	scryptKeyLen = 32
+)
+
+// IsEncrypted returns true when the secrets of c are encrypted at rest.
+func (c *Config) IsEncrypted() bool {
+	return c.passphrase != ""
+}
+
+// Encrypt makes Save store the access token and app secret encrypted with passphrase.
+func (c *Config) Encrypt(passphrase string) error {
+	if passphrase == "" {
+		return errors.New("passphrase must not be empty")
+	}
+	c.passphrase = passphrase
+	return nil
+}
+
+// Decrypt makes Save store the secrets in plain text again.
+func (c *Config) Decrypt() {
+	c.passphrase = ""
+}
+
+// seal encrypts the secrets of c into c.Encrypted and clears the plain fields.
+func (c *Config) seal() error {
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return err
+	}
+	gcm, err := newGCM(c.passphrase, salt)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+	plain, err := json.Marshal(secrets{AccessToken: c.AccessToken, AppSecret: c.AppSecret})
+	if err != nil {
+		return err
+	}
+	c.Encrypted = &Encrypted{
+		KDF:        "scrypt",
+		Salt:       salt,
+		Nonce:      nonce,
+		Ciphertext: gcm.Seal(nil, nonce, plain, nil),
+	}
+	c.AccessToken = ""
+	c.AppSecret = ""
+	return nil
+}
+
+// open decrypts c.Encrypted into the plain secret fields of c.
+func (c *Config) open() error {
+	passphrase := os.Getenv(EnvPassphrase)
+	if passphrase == "" && PassphrasePrompt != nil {
+		var err error
+		if passphrase, err = PassphrasePrompt(); err != nil {
+			return err
+		}
+	}
+	if passphrase == "" {
+		return ErrNoPassphrase
+	}
+
+	e := c.Encrypted
+	if e.KDF != "scrypt" {
+		return fmt.Errorf("unsupported key derivation %q in encrypted config", e.KDF)
+	}
+	gcm, err := newGCM(passphrase, e.Salt)
+	if err != nil {
+		return err
+	}
+	plain, err := gcm.Open(nil, e.Nonce, e.Ciphertext, nil)
+	if err != nil {
+		return errors.New("cannot decrypt config: wrong passphrase")
+	}
+	var s secrets
+	if err := json.Unmarshal(plain, &s); err != nil {
+		return fmt.Errorf("cannot decrypt config: %w", err)
+	}
+	c.AccessToken = s.AccessToken
+	c.AppSecret = s.AppSecret
+	c.Encrypted = nil
+	c.passphrase = passphrase
+	return nil
+}
+
+func newGCM(passphrase string, salt []byte) (cipher.AEAD, error) {
+	key, err := scrypt.Key([]byte(passphrase), salt, scryptN, scryptR, scryptP, scryptKeyLen)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
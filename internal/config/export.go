@@ -0,0 +1,93 @@
+package config
+
+// Redacted returns a copy of c without the credentials (access token, app
+// secret, and the user fields tied to the token), safe to share with a team.
+func (c *Config) Redacted() *Config {
+	out := *c
+	out.AccessToken = ""
+	out.TokenType = ""
+	out.UserID = ""
+	out.UserName = ""
+	out.AppSecret = ""
+	out.Encrypted = nil
+	out.passphrase = ""
+	return &out
+}
+
+// Merge copies the values set in imported into c. Aliases and account sections
+// are merged (imported wins on conflicts); the token and user fields are only
+// taken together, when imported carries a token.
+func (c *Config) Merge(imported *Config) {
+	if imported.AccessToken != "" {
+		c.AccessToken = imported.AccessToken
+		c.TokenType = imported.TokenType
+		c.UserID = imported.UserID
+		c.UserName = imported.UserName
+	}
+	if imported.DefaultAccount != "" {
+		c.DefaultAccount = imported.DefaultAccount
+	}
+	if imported.AppID != "" {
+		c.AppID = imported.AppID
+	}
+	if imported.AppSecret != "" {
+		c.AppSecret = imported.AppSecret
+	}
+	for alias, account := range imported.Aliases {
+		if c.Aliases == nil {
+			c.Aliases = map[string]string{}
+		}
+		c.Aliases[alias] = account
+	}
+	for account, a := range imported.Accounts {
+		if a == nil {
+			continue
+		}
+		if c.Accounts == nil {
+			c.Accounts = map[string]*AccountConfig{}
+		}
+		c.Accounts[account] = a
+	}
+	if imported.Output != "" {
+		c.Output = imported.Output
+	}
+	if imported.Pretty {
+		c.Pretty = true
+	}
+	if imported.APIVersion != "" {
+		c.APIVersion = imported.APIVersion
+	}
+	if imported.Timeout != "" {
+		c.Timeout = imported.Timeout
+	}
+	if imported.KeepAlive != "" {
+		c.KeepAlive = imported.KeepAlive
+	}
+	if imported.MaxIdleConns != 0 {
+		c.MaxIdleConns = imported.MaxIdleConns
+	}
+	if imported.ConfirmBudgetIncrease != "" {
+		c.ConfirmBudgetIncrease = imported.ConfirmBudgetIncrease
+	}
+	if imported.ReportingCurrency != "" {
+		c.ReportingCurrency = imported.ReportingCurrency
+	}
+	if imported.FXRates != "" {
+		c.FXRates = imported.FXRates
+	}
+}
+
+// Credentials returns a copy of c holding only the credentials and the
+// encryption settings, dropping the defaults, aliases, account sections and
+// preferences.
+func (c *Config) Credentials() *Config {
+	return &Config{
+		AccessToken: c.AccessToken,
+		TokenType:   c.TokenType,
+		UserID:      c.UserID,
+		UserName:    c.UserName,
+		AppID:       c.AppID,
+		AppSecret:   c.AppSecret,
+		passphrase:  c.passphrase,
+	}
+}
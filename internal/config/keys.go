@@ -0,0 +1,297 @@
+package config
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Key is a configuration key that can be managed with `meta-ads config`.
+type Key struct {
+	Name string
+	Help string
+	// Secret values are masked when listed.
+	Secret bool
+	// ReadOnly keys are written by the auth commands and can only be inspected.
+	ReadOnly bool
+
+	get func(*Config) string
+	set func(*Config, string) error
+}
+
+// Get returns the value of k in c ("" when unset).
+func (k Key) Get(c *Config) string {
+	return k.get(c)
+}
+
+// Set stores value for k in c.
+func (k Key) Set(c *Config, value string) error {
+	if k.ReadOnly {
+		return fmt.Errorf("%s is read-only — it is managed by: meta-ads auth", k.Name)
+	}
+	return k.set(c, value)
+}
+
+// Unset clears k in c.
+func (k Key) Unset(c *Config) error {
+	if k.ReadOnly {
+		return fmt.Errorf("%s is read-only — use: meta-ads auth logout", k.Name)
+	}
+	return k.set(c, "")
+}
+
+// Keys lists every configuration key, in display order.
+var Keys = []Key{
+	{
+		Name: "default_account",
+		Help: "Ad account (ID or alias) used when --account and META_ADS_ACCOUNT are not set",
+		get:  func(c *Config) string { return c.DefaultAccount },
+		set: func(c *Config, v string) error {
+			if _, isAlias := c.Aliases[v]; v != "" && !isAlias && !strings.HasPrefix(v, "act_") {
+				v = "act_" + v
+			}
+			c.DefaultAccount = v
+			return nil
+		},
+	},
+	{
+		Name: "app_id",
+		Help: "Meta app ID (META_APP_ID takes priority)",
+		get:  func(c *Config) string { return c.AppID },
+		set:  func(c *Config, v string) error { c.AppID = v; return nil },
+	},
+	{
+		Name:   "app_secret",
+		Help:   "Meta app secret, enables appsecret_proof (META_APP_SECRET takes priority)",
+		Secret: true,
+		get:    func(c *Config) string { return c.AppSecret },
+		set:    func(c *Config, v string) error { c.AppSecret = v; return nil },
+	},
+	{
+		Name: "output",
+		Help: "Default output format: table, json, csv, yaml, ndjson, markdown (env: META_ADS_OUTPUT)",
+		get:  func(c *Config) string { return c.Output },
+		set: func(c *Config, v string) error {
+			v = strings.ToLower(v)
+			switch v {
+			case "", "table", "json", "csv", "yaml", "yml", "ndjson", "markdown", "md":
+				c.Output = v
+				return nil
+			}
+			return fmt.Errorf("invalid output %q — use table, json, csv, yaml, ndjson, markdown", v)
+		},
+	},
+	{
+		Name: "pretty",
+		Help: "Indent JSON output: true or false (env: META_ADS_PRETTY)",
+		get: func(c *Config) string {
+			if c.Pretty {
+				return "true"
+			}
+			return ""
+		},
+		set: func(c *Config, v string) error {
+			if v == "" {
+				c.Pretty = false
+				return nil
+			}
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return fmt.Errorf("invalid pretty %q — use true or false", v)
+			}
+			c.Pretty = b
+			return nil
+		},
+	},
+	{
+		Name: "api_version",
+		Help: "Graph API version, e.g. v23.0 (env: META_ADS_API_VERSION)",
+		get:  func(c *Config) string { return c.APIVersion },
+		set: func(c *Config, v string) error {
+			if v != "" && !apiVersionPattern.MatchString(v) {
+				return fmt.Errorf("invalid api_version %q — expected e.g. v23.0", v)
+			}
+			c.APIVersion = v
+			return nil
+		},
+	},
+	{
+		Name: "timeout",
+		Help: "HTTP request timeout, e.g. 60s (env: META_ADS_TIMEOUT)",
+		get:  func(c *Config) string { return c.Timeout },
+		set: func(c *Config, v string) error {
+			if v != "" {
+				if _, err := ParseTimeout(v); err != nil {
+					return err
+				}
+			}
+			c.Timeout = v
+			return nil
+		},
+	},
+	{
+		Name: "keep_alive",
+		Help: "How long idle HTTP connections are kept for reuse, e.g. 90s, or off (default 90s; env: META_ADS_KEEP_ALIVE)",
+		get:  func(c *Config) string { return c.KeepAlive },
+		set: func(c *Config, v string) error {
+			if v != "" {
+				if _, _, err := ParseKeepAlive(v); err != nil {
+					return err
+				}
+			}
+			c.KeepAlive = v
+			return nil
+		},
+	},
+	{
+		Name: "max_idle_conns",
+		Help: "Idle HTTP connections kept open for reuse (default 10; env: META_ADS_MAX_IDLE_CONNS)",
+		get: func(c *Config) string {
+			if c.MaxIdleConns == 0 {
+				return ""
+			}
+			return strconv.Itoa(c.MaxIdleConns)
+		},
+		set: func(c *Config, v string) error {
+			if v == "" {
+				c.MaxIdleConns = 0
+				return nil
+			}
+			n, err := ParseMaxIdleConns(v)
+			if err != nil {
+				return err
+			}
+			c.MaxIdleConns = n
+			return nil
+		},
+	},
+	{
+		Name: "confirm_budget_increase",
+		Help: "Ask before raising a budget by more than this percent, or off (default 20; env: META_ADS_CONFIRM_BUDGET_INCREASE)",
+		get:  func(c *Config) string { return c.ConfirmBudgetIncrease },
+		set: func(c *Config, v string) error {
+			if v != "" {
+				if _, _, err := ParseBudgetThreshold(v); err != nil {
+					return err
+				}
+			}
+			c.ConfirmBudgetIncrease = v
+			return nil
+		},
+	},
+	{
+		Name: "reporting_currency",
+		Help: "Convert insights amounts to this currency, e.g. EUR (env: META_ADS_REPORTING_CURRENCY)",
+		get:  func(c *Config) string { return c.ReportingCurrency },
+		set: func(c *Config, v string) error {
+			if v != "" && !currencyPattern.MatchString(v) {
+				return fmt.Errorf("invalid reporting_currency %q — expected an ISO 4217 code like EUR", v)
+			}
+			c.ReportingCurrency = strings.ToUpper(v)
+			return nil
+		},
+	},
+	{
+		Name: "fx_rates",
+		Help: "Exchange rates for reporting_currency: ecb (default) or a rates file path (env: META_ADS_FX_RATES)",
+		get:  func(c *Config) string { return c.FXRates },
+		set:  func(c *Config, v string) error { c.FXRates = v; return nil },
+	},
+	{
+		Name:     "access_token",
+		Help:     "Access token saved by meta-ads auth",
+		Secret:   true,
+		ReadOnly: true,
+		get:      func(c *Config) string { return c.AccessToken },
+	},
+	{
+		Name:     "token_type",
+		Help:     "How the access token was obtained",
+		ReadOnly: true,
+		get:      func(c *Config) string { return string(c.TokenType) },
+	},
+	{
+		Name:     "user_id",
+		Help:     "Meta user ID of the token owner",
+		ReadOnly: true,
+		get:      func(c *Config) string { return c.UserID },
+	},
+	{
+		Name:     "user_name",
+		Help:     "Meta user name of the token owner",
+		ReadOnly: true,
+		get:      func(c *Config) string { return c.UserName },
+	},
+}
+
+var apiVersionPattern = regexp.MustCompile(`^v?\d+\.\d+$`)
+
+// ParseTimeout parses a timeout preference ("60s", "2m", or plain seconds).
+func ParseTimeout(v string) (time.Duration, error) {
+	if n, err := strconv.Atoi(v); err == nil {
+		v = strconv.Itoa(n) + "s"
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid timeout %q — expected e.g. 60s", v)
+	}
+	return d, nil
+}
+
+// ParseKeepAlive parses a keep_alive preference: a duration like ParseTimeout,
+// or "off" (enabled is then false).
+func ParseKeepAlive(v string) (idle time.Duration, enabled bool, err error) {
+	if strings.EqualFold(strings.TrimSpace(v), "off") {
+		return 0, false, nil
+	}
+	if n, err := strconv.Atoi(v); err == nil {
+		v = strconv.Itoa(n) + "s"
+	}
+	idle, err = time.ParseDuration(v)
+	if err != nil || idle <= 0 {
+		return 0, false, fmt.Errorf("invalid keep_alive %q — expected e.g. 90s, or off", v)
+	}
+	return idle, true, nil
+}
+
+// ParseMaxIdleConns parses a max_idle_conns preference: a positive integer.
+func ParseMaxIdleConns(v string) (int, error) {
+	n, err := strconv.Atoi(strings.TrimSpace(v))
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid max_idle_conns %q — expected a positive number", v)
+	}
+	return n, nil
+}
+
+// ParseBudgetThreshold parses a confirm_budget_increase preference: a
+// percentage like "20" or "20%", or "off" (enabled is then false).
+func ParseBudgetThreshold(v string) (percent float64, enabled bool, err error) {
+	v = strings.TrimSpace(v)
+	if strings.EqualFold(v, "off") {
+		return 0, false, nil
+	}
+	percent, err = strconv.ParseFloat(strings.TrimSuffix(v, "%"), 64)
+	if err != nil || percent < 0 {
+		return 0, false, fmt.Errorf("invalid confirm_budget_increase %q — expected a percentage like 20, or off", v)
+	}
+	return percent, true, nil
+}
+
+// LookupKey returns the key called name.
+func LookupKey(name string) (Key, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for _, k := range Keys {
+		if k.Name == name {
+			return k, nil
+		}
+	}
+	names := make([]string, len(Keys))
+	for i, k := range Keys {
+		names[i] = k.Name
+	}
+	sort.Strings(names)
+	return Key{}, fmt.Errorf("unknown config key %q — available: %s", name, strings.Join(names, ", "))
+}
@@ -0,0 +1,138 @@
+// Package fx converts money amounts between currencies with exchange rates
+// from a pluggable source: the European Central Bank's daily reference rates
+// or a fixed rates file.
+package fx
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+)
+
+// Rates are the exchange rates of one date against a base currency.
+type Rates struct {
+	// Source describes where the rates come from, e.g. "ECB".
+	Source string `json:"source,omitempty"`
+	Base   string `json:"base"`
+	// Date is the day the rates apply to, YYYY-MM-DD.
+	Date string `json:"date"`
+	// Rates maps currencies to the amount of them one unit of Base buys.
+	Rates map[string]float64 `json:"rates"`
+}
+
+// Rate returns the factor that converts amounts in from to amounts in to.
+func (r *Rates) Rate(from, to string) (float64, error) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return 1, nil
+	}
+	perBase := func(c string) (float64, error) {
+		if c == strings.ToUpper(r.Base) {
+			return 1, nil
+		}
+		if v, ok := r.Rates[c]; ok && v > 0 {
+			return v, nil
+		}
+		return 0, fmt.Errorf("no %s rate in the %s rates of %s", c, r.Source, r.Date)
+	}
+	f, err := perBase(from)
+	if err != nil {
+		return 0, err
+	}
+	t, err := perBase(to)
+	if err != nil {
+		return 0, err
+	}
+	return t / f, nil
+}
+
+// Source provides exchange rates.
+type Source interface {
+	Rates() (*Rates, error)
+}
+
+// NewSource returns the rate source described by spec: "ecb" for the ECB
+// daily reference rates, or the path of a rates file.
+func NewSource(spec string) Source {
+	if spec == "" || strings.EqualFold(spec, "ecb") {
+		return ECB{}
+	}
+	return File{Path: spec}
+}
+
+// ecbURL serves the ECB euro foreign exchange reference rates of the last
+// working day.
+const ecbURL = "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"
+
+// ECB fetches the European Central Bank's daily reference rates (base EUR).
+type ECB struct{}
+
+// Rates downloads the latest ECB rates.
+func (ECB) Rates() (*Rates, error) {
+	resp, err := (&http.Client{Timeout: 30 * time.Second}).Get(ecbURL)
+	if err != nil {
+		return nil, fmt.Errorf("fetching ECB rates: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching ECB rates: HTTP %d", resp.StatusCode)
+	}
+
+	var doc struct {
+		Cube struct {
+			Cube struct {
+				Time  string `xml:"time,attr"`
+				Rates []struct {
+					Currency string  `xml:"currency,attr"`
+					Rate     float64 `xml:"rate,attr"`
+				} `xml:"Cube"`
+			} `xml:"Cube"`
+		} `xml:"Cube"`
+	}
+	if err := xml.NewDecoder(resp.Body).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parsing ECB rates: %w", err)
+	}
+	r := &Rates{Source: "ECB", Base: "EUR", Date: doc.Cube.Cube.Time, Rates: map[string]float64{}}
+	for _, c := range doc.Cube.Cube.Rates {
+		r.Rates[strings.ToUpper(c.Currency)] = c.Rate
+	}
+	if len(r.Rates) == 0 {
+		return nil, fmt.Errorf("parsing ECB rates: no rates in the response")
+	}
+	return r, nil
+}
+
+// File reads fixed rates from a JSON file:
+//
+//	{"base": "EUR", "date": "2026-02-01", "rates": {"USD": 1.08, "GBP": 0.85}}
+type File struct {
+	Path string
+}
+
+// Rates reads the rates file.
+func (f File) Rates() (*Rates, error) {
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return nil, fmt.Errorf("reading rates file: %w", err)
+	}
+	var r Rates
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, fmt.Errorf("parsing rates file %s: %w", f.Path, err)
+	}
+	if r.Base == "" || len(r.Rates) == 0 {
+		return nil, fmt.Errorf("rates file %s needs a base currency and rates", f.Path)
+	}
+	if r.Source == "" {
+		r.Source = f.Path
+	}
+	rates := make(map[string]float64, len(r.Rates))
+	for c, v := range r.Rates {
+		rates[strings.ToUpper(c)] = v
+	}
+	r.Rates = rates
+	return &r, nil
+}
@@ -0,0 +1,108 @@
+package metaauth
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Path returns the location of the shared meta-auth config file.
+func Path() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "meta-auth", "config.json"), nil
+}
+
+// Token lock timing: how long Save waits for another CLI to release the lock,
+// and after how long a leftover lock file is considered abandoned.
+const (
+	lockWait  = 10 * time.Second
+	lockStale = 30 * time.Second
+)
+
+// Save writes token to the shared meta-auth config so every Meta CLI picks it
+// up. expiresAt is a unix timestamp (0 when unknown). Other fields of the
+// file, written by other tools, are preserved.
+//
+// Concurrent writers are serialised with a lock file next to the config, and
+// the file is replaced atomically so readers never see a partial write.
+func Save(token, userName string, expiresAt int64) error {
+	path, err := Path()
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+
+	unlock, err := lock(path + ".lock")
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	fields := map[string]json.RawMessage{}
+	data, err := os.ReadFile(path)
+	switch {
+	case err == nil:
+		if err := json.Unmarshal(data, &fields); err != nil {
+			return fmt.Errorf("parsing %s: %w", path, err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return err
+	}
+
+	set := func(key string, v any) {
+		b, _ := json.Marshal(v)
+		fields[key] = b
+	}
+	set("access_token", token)
+	if userName != "" {
+		set("user_name", userName)
+	}
+	if expiresAt > 0 {
+		set("token_expires_at", expiresAt)
+	} else {
+		delete(fields, "token_expires_at")
+	}
+
+	out, err := json.MarshalIndent(fields, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, out, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// lock acquires an exclusive lock file, waiting up to lockWait for another
+// process to release it. Lock files older than lockStale are taken over.
+func lock(path string) (unlock func(), err error) {
+	deadline := time.Now().Add(lockWait)
+	for {
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+		if err == nil {
+			fmt.Fprintf(f, "%d\n", os.Getpid())
+			f.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !errors.Is(err, os.ErrExist) {
+			return nil, err
+		}
+		if info, statErr := os.Stat(path); statErr == nil && time.Since(info.ModTime()) > lockStale {
+			os.Remove(path)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("shared config is locked by another process (%s)", path)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+}
@@ -0,0 +1,87 @@
+package monitor
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// InsightFields are the insight fields requested to compute Metrics.
+const InsightFields = "spend,impressions,clicks,reach,frequency,ctr,cpc,cpm,actions,purchase_roas"
+
+// Metrics lists the metrics usable in conditions.
+var Metrics = []string{"spend", "impressions", "clicks", "reach", "frequency", "ctr", "cpc", "cpm", "conversions", "cpa", "roas"}
+
+// IsMetric reports whether name is a known metric.
+func IsMetric(name string) bool {
+	for _, m := range Metrics {
+		if m == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Compute extracts the metrics of one insights row. conversion is the action
+// type counted by conversions and cpa. Metrics that can't be computed are
+// left out (cpa without conversions, roas without purchases).
+func Compute(row json.RawMessage, conversion string) (map[string]float64, error) {
+	var r map[string]json.RawMessage
+	if err := json.Unmarshal(row, &r); err != nil {
+		return nil, err
+	}
+	m := map[string]float64{}
+	for _, f := range []string{"spend", "impressions", "clicks", "reach", "frequency", "ctr", "cpc", "cpm"} {
+		if v, ok := number(r[f]); ok {
+			m[f] = v
+		}
+	}
+	// Objects without delivery have no row values at all: count as zero.
+	for _, f := range []string{"spend", "impressions", "clicks"} {
+		if _, ok := m[f]; !ok {
+			m[f] = 0
+		}
+	}
+
+	var actions []struct {
+		ActionType string          `json:"action_type"`
+		Value      json.RawMessage `json:"value"`
+	}
+	_ = json.Unmarshal(r["actions"], &actions)
+	conversions := 0.0
+	for _, a := range actions {
+		if a.ActionType == conversion {
+			conversions, _ = number(a.Value)
+		}
+	}
+	m["conversions"] = conversions
+	if conversions > 0 {
+		m["cpa"] = m["spend"] / conversions
+	}
+
+	var roas []struct {
+		Value json.RawMessage `json:"value"`
+	}
+	if json.Unmarshal(r["purchase_roas"], &roas) == nil && len(roas) > 0 {
+		if v, ok := number(roas[0].Value); ok {
+			m["roas"] = v
+		}
+	}
+	return m, nil
+}
+
+// number parses a JSON number or numeric string.
+func number(raw json.RawMessage) (float64, bool) {
+	if len(raw) == 0 {
+		return 0, false
+	}
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		v, err := strconv.ParseFloat(s, 64)
+		return v, err == nil
+	}
+	var v float64
+	if json.Unmarshal(raw, &v) == nil {
+		return v, true
+	}
+	return 0, false
+}
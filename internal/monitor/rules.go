@@ -0,0 +1,195 @@
+// Package monitor holds the local rules evaluated by `meta-ads monitor`:
+// conditions on insight metrics and the action to take when they all match.
+package monitor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Actions a rule can take on matching objects.
+const (
+	ActionPause   = "pause"
+	ActionUnpause = "unpause"
+	ActionNotify  = "notify"
+)
+
+// DefaultConversion is the action type counted by conversions and cpa.
+const DefaultConversion = "purchase"
+
+// Rule is a local automated rule.
+type Rule struct {
+	Name string `yaml:"name"`
+	// Level is the object type evaluated: campaign, adset or ad.
+	Level string `yaml:"level"`
+	// DatePreset is the insights window, e.g. last_3d, today, last_7d.
+	DatePreset string `yaml:"date_preset"`
+	// When lists conditions like "cpa > 40"; all must match.
+	When []string `yaml:"when"`
+	// Action is pause, unpause or notify.
+	Action string `yaml:"action"`
+	// Conversion is the action type behind conversions and cpa.
+	Conversion string `yaml:"conversion,omitempty"`
+	// Webhook receives notify messages as JSON {"text": ...} (Slack-compatible).
+	Webhook string `yaml:"webhook,omitempty"`
+	// Cooldown is the minimum time between two notifications for one object.
+	Cooldown string `yaml:"cooldown,omitempty"`
+
+	conditions []Condition
+	cooldown   time.Duration
+}
+
+// File is a rules file.
+type File struct {
+	// Account is the ad account evaluated; defaults to the resolved account.
+	Account string  `yaml:"account,omitempty"`
+	Rules   []*Rule `yaml:"rules"`
+}
+
+// Condition compares a metric with a threshold.
+type Condition struct {
+	Metric    string
+	Operator  string
+	Threshold float64
+}
+
+var conditionPattern = regexp.MustCompile(`^\s*([a-z_]+)\s*(>=|<=|==|!=|>|<)\s*(-?[0-9.]+)\s*$`)
+
+// ParseCondition parses a condition such as "cpa > 40".
+func ParseCondition(s string) (Condition, error) {
+	m := conditionPattern.FindStringSubmatch(s)
+	if m == nil {
+		return Condition{}, fmt.Errorf("invalid condition %q — expected e.g. \"cpa > 40\"", s)
+	}
+	v, err := strconv.ParseFloat(m[3], 64)
+	if err != nil {
+		return Condition{}, fmt.Errorf("invalid condition %q: %w", s, err)
+	}
+	return Condition{Metric: m[1], Operator: m[2], Threshold: v}, nil
+}
+
+// Match reports whether the metric value satisfies the condition.
+func (c Condition) Match(v float64) bool {
+	switch c.Operator {
+	case ">":
+		return v > c.Threshold
+	case ">=":
+		return v >= c.Threshold
+	case "<":
+		return v < c.Threshold
+	case "<=":
+		return v <= c.Threshold
+	case "==":
+		return v == c.Threshold
+	case "!=":
+		return v != c.Threshold
+	}
+	return false
+}
+
+func (c Condition) String() string {
+	return fmt.Sprintf("%s %s %s", c.Metric, c.Operator, strconv.FormatFloat(c.Threshold, 'f', -1, 64))
+}
+
+// Load reads and validates a rules file.
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+	var f File
+	if err := yaml.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if len(f.Rules) == 0 {
+		return nil, fmt.Errorf("%s: no rules defined", path)
+	}
+	for i, r := range f.Rules {
+		if err := r.init(); err != nil {
+			name := r.Name
+			if name == "" {
+				name = fmt.Sprintf("rules[%d]", i)
+			}
+			return nil, fmt.Errorf("%s: %s: %w", path, name, err)
+		}
+	}
+	return &f, nil
+}
+
+// init fills in defaults and parses the conditions.
+func (r *Rule) init() error {
+	if r.Name == "" {
+		return errors.New("name is required")
+	}
+	r.Level = strings.ToLower(r.Level)
+	switch r.Level {
+	case "":
+		r.Level = "adset"
+	case "campaign", "adset", "ad":
+	default:
+		return fmt.Errorf("invalid level %q — use campaign, adset, or ad", r.Level)
+	}
+	if r.DatePreset == "" {
+		r.DatePreset = "last_3d"
+	}
+	if r.Conversion == "" {
+		r.Conversion = DefaultConversion
+	}
+	r.Action = strings.ToLower(r.Action)
+	switch r.Action {
+	case ActionPause, ActionUnpause, ActionNotify:
+	default:
+		return fmt.Errorf("invalid action %q — use pause, unpause, or notify", r.Action)
+	}
+	if len(r.When) == 0 {
+		return errors.New("at least one when condition is required")
+	}
+	for _, w := range r.When {
+		c, err := ParseCondition(w)
+		if err != nil {
+			return err
+		}
+		if !IsMetric(c.Metric) {
+			return fmt.Errorf("unknown metric %q — use %s", c.Metric, strings.Join(Metrics, ", "))
+		}
+		r.conditions = append(r.conditions, c)
+	}
+	r.cooldown = 24 * time.Hour
+	if r.Cooldown != "" {
+		d, err := time.ParseDuration(r.Cooldown)
+		if err != nil {
+			return fmt.Errorf("invalid cooldown %q: %w", r.Cooldown, err)
+		}
+		r.cooldown = d
+	}
+	return nil
+}
+
+// Conditions returns the parsed when conditions.
+func (r *Rule) Conditions() []Condition {
+	return r.conditions
+}
+
+// CooldownDuration returns the parsed cooldown (24h by default).
+func (r *Rule) CooldownDuration() time.Duration {
+	return r.cooldown
+}
+
+// Evaluate reports whether every condition matches metrics. Conditions on a
+// metric that can't be computed (e.g. cpa with no conversions) don't match.
+func (r *Rule) Evaluate(metrics map[string]float64) bool {
+	for _, c := range r.conditions {
+		v, ok := metrics[c.Metric]
+		if !ok || !c.Match(v) {
+			return false
+		}
+	}
+	return true
+}
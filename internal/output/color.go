@@ -0,0 +1,76 @@
+package output
+
+import (
+	"os"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mattn/go-isatty"
+)
+
+const (
+	ansiReset  = "\033[0m"
+	ansiRed    = "\033[31m"
+	ansiGreen  = "\033[32m"
+	ansiYellow = "\033[33m"
+)
+
+// noColor is set by --no-color.
+var noColor bool
+
+// SetNoColor disables colored output regardless of the terminal.
+func SetNoColor(v bool) {
+	noColor = v
+}
+
+// colorEnabled returns true when escape codes may be written to f:
+// f is a terminal, output is not markdown, and neither --no-color nor
+// NO_COLOR (https://no-color.org) is set.
+func colorEnabled(f *os.File) bool {
+	if noColor || tableFormat == FormatMarkdown || os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	return isatty.IsTerminal(f.Fd()) || isatty.IsCygwinTerminal(f.Fd())
+}
+
+func paint(f *os.File, code, s string) string {
+	if s == "" || !colorEnabled(f) {
+		return s
+	}
+	return code + s + ansiReset
+}
+
+// Green, Yellow and Red color s for stdout when color is enabled.
+func Green(s string) string  { return paint(os.Stdout, ansiGreen, s) }
+func Yellow(s string) string { return paint(os.Stdout, ansiYellow, s) }
+func Red(s string) string    { return paint(os.Stdout, ansiRed, s) }
+
+// Warn formats a warning for stderr, in red when stderr is a terminal.
+func Warn(s string) string { return paint(os.Stderr, ansiRed, s) }
+
+// Status colors a Meta status value: green for running objects, yellow for
+// paused or pending ones, red for rejected or broken ones.
+func Status(s string) string {
+	switch strings.ToUpper(s) {
+	case "ACTIVE", "ENABLED", "APPROVED":
+		return Green(s)
+	case "PAUSED", "CAMPAIGN_PAUSED", "ADSET_PAUSED", "PENDING_REVIEW", "IN_PROCESS", "PREAPPROVED", "PENDING_BILLING_INFO",
+		"PENDING_RISK_REVIEW", "PENDING_SETTLEMENT", "IN_GRACE_PERIOD", "PENDING_CLOSURE":
+		return Yellow(s)
+	case "DISAPPROVED", "WITH_ISSUES", "DISABLED", "ERROR", "UNSETTLED", "CLOSED":
+		return Red(s)
+	}
+	return s
+}
+
+var ansiEscape = regexp.MustCompile("\033\\[[0-9;]*m")
+
+// visibleWidth returns the number of characters s occupies on screen,
+// ignoring color escape codes.
+func visibleWidth(s string) int {
+	if strings.IndexByte(s, '\033') >= 0 {
+		s = ansiEscape.ReplaceAllString(s, "")
+	}
+	return utf8.RuneCountInString(s)
+}
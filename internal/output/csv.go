@@ -0,0 +1,178 @@
+package output
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+)
+
+// PrintCSV writes v as CSV to stdout, one row per item.
+//
+// For structs the header row is the JSON field names of the struct (embedded
+// structs are flattened, as in the JSON output); for raw JSON objects it is the
+// union of their keys in first-seen order. Nested objects and arrays are
+// written as compact JSON.
+func PrintCSV(v any) error {
+	items, err := toObjects(v)
+	if err != nil {
+		return err
+	}
+
+	headers := structHeaders(v)
+	seen := map[string]bool{}
+	for _, h := range headers {
+		seen[h] = true
+	}
+	for _, item := range items {
+		for _, k := range item.keys {
+			if !seen[k] {
+				seen[k] = true
+				headers = append(headers, k)
+			}
+		}
+	}
+
+	w := csv.NewWriter(os.Stdout)
+	if err := w.Write(headers); err != nil {
+		return err
+	}
+	for _, item := range items {
+		row := make([]string, len(headers))
+		for i, h := range headers {
+			row[i] = cellValue(item.values[h])
+		}
+		if err := w.Write(row); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// object is a decoded JSON object that remembers its key order.
+type object struct {
+	keys   []string
+	values map[string]json.RawMessage
+}
+
+// toObjects converts v to a list of JSON objects via its JSON encoding.
+// A single object yields one item; a JSON array yields one item per element.
+func toObjects(v any) ([]object, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	data = bytes.TrimSpace(data)
+
+	var elems []json.RawMessage
+	if len(data) > 0 && data[0] == '[' {
+		if err := json.Unmarshal(data, &elems); err != nil {
+			return nil, err
+		}
+	} else {
+		elems = []json.RawMessage{data}
+	}
+
+	items := make([]object, 0, len(elems))
+	for _, e := range elems {
+		obj, err := decodeObject(e)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, obj)
+	}
+	return items, nil
+}
+
+// decodeObject decodes a JSON object, preserving key order. Non-object values
+// become a single "value" column.
+func decodeObject(raw json.RawMessage) (object, error) {
+	obj := object{values: map[string]json.RawMessage{}}
+	dec := json.NewDecoder(bytes.NewReader(raw))
+	tok, err := dec.Token()
+	if err != nil {
+		return obj, err
+	}
+	if d, ok := tok.(json.Delim); !ok || d != '{' {
+		obj.keys = []string{"value"}
+		obj.values["value"] = raw
+		return obj, nil
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return obj, err
+		}
+		key, _ := tok.(string)
+		var val json.RawMessage
+		if err := dec.Decode(&val); err != nil {
+			return obj, err
+		}
+		obj.keys = append(obj.keys, key)
+		obj.values[key] = val
+	}
+	return obj, nil
+}
+
+// structHeaders returns the JSON field names of the element type of v when it
+// is a struct (or slice of structs), so columns of empty omitempty fields are kept.
+func structHeaders(v any) []string {
+	t := reflect.TypeOf(v)
+	for t != nil && (t.Kind() == reflect.Pointer || t.Kind() == reflect.Slice || t.Kind() == reflect.Array) {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+	return jsonFieldNames(t)
+}
+
+func jsonFieldNames(t reflect.Type) []string {
+	var names []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				names = append(names, jsonFieldNames(ft)...)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// cellValue renders a JSON value for a CSV cell.
+func cellValue(raw json.RawMessage) string {
+	if len(raw) == 0 || string(raw) == "null" {
+		return ""
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		return s
+	}
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		return fmt.Sprint(string(raw))
+	}
+	return buf.String()
+}
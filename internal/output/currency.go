@@ -0,0 +1,74 @@
+package output
+
+import (
+	"cmp"
+	"fmt"
+	"strings"
+)
+
+// zeroDecimalCurrencies are the currencies Meta reports without minor units
+// (offset 1): a budget of "5000" in JPY is ¥5000, not ¥50.00.
+var zeroDecimalCurrencies = map[string]bool{
+	"CLP": true, "COP": true, "CRC": true, "HUF": true, "ISK": true, "IDR": true,
+	"JPY": true, "KRW": true, "PYG": true, "TWD": true, "VND": true,
+}
+
+// currency is the account currency used by FormatBudget, and currencyLabel
+// the code it shows; set by SetCurrency.
+var currency, currencyLabel string
+
+// SetCurrency sets the ISO 4217 code FormatBudget formats amounts in,
+// usually the currency of the ad account being listed, and the label shown
+// after them (code when label is empty). The label changes nothing else:
+// minor units are always those of code.
+func SetCurrency(code, label string) {
+	currency = strings.ToUpper(code)
+	currencyLabel = strings.ToUpper(cmp.Or(label, code))
+}
+
+// CurrencyOffset returns how many minor units make one unit of code
+// (100 for most currencies, 1 for zero-decimal ones like JPY).
+func CurrencyOffset(code string) int64 {
+	if zeroDecimalCurrencies[strings.ToUpper(code)] {
+		return 1
+	}
+	return 100
+}
+
+// FormatMoney converts a Meta amount in minor units to a human-readable string
+// in the given currency. E.g. ("5000", "USD") → "50.00 USD", ("500000", "JPY") → "500,000 JPY",
+// digits grouped as set with SetNumberFormat.
+// The currency code is omitted when code is empty.
+func FormatMoney(minor, code string) string {
+	return FormatMoneyLabel(minor, code, code)
+}
+
+// FormatMoneyLabel is FormatMoney with label shown instead of code, e.g. a
+// currency override of the account; the minor units are still those of code.
+func FormatMoneyLabel(minor, code, label string) string {
+	if minor == "" || minor == "0" {
+		return "-"
+	}
+	neg := strings.HasPrefix(minor, "-")
+	// Parse as integer minor units
+	var n int64
+	for _, c := range minor {
+		if c >= '0' && c <= '9' {
+			n = n*10 + int64(c-'0')
+		}
+	}
+
+	var s string
+	if CurrencyOffset(code) == 1 {
+		s = FormatNumber(fmt.Sprintf("%d", n))
+	} else {
+		s = FormatNumber(fmt.Sprintf("%d.%02d", n/100, n%100))
+	}
+	if neg {
+		s = "-" + s
+	}
+	if label != "" {
+		s += " " + strings.ToUpper(label)
+	}
+	return s
+}
@@ -0,0 +1,147 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/cobra"
+)
+
+// Format is an output format selectable with --output.
+type Format string
+
+const (
+	FormatTable Format = "table"
+	FormatJSON  Format = "json"
+	FormatCSV   Format = "csv"
+	FormatYAML  Format = "yaml"
+	// FormatNDJSON writes one compact JSON document per line. List commands
+	// stream items as their page arrives (see IsStream).
+	FormatNDJSON Format = "ndjson"
+	// FormatMarkdown renders the same tables as FormatTable, as GitHub-flavored markdown.
+	FormatMarkdown Format = "markdown"
+	// FormatQuiet prints only IDs, one per line. It is selected by -q/--quiet.
+	FormatQuiet Format = "quiet"
+	// FormatTemplate applies the Go template given with --format to each item.
+	// It is selected by --format rather than --output.
+	FormatTemplate Format = "template"
+)
+
+// Formats lists every value accepted by --output.
+var Formats = []Format{FormatTable, FormatJSON, FormatCSV, FormatYAML, FormatNDJSON, FormatMarkdown}
+
+// ParseFormat validates an --output value.
+func ParseFormat(s string) (Format, error) {
+	f := Format(strings.ToLower(strings.TrimSpace(s)))
+	switch f {
+	case "yml":
+		f = FormatYAML
+	case "md":
+		f = FormatMarkdown
+	}
+	for _, known := range Formats {
+		if f == known {
+			return f, nil
+		}
+	}
+	names := make([]string, len(Formats))
+	for i, known := range Formats {
+		names[i] = string(known)
+	}
+	return "", fmt.Errorf("invalid --output %q — use %s", s, strings.Join(names, ", "))
+}
+
+// FormatOf returns the output format selected for cmd:
+//   - quiet when -q/--quiet is set on the root command
+//   - template when --format is set on the root command
+//   - --output on the root command, when set
+//   - json when --json or --pretty is set
+//   - json when stdout is not a TTY (piped to another command / agent)
+//   - table otherwise
+func FormatOf(cmd *cobra.Command) Format {
+	if f := cmd.Root().PersistentFlags().Lookup("quiet"); f != nil && f.Changed && f.Value.String() == "true" {
+		return FormatQuiet
+	}
+	if f := cmd.Root().PersistentFlags().Lookup("format"); f != nil && f.Changed {
+		return FormatTemplate
+	}
+	if f := cmd.Root().PersistentFlags().Lookup("output"); f != nil && f.Changed {
+		if format, err := ParseFormat(f.Value.String()); err == nil {
+			return format
+		}
+	}
+	json, _ := cmd.Flags().GetBool("json")
+	pretty, _ := cmd.Flags().GetBool("pretty")
+	if json || pretty {
+		return FormatJSON
+	}
+	if !isatty.IsTerminal(os.Stdout.Fd()) && !isatty.IsCygwinTerminal(os.Stdout.Fd()) {
+		return FormatJSON
+	}
+	return FormatTable
+}
+
+// tableFormat is how PrintTable and PrintKeyValue render; set by Configure.
+var tableFormat = FormatTable
+
+// Configure records the output format selected for the running command so
+// table helpers can honour it. Call it once before the command runs.
+func Configure(cmd *cobra.Command) {
+	tableFormat = FormatTable
+	if FormatOf(cmd) == FormatMarkdown {
+		tableFormat = FormatMarkdown
+	}
+}
+
+// IsTable returns true when the command should render its human-readable table
+// (as text or markdown). Every other format is rendered from the underlying
+// data with Print.
+func IsTable(cmd *cobra.Command) bool {
+	f := FormatOf(cmd)
+	return f == FormatTable || f == FormatMarkdown
+}
+
+// IsStream returns true when list items should be printed one by one as they
+// are fetched rather than collected first.
+func IsStream(cmd *cobra.Command) bool {
+	f := FormatOf(cmd)
+	return f == FormatNDJSON || f == FormatQuiet
+}
+
+// PrintItem prints a single streamed list item (see IsStream).
+func PrintItem(cmd *cobra.Command, v any) error {
+	if FormatOf(cmd) == FormatQuiet {
+		return PrintIDs(v)
+	}
+	return PrintLine(v)
+}
+
+// prettyDefault indents JSON output even without --pretty; set by SetPrettyDefault.
+var prettyDefault bool
+
+// SetPrettyDefault makes JSON output indented by default (the "pretty" preference).
+func SetPrettyDefault(v bool) {
+	prettyDefault = v
+}
+
+// Print renders v (a struct, a slice of structs, or raw JSON) in the
+// machine-readable format selected for cmd.
+func Print(cmd *cobra.Command, v any) error {
+	switch FormatOf(cmd) {
+	case FormatCSV:
+		return PrintCSV(v)
+	case FormatYAML:
+		return PrintYAML(v)
+	case FormatNDJSON:
+		return PrintNDJSON(v)
+	case FormatQuiet:
+		return PrintIDs(v)
+	case FormatTemplate:
+		return PrintTemplate(v, cmd.Root().PersistentFlags().Lookup("format").Value.String())
+	default:
+		pretty, _ := cmd.Flags().GetBool("pretty")
+		return PrintJSON(v, pretty || prettyDefault)
+	}
+}
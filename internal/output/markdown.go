@@ -0,0 +1,65 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// printMarkdown writes a GitHub-flavored markdown table to stdout.
+func printMarkdown(headers []string, rows [][]string) {
+	cells := make([][]string, 0, len(rows)+1)
+	cells = append(cells, escapeMarkdownRow(headers))
+	for _, row := range rows {
+		cells = append(cells, escapeMarkdownRow(row))
+	}
+
+	widths := make([]int, len(headers))
+	for _, row := range cells {
+		for i, cell := range row {
+			if i < len(widths) && visibleWidth(cell) > widths[i] {
+				widths[i] = visibleWidth(cell)
+			}
+		}
+	}
+	for i := range widths {
+		if widths[i] < 3 {
+			widths[i] = 3 // room for the "---" separator
+		}
+	}
+
+	var b strings.Builder
+	writeRow := func(row []string) {
+		b.WriteString("|")
+		for i, w := range widths {
+			cell := ""
+			if i < len(row) {
+				cell = row[i]
+			}
+			b.WriteString(" " + cell + strings.Repeat(" ", w-visibleWidth(cell)) + " |")
+		}
+		b.WriteByte('\n')
+	}
+	writeRow(cells[0])
+	b.WriteString("|")
+	for _, w := range widths {
+		b.WriteString(" " + strings.Repeat("-", w) + " |")
+	}
+	b.WriteByte('\n')
+	for _, row := range cells[1:] {
+		writeRow(row)
+	}
+	fmt.Fprint(os.Stdout, b.String())
+}
+
+// escapeMarkdownRow makes cells safe inside a markdown table row.
+func escapeMarkdownRow(row []string) []string {
+	out := make([]string, len(row))
+	for i, cell := range row {
+		cell = strings.ReplaceAll(cell, "|", `\|`)
+		cell = strings.ReplaceAll(cell, "\r\n", " ")
+		cell = strings.ReplaceAll(cell, "\n", " ")
+		out[i] = cell
+	}
+	return out
+}
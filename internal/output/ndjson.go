@@ -0,0 +1,35 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+)
+
+// PrintNDJSON writes v as newline-delimited JSON to stdout: one line per
+// element when v is a list, a single line otherwise.
+func PrintNDJSON(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '[' {
+		return PrintLine(json.RawMessage(data))
+	}
+	var elems []json.RawMessage
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return err
+	}
+	for _, e := range elems {
+		if err := PrintLine(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// PrintLine writes v as a single line of compact JSON to stdout.
+func PrintLine(v any) error {
+	return json.NewEncoder(os.Stdout).Encode(v)
+}
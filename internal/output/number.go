@@ -0,0 +1,154 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// NumberStyle is how table output writes numbers: the separator between
+// groups of three digits and the decimal mark.
+type NumberStyle struct {
+	Group   string
+	Decimal string
+}
+
+var (
+	styleComma      = NumberStyle{Group: ",", Decimal: "."}
+	styleDot        = NumberStyle{Group: ".", Decimal: ","}
+	styleSpace      = NumberStyle{Group: " ", Decimal: ","}
+	styleApostrophe = NumberStyle{Group: "'", Decimal: "."}
+)
+
+// languageStyles maps a locale language to its number style; languages not
+// listed use styleComma, as English does.
+var languageStyles = map[string]NumberStyle{
+	"da": styleDot, "de": styleDot, "el": styleDot, "es": styleDot, "hr": styleDot,
+	"id": styleDot, "it": styleDot, "nl": styleDot, "pt": styleDot, "ro": styleDot,
+	"sl": styleDot, "sr": styleDot, "tr": styleDot, "vi": styleDot,
+	"bg": styleSpace, "cs": styleSpace, "et": styleSpace, "fi": styleSpace, "fr": styleSpace,
+	"hu": styleSpace, "lt": styleSpace, "lv": styleSpace, "nb": styleSpace, "nn": styleSpace,
+	"no": styleSpace, "pl": styleSpace, "ru": styleSpace, "sk": styleSpace, "sv": styleSpace,
+	"uk": styleSpace,
+}
+
+// regionStyles overrides languageStyles for a language_REGION locale.
+var regionStyles = map[string]NumberStyle{
+	"de_CH": styleApostrophe, "it_CH": styleApostrophe,
+	"es_MX": styleComma, "es_US": styleComma,
+}
+
+var localePattern = regexp.MustCompile(`^([a-zA-Z]{2,3})(?:[_-]([a-zA-Z0-9]+))?(?:\.[^@]*)?(?:@.*)?$`)
+
+// ParseNumberFormat returns the number style of a locale such as "de",
+// "fr_FR.UTF-8" or "en-US".
+func ParseNumberFormat(locale string) (NumberStyle, error) {
+	m := localePattern.FindStringSubmatch(locale)
+	if m == nil {
+		return NumberStyle{}, fmt.Errorf("invalid number format %q — use a locale such as en, de, fr or de_CH", locale)
+	}
+	lang, region := strings.ToLower(m[1]), strings.ToUpper(m[2])
+	if s, ok := regionStyles[lang+"_"+region]; ok {
+		return s, nil
+	}
+	if s, ok := languageStyles[lang]; ok {
+		return s, nil
+	}
+	return styleComma, nil
+}
+
+// LocaleNumberFormat returns the number style of the user's locale, from
+// LC_ALL, LC_NUMERIC or LANG, or the English one when none is set ("C",
+// "POSIX" and the like count as unset).
+func LocaleNumberFormat() NumberStyle {
+	for _, env := range []string{"LC_ALL", "LC_NUMERIC", "LANG"} {
+		if v := os.Getenv(env); v != "" {
+			if s, err := ParseNumberFormat(v); err == nil {
+				return s
+			}
+			return styleComma
+		}
+	}
+	return styleComma
+}
+
+// numberStyle is the style of table numbers; set by SetNumberFormat.
+var numberStyle = styleComma
+
+// rawNumbers leaves numbers as Meta returns them; set by SetRawNumbers.
+var rawNumbers bool
+
+// SetNumberFormat sets how tables and amounts group digits and mark decimals.
+func SetNumberFormat(s NumberStyle) {
+	numberStyle = s
+}
+
+// SetRawNumbers turns number formatting off: tables and amounts show numbers
+// as Meta returns them, for scripts reading table output.
+func SetRawNumbers(v bool) {
+	rawNumbers = v
+}
+
+var plainNumber = regexp.MustCompile(`^(-?)(\d+)(?:\.(\d+))?$`)
+
+// idDigits is the length from which an integer is taken for an object ID
+// (Meta IDs have 15 digits or more) and left as it is.
+const idDigits = 15
+
+// FormatNumber writes a plain number such as "1234567.5" in the number
+// style, e.g. "1,234,567.5". Anything else, IDs, and numbers with leading
+// zeros are returned unchanged.
+func FormatNumber(s string) string {
+	if rawNumbers {
+		return s
+	}
+	m := plainNumber.FindStringSubmatch(s)
+	if m == nil {
+		return s
+	}
+	sign, whole, frac := m[1], m[2], m[3]
+	if (len(whole) > 1 && whole[0] == '0') || (frac == "" && len(whole) >= idDigits) {
+		return s
+	}
+	var b strings.Builder
+	b.WriteString(sign)
+	for i, d := range whole {
+		if i > 0 && (len(whole)-i)%3 == 0 {
+			b.WriteString(numberStyle.Group)
+		}
+		b.WriteRune(d)
+	}
+	if frac != "" {
+		b.WriteString(numberStyle.Decimal)
+		b.WriteString(frac)
+	}
+	return b.String()
+}
+
+// isIDColumn reports whether a table header or key names an ID column,
+// whose numbers are never grouped: "ID", "ACCOUNT ID", "Pixel ID".
+func isIDColumn(header string) bool {
+	h := strings.ToUpper(header)
+	return h == "ID" || strings.HasSuffix(h, " ID") || strings.HasSuffix(h, "_ID")
+}
+
+// formatNumberCells returns rows with the numbers of every column but the ID
+// ones formatted with FormatNumber.
+func formatNumberCells(headers []string, rows [][]string) [][]string {
+	if rawNumbers {
+		return rows
+	}
+	formatted := make([][]string, len(rows))
+	for i, row := range rows {
+		formatted[i] = make([]string, len(row))
+		for j, cell := range row {
+			if j < len(headers) && isIDColumn(headers[j]) {
+				formatted[i][j] = cell
+			} else {
+				formatted[i][j] = FormatNumber(cell)
+			}
+		}
+	}
+	return formatted
+}
@@ -4,22 +4,15 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"text/tabwriter"
+	"strings"
 
 	"github.com/mattn/go-isatty"
 	"github.com/spf13/cobra"
 )
 
-// IsJSON returns true when output should be JSON:
-//   - stdout is not a TTY (piped to another command / agent)
-//   - OR --json or --pretty flag is set on the command
+// IsJSON returns true when output should be JSON (see FormatOf).
 func IsJSON(cmd *cobra.Command) bool {
-	if !isatty.IsTerminal(os.Stdout.Fd()) && !isatty.IsCygwinTerminal(os.Stdout.Fd()) {
-		return true
-	}
-	json, _ := cmd.Flags().GetBool("json")
-	pretty, _ := cmd.Flags().GetBool("pretty")
-	return json || pretty
+	return FormatOf(cmd) == FormatJSON
 }
 
 // IsPretty returns true when JSON should be indented.
@@ -45,32 +38,18 @@ func PrintJSON(v any, pretty bool) error {
 	return enc.Encode(v)
 }
 
-// PrintTable writes a tab-aligned table to stdout.
+// PrintTable writes a column-aligned table to stdout.
 // headers are printed as an uppercase header row.
 // rows is a slice of string slices, one per data row.
+// Cells may contain color codes (see Status); they don't count towards column width.
+// Numbers are formatted with FormatNumber, except in ID columns.
 func PrintTable(headers []string, rows [][]string) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
-	defer w.Flush()
-
-	// Header row
-	for i, h := range headers {
-		if i > 0 {
-			fmt.Fprint(w, "\t")
-		}
-		fmt.Fprint(w, h)
-	}
-	fmt.Fprintln(w)
-
-	// Data rows
-	for _, row := range rows {
-		for i, cell := range row {
-			if i > 0 {
-				fmt.Fprint(w, "\t")
-			}
-			fmt.Fprint(w, cell)
-		}
-		fmt.Fprintln(w)
+	rows = formatNumberCells(headers, rows)
+	if tableFormat == FormatMarkdown {
+		printMarkdown(headers, rows)
+		return
 	}
+	printAligned(append([][]string{headers}, rows...))
 }
 
 // Truncate shortens a string to maxLen characters, adding "…" if truncated.
@@ -83,41 +62,70 @@ func Truncate(s string, maxLen int) string {
 	return string(runes[:maxLen-1]) + "…"
 }
 
-// FormatBudget converts a Meta budget string (in account currency cents) to a
-// human-readable decimal string. E.g. "5000" → "50.00".
-// Meta returns budgets as minor units (cents).
+// FormatBudget converts a Meta budget string (in account currency minor units)
+// to a human-readable amount in the currency set with SetCurrency.
+// E.g. "5000" → "50.00 USD", or "50.00" when the currency is unknown.
 func FormatBudget(cents string) string {
-	if cents == "" || cents == "0" {
-		return "-"
-	}
-	// Parse as integer cents
-	var n int64
-	for _, c := range cents {
-		if c >= '0' && c <= '9' {
-			n = n*10 + int64(c-'0')
-		}
-	}
-	return fmt.Sprintf("%d.%02d", n/100, n%100)
+	return FormatMoneyLabel(cents, currency, currencyLabel)
 }
 
 // PrintKeyValue prints a two-column key-value table (e.g. for "get" detail views).
-// rows is a slice of [key, value] pairs.
+// rows is a slice of [key, value] pairs. Numbers are formatted as in PrintTable.
 func PrintKeyValue(rows [][]string) {
-	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', 0)
-	defer w.Flush()
+	var visible [][]string
 	for _, row := range rows {
 		if len(row) == 2 && row[1] != "" && row[1] != "-" {
-			fmt.Fprintf(w, "%s\t%s\n", row[0], row[1])
+			if !isIDColumn(row[0]) {
+				row = []string{row[0], FormatNumber(row[1])}
+			}
+			visible = append(visible, row)
 		}
 	}
+	if tableFormat == FormatMarkdown {
+		printMarkdown([]string{"Field", "Value"}, visible)
+		return
+	}
+	printAligned(visible)
+}
+
+// printAligned writes rows to stdout with each column padded to its widest
+// cell plus two spaces. The last cell of a row is never padded.
+func printAligned(rows [][]string) {
+	var widths []int
+	for _, row := range rows {
+		for i, cell := range row {
+			if i >= len(widths) {
+				widths = append(widths, 0)
+			}
+			if w := visibleWidth(cell); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	var b strings.Builder
+	for _, row := range rows {
+		for i, cell := range row {
+			b.WriteString(cell)
+			if i < len(row)-1 {
+				b.WriteString(strings.Repeat(" ", widths[i]-visibleWidth(cell)+2))
+			}
+		}
+		b.WriteByte('\n')
+	}
+	fmt.Fprint(os.Stdout, b.String())
 }
 
 // FormatTime trims Meta's ISO-8601 timestamps to a shorter form.
 // "2026-01-15T10:30:00+0000" → "2026-01-15 10:30"
+// When a zone is set with SetTimezone the timestamp is converted to it first.
 func FormatTime(t string) string {
 	if t == "" {
 		return "-"
 	}
+	if converted, ok := convertTime(t); ok {
+		return converted.Format("2006-01-02 15:04")
+	}
 	// Keep only the date+hour:minute part
 	if len(t) >= 16 {
 		return t[:10] + " " + t[11:16]
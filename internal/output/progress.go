@@ -0,0 +1,80 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mattn/go-isatty"
+)
+
+// spinnerFrames are drawn in turn at the start of a Progress line.
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// progressInterval is the least time between two redraws of a Progress line.
+const progressInterval = 100 * time.Millisecond
+
+// Progress is a status line on stderr, redrawn in place behind a spinner, so
+// long operations don't look frozen. It draws nothing when stderr isn't a
+// terminal, so logs and pipes only get the command's own output. It is safe
+// for concurrent use.
+type Progress struct {
+	enabled bool
+
+	mu    sync.Mutex
+	frame int
+	last  time.Time
+	shown bool
+}
+
+// NewProgress returns a Progress line, enabled when stderr is a terminal.
+func NewProgress() *Progress {
+	tty := isatty.IsTerminal(os.Stderr.Fd()) || isatty.IsCygwinTerminal(os.Stderr.Fd())
+	return &Progress{enabled: tty && os.Getenv("TERM") != "dumb"}
+}
+
+// Update redraws the line with the formatted text, at most every 100ms. The
+// cursor is left at the start of the line, so a message printed meanwhile
+// overwrites it rather than following it.
+func (p *Progress) Update(format string, args ...any) {
+	if !p.enabled {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if time.Since(p.last) < progressInterval {
+		return
+	}
+	p.last = time.Now()
+	p.frame = (p.frame + 1) % len(spinnerFrames)
+	fmt.Fprintf(os.Stderr, "\r\033[K%s %s\r", spinnerFrames[p.frame], fmt.Sprintf(format, args...))
+	p.shown = true
+}
+
+// Clear erases the line, before the command prints its results. The next
+// Update draws it again.
+func (p *Progress) Clear() {
+	if !p.enabled {
+		return
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.shown {
+		fmt.Fprint(os.Stderr, "\r\033[K")
+	}
+	p.shown = false
+	p.last = time.Time{}
+}
+
+// ProgressBar returns a bar of width cells filled in proportion to done of
+// total, followed by the percentage: "[██████░░░░] 60%".
+func ProgressBar(done, total int64, width int) string {
+	if total <= 0 {
+		return ""
+	}
+	done = min(max(done, 0), total)
+	filled := int(done * int64(width) / total)
+	return fmt.Sprintf("[%s%s] %d%%", strings.Repeat("█", filled), strings.Repeat("░", width-filled), done*100/total)
+}
@@ -0,0 +1,37 @@
+package output
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// PrintIDs writes the ID of v — or of each item when v is a list — to stdout,
+// one per line. The ID is the "id" field, or else the first "*_id" field
+// (e.g. campaign_id on insights rows). Items without one are skipped.
+func PrintIDs(v any) error {
+	items, err := toObjects(v)
+	if err != nil {
+		return err
+	}
+	for _, item := range items {
+		if id := objectID(item); id != "" {
+			fmt.Fprintln(os.Stdout, id)
+		}
+	}
+	return nil
+}
+
+func objectID(obj object) string {
+	if id := cellValue(obj.values["id"]); id != "" {
+		return id
+	}
+	for _, k := range obj.keys {
+		if strings.HasSuffix(k, "_id") {
+			if id := cellValue(obj.values[k]); id != "" {
+				return id
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,84 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"text/template"
+)
+
+// templateFuncs are available inside --format templates.
+var templateFuncs = template.FuncMap{
+	"json": func(v any) (string, error) {
+		data, err := json.Marshal(v)
+		return string(data), err
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join":  strings.Join,
+}
+
+// ParseTemplate parses a --format Go template.
+func ParseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("format").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid --format template: %w", err)
+	}
+	return tmpl, nil
+}
+
+// PrintTemplate executes tmpl once per item of v (or once when v is not a
+// list), writing a newline after each item unless the template ends with one.
+//
+// Structs expose their Go field names ({{.ID}}, {{.EffectiveStatus}}); raw API
+// responses expose their JSON keys ({{.campaign_name}}, {{.spend}}).
+func PrintTemplate(v any, text string) error {
+	tmpl, err := ParseTemplate(text)
+	if err != nil {
+		return err
+	}
+	newline := !strings.HasSuffix(text, "\n")
+
+	for _, item := range templateItems(v) {
+		if err := tmpl.Execute(os.Stdout, item); err != nil {
+			return err
+		}
+		if newline {
+			fmt.Fprintln(os.Stdout)
+		}
+	}
+	return nil
+}
+
+// templateItems splits v into the values each template run receives.
+func templateItems(v any) []any {
+	if raw, ok := v.(json.RawMessage); ok {
+		var decoded any
+		if err := json.Unmarshal(raw, &decoded); err != nil {
+			return []any{string(raw)}
+		}
+		if list, ok := decoded.([]any); ok {
+			return list
+		}
+		return []any{decoded}
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return []any{v}
+	}
+	items := make([]any, rv.Len())
+	for i := range items {
+		item := rv.Index(i).Interface()
+		if raw, ok := item.(json.RawMessage); ok {
+			var decoded any
+			if err := json.Unmarshal(raw, &decoded); err == nil {
+				item = decoded
+			}
+		}
+		items[i] = item
+	}
+	return items
+}
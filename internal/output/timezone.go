@@ -0,0 +1,69 @@
+package output
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	_ "time/tzdata" // IANA zones for --tz account on systems without a zoneinfo database
+)
+
+// timezone is the zone FormatTime converts to; nil keeps Meta's own offsets.
+var timezone *time.Location
+
+// SetTimezone makes FormatTime convert timestamps to loc.
+func SetTimezone(loc *time.Location) {
+	timezone = loc
+}
+
+// ParseTimezone resolves an IANA zone name ("Europe/Paris"), "local" or "utc".
+func ParseTimezone(name string) (*time.Location, error) {
+	switch strings.ToLower(name) {
+	case "local":
+		return time.Local, nil
+	case "utc":
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q: %w", name, err)
+	}
+	return loc, nil
+}
+
+// metaTimeLayouts are the timestamp layouts returned by the Graph API.
+var metaTimeLayouts = []string{
+	"2006-01-02T15:04:05-0700",
+	time.RFC3339,
+}
+
+// convertTime parses a Meta timestamp (ISO-8601 or unix seconds) and converts
+// it to the zone set with SetTimezone.
+func convertTime(t string) (time.Time, bool) {
+	if timezone == nil {
+		return time.Time{}, false
+	}
+	for _, layout := range metaTimeLayouts {
+		if parsed, err := time.Parse(layout, t); err == nil {
+			return parsed.In(timezone), true
+		}
+	}
+	if n, err := strconv.ParseInt(t, 10, 64); err == nil && n > 0 {
+		return time.Unix(n, 0).In(timezone), true
+	}
+	return time.Time{}, false
+}
+
+// FormatUnixTime formats a unix timestamp in the zone set with SetTimezone,
+// or in UTC when none is set.
+func FormatUnixTime(sec int64) string {
+	if sec == 0 {
+		return "-"
+	}
+	loc := timezone
+	if loc == nil {
+		loc = time.UTC
+	}
+	return time.Unix(sec, 0).In(loc).Format("2006-01-02 15:04")
+}
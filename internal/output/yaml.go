@@ -0,0 +1,40 @@
+package output
+
+import (
+	"encoding/json"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// PrintYAML writes v as YAML to stdout.
+//
+// v goes through its JSON encoding first so field names, omitempty handling and
+// raw API responses match the JSON output exactly; key order is preserved.
+func PrintYAML(v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	// JSON is valid YAML: decode into a node tree to keep key order,
+	// then drop the flow/quoted styles inherited from the JSON syntax.
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return err
+	}
+	blockStyle(&doc)
+
+	enc := yaml.NewEncoder(os.Stdout)
+	enc.SetIndent(2)
+	if err := enc.Encode(&doc); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
@@ -0,0 +1,101 @@
+// Package picker lets a user choose one item from a list in the terminal,
+// narrowing it down with fuzzy search.
+package picker
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// Item is a choice offered by Pick.
+type Item struct {
+	ID    string
+	Label string
+}
+
+// maxShown is the number of matches listed at once.
+const maxShown = 20
+
+// ErrCanceled is returned when the user quits the picker.
+var ErrCanceled = errors.New("selection canceled")
+
+// Pick lists items on out and reads from in until the user picks one: a
+// number selects from the list shown, any other text filters the list with
+// a fuzzy match on label and ID, and an empty line or "q" cancels.
+func Pick(title string, items []Item, in io.Reader, out io.Writer) (Item, error) {
+	if len(items) == 0 {
+		return Item{}, errors.New("nothing to choose from")
+	}
+	scanner := bufio.NewScanner(in)
+	matches := items
+	query := ""
+	for {
+		fmt.Fprintf(out, "\n%s", title)
+		if query != "" {
+			fmt.Fprintf(out, " matching %q", query)
+		}
+		fmt.Fprintf(out, " (%d):\n", len(matches))
+		for i, it := range matches {
+			if i == maxShown {
+				fmt.Fprintf(out, "  … %d more, type to narrow down\n", len(matches)-maxShown)
+				break
+			}
+			fmt.Fprintf(out, "  %2d) %s\n", i+1, it.Label)
+		}
+		fmt.Fprint(out, "Number, search text, or empty to cancel: ")
+
+		if !scanner.Scan() {
+			return Item{}, ErrCanceled
+		}
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line == "q" {
+			return Item{}, ErrCanceled
+		}
+		if n, err := strconv.Atoi(line); err == nil && n >= 1 && n <= len(matches) && n <= maxShown {
+			return matches[n-1], nil
+		}
+
+		query = line
+		matches = Filter(items, query)
+		if len(matches) == 1 {
+			fmt.Fprintf(out, "→ %s\n", matches[0].Label)
+			return matches[0], nil
+		}
+		if len(matches) == 0 {
+			fmt.Fprintf(out, "No match for %q.\n", query)
+			matches, query = items, ""
+		}
+	}
+}
+
+// Filter returns the items whose label or ID fuzzily matches query.
+func Filter(items []Item, query string) []Item {
+	var matches []Item
+	for _, it := range items {
+		if Match(it.Label+" "+it.ID, query) {
+			matches = append(matches, it)
+		}
+	}
+	return matches
+}
+
+// Match reports whether the characters of query appear in s in order,
+// ignoring case and spaces in query: "sumsal" matches "Summer Sale".
+func Match(s, query string) bool {
+	s = strings.ToLower(s)
+	for _, r := range strings.ToLower(query) {
+		if r == ' ' {
+			continue
+		}
+		i := strings.IndexRune(s, r)
+		if i < 0 {
+			return false
+		}
+		s = s[i+len(string(r)):]
+	}
+	return true
+}
@@ -0,0 +1,114 @@
+package spec
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// Change is a field whose remote value differs from the spec.
+type Change struct {
+	Field string `json:"field"`
+	From  string `json:"from,omitempty"`
+	To    string `json:"to"`
+}
+
+// Diff compares the fields an apply would send with the remote object.
+// Objects in the spec (e.g. targeting) are compared as a subset: keys the
+// spec doesn't mention are ignored, since Meta fills in defaults.
+func Diff(want map[string]any, remote json.RawMessage) ([]Change, error) {
+	var have map[string]any
+	if err := json.Unmarshal(remote, &have); err != nil {
+		return nil, err
+	}
+	var changes []Change
+	for _, k := range sortedKeys(want) {
+		w, err := normalize(want[k])
+		if err != nil {
+			return nil, err
+		}
+		h, ok := have[k]
+		if ok && matches(w, h) || !ok && isEmpty(w) {
+			continue
+		}
+		c := Change{Field: k, To: Display(w)}
+		if ok {
+			c.From = Display(h)
+		}
+		changes = append(changes, c)
+	}
+	return changes, nil
+}
+
+// Display renders a field value for a plan: scalars as-is, the rest as JSON.
+func Display(v any) string {
+	switch t := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return t
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64)
+	case int:
+		return strconv.Itoa(t)
+	case int64:
+		return strconv.FormatInt(t, 10)
+	}
+	b, _ := json.Marshal(jsonable(v))
+	return string(b)
+}
+
+// normalize round-trips v through JSON so it has the same types as a decoded
+// API response.
+func normalize(v any) (any, error) {
+	b, err := json.Marshal(jsonable(v))
+	if err != nil {
+		return nil, err
+	}
+	var out any
+	err = json.Unmarshal(b, &out)
+	return out, err
+}
+
+// isEmpty reports whether v is an empty list or object, which Meta omits.
+func isEmpty(v any) bool {
+	switch t := v.(type) {
+	case []any:
+		return len(t) == 0
+	case map[string]any:
+		return len(t) == 0
+	}
+	return false
+}
+
+// matches reports whether have satisfies want. Scalars compare by their
+// text (Meta returns numbers such as budgets as strings).
+func matches(want, have any) bool {
+	switch w := want.(type) {
+	case map[string]any:
+		h, ok := have.(map[string]any)
+		if !ok {
+			return false
+		}
+		for k, v := range w {
+			if !matches(v, h[k]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		h, ok := have.([]any)
+		if !ok || len(h) != len(w) {
+			return false
+		}
+		for i := range w {
+			if !matches(w[i], h[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	if have == nil {
+		return want == nil
+	}
+	return Display(want) == Display(have)
+}
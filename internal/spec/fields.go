@@ -0,0 +1,151 @@
+package spec
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// Fields returns the API fields of the campaign. Fields that can only be set
+// at creation (objective) are left out unless create is true.
+func (c *Campaign) Fields(create bool) map[string]any {
+	f := map[string]any{}
+	put(f, "name", c.Name)
+	put(f, "status", c.Status)
+	putInt(f, "daily_budget", c.DailyBudget)
+	putInt(f, "lifetime_budget", c.LifetimeBudget)
+	putInt(f, "spend_cap", c.SpendCap)
+	put(f, "bid_strategy", c.BidStrategy)
+	if create {
+		put(f, "objective", c.Objective)
+		cats := c.SpecialAdCategories
+		if cats == nil {
+			cats = []string{}
+		}
+		f["special_ad_categories"] = cats
+	} else if c.SpecialAdCategories != nil {
+		f["special_ad_categories"] = c.SpecialAdCategories
+	}
+	return withParams(f, c.Params)
+}
+
+// Fields returns the API fields of the ad set (campaign_id is added by the caller).
+func (as *AdSet) Fields() map[string]any {
+	f := map[string]any{}
+	put(f, "name", as.Name)
+	put(f, "status", as.Status)
+	putInt(f, "daily_budget", as.DailyBudget)
+	putInt(f, "lifetime_budget", as.LifetimeBudget)
+	putInt(f, "bid_amount", as.BidAmount)
+	put(f, "billing_event", as.BillingEvent)
+	put(f, "optimization_goal", as.OptimizationGoal)
+	put(f, "start_time", as.StartTime)
+	put(f, "end_time", as.EndTime)
+	if as.Targeting != nil {
+		f["targeting"] = as.Targeting
+	}
+	if as.PromotedObject != nil {
+		f["promoted_object"] = as.PromotedObject
+	}
+	return withParams(f, as.Params)
+}
+
+// Fields returns the API fields of the ad (adset_id and creative are added by the caller).
+func (ad *Ad) Fields() map[string]any {
+	f := map[string]any{}
+	put(f, "name", ad.Name)
+	put(f, "status", ad.Status)
+	return withParams(f, ad.Params)
+}
+
+// Fields returns the API fields used to create the creative.
+func (cr *Creative) Fields() map[string]any {
+	f := map[string]any{}
+	put(f, "name", cr.Name)
+	if cr.ObjectStorySpec != nil {
+		f["object_story_spec"] = cr.ObjectStorySpec
+	}
+	return withParams(f, cr.Params)
+}
+
+// Form encodes fields as a Graph API form body: strings and numbers as-is,
+// everything else (objects, lists, booleans) as JSON.
+func Form(fields map[string]any) (url.Values, error) {
+	body := url.Values{}
+	for _, k := range sortedKeys(fields) {
+		switch v := fields[k].(type) {
+		case string:
+			body.Set(k, v)
+		case int:
+			body.Set(k, strconv.Itoa(v))
+		case int64:
+			body.Set(k, strconv.FormatInt(v, 10))
+		case float64:
+			body.Set(k, strconv.FormatFloat(v, 'f', -1, 64))
+		default:
+			b, err := json.Marshal(jsonable(v))
+			if err != nil {
+				return nil, fmt.Errorf("encoding %s: %w", k, err)
+			}
+			body.Set(k, string(b))
+		}
+	}
+	return body, nil
+}
+
+func put(f map[string]any, key, v string) {
+	if v != "" {
+		f[key] = v
+	}
+}
+
+func putInt(f map[string]any, key string, v int64) {
+	if v != 0 {
+		f[key] = v
+	}
+}
+
+// withParams adds the raw params to f; params win on conflicts.
+func withParams(f, params map[string]any) map[string]any {
+	for k, v := range params {
+		f[k] = v
+	}
+	return f
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// jsonable converts the map[any]any values yaml.v3 may produce for
+// non-string keys into map[string]any so they can be JSON-encoded.
+func jsonable(v any) any {
+	switch t := v.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(t))
+		for k, val := range t {
+			m[fmt.Sprint(k)] = jsonable(val)
+		}
+		return m
+	case map[string]any:
+		m := make(map[string]any, len(t))
+		for k, val := range t {
+			m[k] = jsonable(val)
+		}
+		return m
+	case []any:
+		s := make([]any, len(t))
+		for i, val := range t {
+			s[i] = jsonable(val)
+		}
+		return s
+	}
+	return v
+}
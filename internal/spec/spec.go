@@ -0,0 +1,283 @@
+// Package spec reads and writes the declarative campaign files used by
+// `meta-ads apply`: a campaign → ad set → ad → creative tree in YAML.
+//
+// Each object carries an optional id. Objects without one are created by
+// apply, which records the new ID back into the file (comments and key
+// order are preserved), so the next apply updates them instead.
+package spec
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Spec is a declarative campaign file.
+type Spec struct {
+	// Account is the ad account the objects are created in; the resolved
+	// account (--account, META_ADS_ACCOUNT, default_account) is used when empty.
+	Account   string      `yaml:"account,omitempty"`
+	Campaigns []*Campaign `yaml:"campaigns"`
+
+	path string
+	doc  *yaml.Node
+}
+
+// Campaign is a campaign and its ad sets.
+type Campaign struct {
+	ID                  string         `yaml:"id,omitempty"`
+	Name                string         `yaml:"name"`
+	Objective           string         `yaml:"objective,omitempty"`
+	Status              string         `yaml:"status,omitempty"`
+	DailyBudget         int64          `yaml:"daily_budget,omitempty"`
+	LifetimeBudget      int64          `yaml:"lifetime_budget,omitempty"`
+	SpendCap            int64          `yaml:"spend_cap,omitempty"`
+	BidStrategy         string         `yaml:"bid_strategy,omitempty"`
+	SpecialAdCategories []string       `yaml:"special_ad_categories,omitempty"`
+	Params              map[string]any `yaml:"params,omitempty"`
+	AdSets              []*AdSet       `yaml:"adsets,omitempty"`
+
+	node *yaml.Node
+}
+
+// AdSet is an ad set and its ads.
+type AdSet struct {
+	ID               string         `yaml:"id,omitempty"`
+	Name             string         `yaml:"name"`
+	Status           string         `yaml:"status,omitempty"`
+	DailyBudget      int64          `yaml:"daily_budget,omitempty"`
+	LifetimeBudget   int64          `yaml:"lifetime_budget,omitempty"`
+	BidAmount        int64          `yaml:"bid_amount,omitempty"`
+	BillingEvent     string         `yaml:"billing_event,omitempty"`
+	OptimizationGoal string         `yaml:"optimization_goal,omitempty"`
+	StartTime        string         `yaml:"start_time,omitempty"`
+	EndTime          string         `yaml:"end_time,omitempty"`
+	Targeting        map[string]any `yaml:"targeting,omitempty"`
+	PromotedObject   map[string]any `yaml:"promoted_object,omitempty"`
+	Params           map[string]any `yaml:"params,omitempty"`
+	Ads              []*Ad          `yaml:"ads,omitempty"`
+
+	node *yaml.Node
+}
+
+// Ad is an ad and its creative.
+type Ad struct {
+	ID       string         `yaml:"id,omitempty"`
+	Name     string         `yaml:"name"`
+	Status   string         `yaml:"status,omitempty"`
+	Creative *Creative      `yaml:"creative,omitempty"`
+	Params   map[string]any `yaml:"params,omitempty"`
+
+	node *yaml.Node
+}
+
+// Creative is an ad creative: either an existing one referenced by id, or
+// one to create from object_story_spec and params.
+type Creative struct {
+	ID              string         `yaml:"id,omitempty"`
+	Name            string         `yaml:"name,omitempty"`
+	ObjectStorySpec map[string]any `yaml:"object_story_spec,omitempty"`
+	// Thumbnail picks the thumbnail of a video creative: an image URL, or the
+	// index of one of the video's generated thumbnails (see assets videos
+	// thumbnails).
+	Thumbnail string         `yaml:"thumbnail,omitempty"`
+	Params    map[string]any `yaml:"params,omitempty"`
+
+	node *yaml.Node
+}
+
+// Load reads a spec file; path "-" reads stdin (IDs can't be recorded then).
+func Load(path string) (*Spec, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", path, err)
+	}
+
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return nil, fmt.Errorf("%s is empty", path)
+	}
+
+	var s Spec
+	if err := doc.Decode(&s); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	s.path = path
+	s.doc = &doc
+	s.attachNodes()
+
+	if err := s.validate(); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return &s, nil
+}
+
+// ParseCreative reads a single creative in YAML (or JSON), as found under
+// creative: in a spec.
+func ParseCreative(data []byte) (*Creative, error) {
+	var cr Creative
+	if err := yaml.Unmarshal(data, &cr); err != nil {
+		return nil, fmt.Errorf("parsing creative: %w", err)
+	}
+	if cr.ObjectStorySpec == nil && cr.Params == nil {
+		return nil, errors.New("the creative has no object_story_spec or params")
+	}
+	return &cr, nil
+}
+
+// attachNodes links each object to its YAML mapping so IDs can be written back.
+func (s *Spec) attachNodes() {
+	campaigns := sequence(mappingValue(s.doc.Content[0], "campaigns"))
+	for i, c := range s.Campaigns {
+		if i >= len(campaigns) {
+			break
+		}
+		c.node = campaigns[i]
+		adsets := sequence(mappingValue(c.node, "adsets"))
+		for j, as := range c.AdSets {
+			if j >= len(adsets) {
+				break
+			}
+			as.node = adsets[j]
+			ads := sequence(mappingValue(as.node, "ads"))
+			for k, ad := range as.Ads {
+				if k >= len(ads) {
+					break
+				}
+				ad.node = ads[k]
+				if ad.Creative != nil {
+					ad.Creative.node = mappingValue(ad.node, "creative")
+				}
+			}
+		}
+	}
+}
+
+func (s *Spec) validate() error {
+	if len(s.Campaigns) == 0 {
+		return errors.New("no campaigns defined")
+	}
+	for i, c := range s.Campaigns {
+		if c.Name == "" {
+			return fmt.Errorf("campaigns[%d]: name is required", i)
+		}
+		if c.ID == "" && c.Objective == "" {
+			return fmt.Errorf("campaign %q: objective is required to create it", c.Name)
+		}
+		for j, as := range c.AdSets {
+			if as.Name == "" {
+				return fmt.Errorf("campaign %q: adsets[%d]: name is required", c.Name, j)
+			}
+			for k, ad := range as.Ads {
+				if ad.Name == "" {
+					return fmt.Errorf("ad set %q: ads[%d]: name is required", as.Name, k)
+				}
+				if ad.ID == "" && ad.Creative == nil {
+					return fmt.Errorf("ad %q: creative is required to create it", ad.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// Path returns the file the spec was loaded from ("-" for stdin).
+func (s *Spec) Path() string {
+	return s.path
+}
+
+// Writable reports whether Save can record IDs (false when read from stdin).
+func (s *Spec) Writable() bool {
+	return s.path != "-"
+}
+
+// Save writes the spec back to its file, keeping comments and key order.
+func (s *Spec) Save() error {
+	if !s.Writable() {
+		return nil
+	}
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(s.doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, buf.Bytes(), info.Mode().Perm())
+}
+
+// Encode writes s as YAML to w.
+func (s *Spec) Encode(w io.Writer) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(s); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// SetID records the ID of a created campaign.
+func (c *Campaign) SetID(id string) { c.ID = id; setNodeID(c.node, id) }
+
+// SetID records the ID of a created ad set.
+func (as *AdSet) SetID(id string) { as.ID = id; setNodeID(as.node, id) }
+
+// SetID records the ID of a created ad.
+func (ad *Ad) SetID(id string) { ad.ID = id; setNodeID(ad.node, id) }
+
+// SetID records the ID of a created creative.
+func (cr *Creative) SetID(id string) { cr.ID = id; setNodeID(cr.node, id) }
+
+// setNodeID sets the id key of mapping n, adding it first when missing.
+func setNodeID(n *yaml.Node, id string) {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return
+	}
+	if v := mappingValue(n, "id"); v != nil {
+		v.Kind, v.Tag, v.Style, v.Value = yaml.ScalarNode, "!!str", yaml.DoubleQuotedStyle, id
+		return
+	}
+	key := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "id"}
+	val := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Style: yaml.DoubleQuotedStyle, Value: id}
+	n.Content = append([]*yaml.Node{key, val}, n.Content...)
+}
+
+// mappingValue returns the value of key in mapping n, or nil.
+func mappingValue(n *yaml.Node, key string) *yaml.Node {
+	if n == nil || n.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// sequence returns the items of sequence n, or nil.
+func sequence(n *yaml.Node) []*yaml.Node {
+	if n == nil || n.Kind != yaml.SequenceNode {
+		return nil
+	}
+	return n.Content
+}